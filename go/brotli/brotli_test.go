@@ -172,3 +172,50 @@ func TestEncodeDecodeWithDictionary(t *testing.T) {
 			decoded, want)
 	}
 }
+
+func TestDecodeUTF8ContextMode(t *testing.T) {
+	// At quality 11, UTF-8 text is encoded with the UTF-8 context mode, whose
+	// lookup table the decoder unpacks at initialization.
+	words := []string{"brotli ", "Grüße ", "naïve ", "привет ", "мир ", "日本語 ", "テキスト ", "ελληνικά ", "∑∞≠ ", "😀 ", "\n"}
+	rng := rand.New(rand.NewSource(1))
+	var input []byte
+	for len(input) < 64<<10 {
+		input = append(input, words[rng.Intn(len(words))]...)
+	}
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 11})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	decoded, err := brotli.Decode(encoded)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !bytes.Equal(decoded, input) {
+		t.Errorf("Decode: got %d bytes, want the %d bytes of input", len(decoded), len(input))
+	}
+}
+
+func TestDecodeWithDictionaryPart(t *testing.T) {
+	// Backward references may copy any part of the dictionary, not only parts
+	// that reach its end.
+	rng := rand.New(rand.NewSource(1))
+	dictionary := make([]byte, 20000)
+	for i := range dictionary {
+		dictionary[i] = "abcdefgh ijk"[rng.Intn(12)]
+	}
+	input := dictionary[5000:10000]
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	decoded, err := brotli.DecodeWithRawDictionary(encoded, dictionary)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !bytes.Equal(decoded, input) {
+		t.Errorf("Decode: got %d bytes, want the %d bytes of input", len(decoded), len(input))
+	}
+}
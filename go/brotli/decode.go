@@ -1067,7 +1067,7 @@ func initializeCompoundDictionaryCopy(s *_State, address int32, length int32) in
 	for address >= s.cdChunkOffsets[index+1] {
 		index++
 	}
-	if s.cdTotalSize > address+length {
+	if address+length > s.cdTotalSize {
 		return makeError(s, -9)
 	}
 	s.distRbIdx = (s.distRbIdx + 1) & 0x3
@@ -1939,7 +1939,7 @@ func unpackLookupTable(lookup []int32, utfMap string, utfRle string) {
 }
 
 func init() {
-	unpackLookupTable(lookup, "         !!  !                  \"#$##%#$&'##(#)#++++++++++((&*'##,---,---,-----,-----,-----&#'###.///.///./////./////./////&#'# ", "A/*  ':  & : $  \u0081 @")
+	unpackLookupTable(lookup, "         !!  !                  \"#$##%#$&'##(#)#++++++++++((&*'##,---,---,-----,-----,-----&#'###.///.///./////./////./////&#'# ", "A/*  ':  & : $  \x81 @")
 }
 
 type _State struct {
@@ -0,0 +1,252 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ItemError is an error of a single item of a batch.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e ItemError) Unwrap() error { return e.Err }
+
+// BatchError is returned by batch functions when some items have failed, or
+// when the context was canceled before all items were processed.
+type BatchError struct {
+	// Items lists failed items, ordered by index.
+	Items []ItemError
+	// Err is the context error, if processing has been stopped.
+	Err error
+}
+
+func (e *BatchError) Error() string {
+	switch {
+	case len(e.Items) == 0:
+		return fmt.Sprintf("cbrotli: batch stopped: %v", e.Err)
+	case e.Err != nil:
+		return fmt.Sprintf("cbrotli: batch stopped: %v; %d items failed, first: %v",
+			e.Err, len(e.Items), e.Items[0])
+	default:
+		return fmt.Sprintf("cbrotli: %d items failed, first: %v", len(e.Items), e.Items[0])
+	}
+}
+
+// Unwrap returns the item errors and the context error, so that errors.Is
+// and errors.As can inspect them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Items)+1)
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	for _, item := range e.Items {
+		errs = append(errs, item)
+	}
+	return errs
+}
+
+// CompressBatch encodes each of inputs with options, like Encode, using up to
+// parallelism goroutines (GOMAXPROCS if not positive). Outputs are returned in
+// input order; if some items fail, their outputs are nil and the error is a
+// *BatchError.
+//
+// Each goroutine reuses its buffers across items, so that allocations are
+// limited to the returned outputs.
+func CompressBatch(inputs [][]byte, options WriterOptions, parallelism int) ([][]byte, error) {
+	return CompressBatchContext(context.Background(), inputs, options, parallelism)
+}
+
+// CompressBatchContext is like CompressBatch, but stops scheduling items once
+// ctx is done; outputs of items not processed by then are nil.
+func CompressBatchContext(ctx context.Context, inputs [][]byte, options WriterOptions, parallelism int) ([][]byte, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return runBatch(ctx, inputs, parallelism, func() func([]byte) ([]byte, error) {
+		e := &batchEncoder{}
+		return func(input []byte) ([]byte, error) {
+			return e.encode(input, options)
+		}
+	})
+}
+
+// DecompressBatch decodes each of inputs, like Decode, using up to parallelism
+// goroutines (GOMAXPROCS if not positive). Outputs are returned in input
+// order; if some items fail, their outputs are nil and the error is a
+// *BatchError.
+func DecompressBatch(inputs [][]byte, parallelism int) ([][]byte, error) {
+	return DecompressBatchContext(context.Background(), inputs, parallelism)
+}
+
+// DecompressBatchContext is like DecompressBatch, but stops scheduling items
+// once ctx is done; outputs of items not processed by then are nil.
+func DecompressBatchContext(ctx context.Context, inputs [][]byte, parallelism int) ([][]byte, error) {
+	return runBatch(ctx, inputs, parallelism, func() func([]byte) ([]byte, error) {
+		return Decode
+	})
+}
+
+// runBatch processes inputs with a pool of workers; newWorker is called once
+// per goroutine, so that workers can keep state.
+func runBatch(ctx context.Context, inputs [][]byte, parallelism int, newWorker func() func([]byte) ([]byte, error)) ([][]byte, error) {
+	outputs, errs, scheduled := runItems(ctx, inputs, parallelism, newWorker)
+	var batchErr BatchError
+	for i, err := range errs {
+		if err != nil {
+			outputs[i] = nil
+			batchErr.Items = append(batchErr.Items, ItemError{Index: i, Err: err})
+		}
+	}
+	if scheduled < len(inputs) {
+		batchErr.Err = ctx.Err()
+	}
+	if batchErr.Items == nil && batchErr.Err == nil {
+		return outputs, nil
+	}
+	return outputs, &batchErr
+}
+
+// runItems runs the workers of runBatch; items are scheduled in order, and
+// those from scheduled on were not processed because ctx was done.
+func runItems(ctx context.Context, inputs [][]byte, parallelism int, newWorker func() func([]byte) ([]byte, error)) (outputs [][]byte, errs []error, scheduled int) {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	parallelism = min(parallelism, len(inputs))
+	outputs = make([][]byte, len(inputs))
+	errs = make([]error, len(inputs))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work := newWorker()
+			for ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= len(inputs) {
+					return
+				}
+				outputs[i], errs[i] = work(inputs[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return outputs, errs, min(int(next.Load()), len(inputs))
+}
+
+// DecompressBatchLimit decodes each of inputs, like DecompressBatchContext,
+// but reports the outcome of each item: errs has an entry per input, nil for
+// items decoded successfully, so that bad items can be set aside. Items
+// decoding to more than maxOutputEach bytes (if positive) fail with
+// ErrDecodedTooLarge, and items not processed because ctx is done fail with
+// ctx.Err(). Outputs of failed items are nil.
+//
+// Each goroutine reuses one Reader and its buffers across items.
+func DecompressBatchLimit(ctx context.Context, inputs [][]byte, parallelism int, maxOutputEach int64) (outputs [][]byte, errs []error) {
+	var mu sync.Mutex
+	var decoders []*batchDecoder
+	outputs, errs, scheduled := runItems(ctx, inputs, parallelism, func() func([]byte) ([]byte, error) {
+		d := &batchDecoder{options: ReaderOptions{MaxDecodedSize: maxOutputEach}}
+		mu.Lock()
+		decoders = append(decoders, d)
+		mu.Unlock()
+		return d.decode
+	})
+	for _, d := range decoders {
+		if d.r != nil {
+			d.r.Close()
+		}
+	}
+	for i := scheduled; i < len(inputs); i++ {
+		errs[i] = ctx.Err()
+	}
+	for i, err := range errs {
+		if err != nil {
+			outputs[i] = nil
+		}
+	}
+	return outputs, errs
+}
+
+// batchDecoder keeps the Reader and buffer of a DecompressBatchLimit worker.
+type batchDecoder struct {
+	options ReaderOptions
+	src     bytes.Reader
+	r       *Reader
+	out     bytes.Buffer
+}
+
+// decode is Decode with a reused Reader; the result is a fresh slice of exact
+// size.
+func (d *batchDecoder) decode(encoded []byte) ([]byte, error) {
+	d.src.Reset(encoded)
+	if d.r == nil {
+		d.r = NewReaderWithOptions(&d.src, d.options)
+	} else if err := d.r.Reset(&d.src); err != nil {
+		return nil, err
+	}
+	d.out.Reset()
+	if _, err := d.out.ReadFrom(d.r); err != nil {
+		return nil, err
+	}
+	return bytes.Clone(d.out.Bytes()), nil
+}
+
+// batchEncoder keeps buffers of a CompressBatch worker.
+type batchEncoder struct {
+	scratch []byte
+	stream  *BufferWriter
+}
+
+// encode is Encode with reused buffers; options must be valid. The result is
+// a fresh slice of exact size.
+func (e *batchEncoder) encode(content []byte, options WriterOptions) ([]byte, error) {
+	encoded, err := e.encodeTemporary(content, options)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(encoded), nil
+}
+
+// encodeTemporary is like encode, but the result is only valid until the next
+// call.
+func (e *batchEncoder) encodeTemporary(content []byte, options WriterOptions) ([]byte, error) {
+	if options.SizeHint == 0 {
+		options.SizeHint = len(content)
+	}
+	if options.oneShot() && len(content) != 0 {
+		if encoded, ok := encodeOneShot(content, options, e.scratch); ok {
+			e.scratch = encoded
+			return encoded, nil
+		}
+	}
+	if e.stream == nil {
+		e.stream = NewBufferWriter(options)
+	} else {
+		e.stream.ResetOptions(options)
+	}
+	_, err := e.stream.Write(content)
+	if closeErr := e.stream.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e.stream.Bytes(), nil
+}
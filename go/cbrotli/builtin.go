@@ -0,0 +1,79 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+
+// Mirrors BrotliDictionary of c/common/dictionary.h, which is not installed.
+struct BrotliDictionary {
+  uint8_t size_bits_by_length[32];
+  uint32_t offsets_by_length[32];
+  size_t data_size;
+  const uint8_t* data;
+};
+
+const struct BrotliDictionary* BrotliGetDictionary(void);
+
+// Mirrors BrotliTransforms of c/common/transform.h, which is not installed.
+struct BrotliTransforms {
+  uint16_t prefix_suffix_size;
+  const uint8_t* prefix_suffix;
+  const uint16_t* prefix_suffix_map;
+  uint32_t num_transforms;
+  const uint8_t* transforms;
+  const uint8_t* params;
+  int16_t cutOffTransforms[10];
+};
+
+const struct BrotliTransforms* BrotliGetTransforms(void);
+int BrotliTransformDictionaryWord(uint8_t* dst, const uint8_t* word, int len,
+                                  const struct BrotliTransforms* transforms,
+                                  int transform_idx);
+
+// Declared in c/common/context.h.
+extern const uint8_t _kBrotliContextLookupTable[2048];
+
+static const uint8_t* ContextLookupTable(void) {
+  return _kBrotliContextLookupTable;
+}
+*/
+import "C"
+
+import "unsafe"
+
+// builtinDictionary returns the static dictionary of C-Brotli; its data is C
+// memory.
+func builtinDictionary() *staticDictionary {
+	d := C.BrotliGetDictionary()
+	s := &staticDictionary{
+		data:          unsafe.Slice((*byte)(unsafe.Pointer(d.data)), int(d.data_size)),
+		numTransforms: int(C.BrotliGetTransforms().num_transforms),
+	}
+	for i := range s.sizeBits {
+		s.sizeBits[i] = uint8(d.size_bits_by_length[i])
+		s.offsets[i] = uint32(d.offsets_by_length[i])
+	}
+	return s
+}
+
+// transform writes word transformed with the built-in transform idx to dst,
+// which must have room for maxTransformedWordLength bytes, and returns the
+// length of the result.
+func (s *staticDictionary) transform(dst, word []byte, idx int) int {
+	return int(C.BrotliTransformDictionaryWord((*C.uint8_t)(&dst[0]),
+		(*C.uint8_t)(&word[0]), C.int(len(word)), C.BrotliGetTransforms(), C.int(idx)))
+}
+
+// contextLookupTable returns the literal context lookup table of RFC 7932,
+// section 7.1: 512 bytes per context mode, the first 256 indexed by the last
+// byte and the others by the byte before it.
+func contextLookupTable() []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(C.ContextLookupTable())), 2048)
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !cgo
+
+package cbrotli
+
+import "github.com/google/brotli/go/cbrotli/internal/decoder"
+
+// builtinDictionary returns the static dictionary of the pure-Go decoder.
+func builtinDictionary() *staticDictionary {
+	data, offsets, sizeBits := decoder.Dictionary()
+	s := &staticDictionary{
+		data:          data,
+		numTransforms: decoder.NumTransforms(),
+	}
+	for i := range s.sizeBits {
+		s.sizeBits[i] = uint8(sizeBits[i])
+		s.offsets[i] = uint32(offsets[i])
+	}
+	return s
+}
+
+// transform writes word transformed with the built-in transform idx to dst,
+// which must have room for maxTransformedWordLength bytes, and returns the
+// length of the result.
+func (s *staticDictionary) transform(dst, word []byte, idx int) int {
+	return decoder.Transform(dst, word, idx)
+}
+
+var builtinContextLookupTable = decoder.ContextLookupTable()
+
+// contextLookupTable returns the literal context lookup table of RFC 7932,
+// section 7.1: 512 bytes per context mode, the first 256 indexed by the last
+// byte and the others by the byte before it.
+func contextLookupTable() []byte {
+	return builtinContextLookupTable
+}
@@ -0,0 +1,19 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo && !libbrotli_system
+
+package cbrotli
+
+// By default, the package compiles the copy of the C sources in csrc, through
+// the brotli_*.c wrappers, so that it builds without libbrotli installed. The
+// libbrotli_system build tag links the system libraries instead, found with
+// pkg-config (see cgo.go).
+
+//go:generate sh csrc/update.sh
+
+// #cgo CFLAGS: -I${SRCDIR}/csrc/include
+// #cgo LDFLAGS: -lm
+import "C"
@@ -0,0 +1,204 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// PreparedDictionaryCacheOptions configures PreparedDictionaryCache.
+type PreparedDictionaryCacheOptions struct {
+	// Load returns the data of the dictionary named key; it is called on
+	// cache misses, possibly concurrently for different keys. The data must
+	// not be modified afterwards.
+	Load func(key string) ([]byte, error)
+	// Type is the type of the loaded dictionaries.
+	Type DictionaryType
+	// Quality is passed to PrepareDictionary.
+	Quality int
+	// MaxEntries and MaxBytes limit the number of cached dictionaries and
+	// the total size of their data; 0 means no limit.
+	MaxEntries int
+	MaxBytes   int64
+}
+
+// CacheStats reports the activity of a PreparedDictionaryCache.
+type CacheStats struct {
+	Hits, Misses, Evictions int64
+	// Entries and Bytes describe the dictionaries currently cached.
+	Entries int
+	Bytes   int64
+}
+
+// PreparedDictionaryCache keeps recently used prepared dictionaries, so that
+// each is prepared once while it is in demand. It is safe for concurrent use.
+//
+// Dictionaries returned by Get are shared; they must be released with Release
+// instead of being closed. The least recently used dictionaries are closed
+// when the cache is over its limits, but only once they are released and no
+// Writer uses them anymore; until then the cache may exceed its limits.
+type PreparedDictionaryCache struct {
+	options PreparedDictionaryCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	byDict  map[*PreparedDictionary]*cacheEntry
+	lru     list.List // *cacheEntry; most recently used at the front
+	stats   CacheStats
+}
+
+type cacheEntry struct {
+	key   string
+	dict  *PreparedDictionary
+	size  int64
+	refs  int           // Get calls not released yet
+	ready chan struct{} // closed when dict or err is set
+	err   error
+	elem  *list.Element
+}
+
+var (
+	errNoLoad            = errors.New("cbrotli: PreparedDictionaryCacheOptions.Load is not set")
+	errNotFromCache      = errors.New("cbrotli: dictionary is not from this cache")
+	errCacheEntriesInUse = errors.New("cbrotli: cached dictionaries are in use")
+)
+
+// NewPreparedDictionaryCache initializes new PreparedDictionaryCache instance.
+// Close should be called to free resources.
+func NewPreparedDictionaryCache(options PreparedDictionaryCacheOptions) *PreparedDictionaryCache {
+	return &PreparedDictionaryCache{
+		options: options,
+		entries: make(map[string]*cacheEntry),
+		byDict:  make(map[*PreparedDictionary]*cacheEntry),
+	}
+}
+
+// Get returns the prepared dictionary named key, loading and preparing it if
+// it is not cached. Concurrent calls for the same key wait for a single
+// preparation. Release MUST be called when the dictionary is no longer needed
+// by new Writers.
+func (c *PreparedDictionaryCache) Get(key string) (*PreparedDictionary, error) {
+	if c.options.Load == nil {
+		return nil, errNoLoad
+	}
+	c.mu.Lock()
+	e := c.entries[key]
+	if e != nil {
+		c.stats.Hits++
+		e.refs++
+		c.lru.MoveToFront(e.elem)
+		c.mu.Unlock()
+		<-e.ready
+		if e.err != nil {
+			c.mu.Lock()
+			e.refs--
+			c.mu.Unlock()
+			return nil, e.err
+		}
+		return e.dict, nil
+	}
+	c.stats.Misses++
+	e = &cacheEntry{key: key, refs: 1, ready: make(chan struct{})}
+	e.elem = c.lru.PushFront(e)
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	data, err := c.options.Load(key)
+	var dict *PreparedDictionary
+	if err == nil {
+		dict, err = PrepareDictionary(data, c.options.Type, PrepareDictionaryOptions{Quality: c.options.Quality})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.dict, e.err = dict, err
+	close(e.ready)
+	if err != nil {
+		e.refs--
+		c.remove(e)
+		return nil, err
+	}
+	e.size = int64(len(data))
+	c.byDict[dict] = e
+	c.stats.Entries++
+	c.stats.Bytes += e.size
+	c.evict()
+	return dict, nil
+}
+
+// Release gives back a dictionary returned by Get.
+func (c *PreparedDictionaryCache) Release(dict *PreparedDictionary) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.byDict[dict]
+	if e == nil || e.refs == 0 {
+		return errNotFromCache
+	}
+	e.refs--
+	c.evict()
+	return nil
+}
+
+// Stats returns the cache counters.
+func (c *PreparedDictionaryCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Close frees all cached dictionaries. It fails if some of them are not
+// released or are used by Writers; those remain cached.
+func (c *PreparedDictionaryCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.lru.Back(); elem != nil; {
+		e := elem.Value.(*cacheEntry)
+		elem = elem.Prev()
+		c.tryEvict(e)
+	}
+	if c.lru.Len() != 0 {
+		return errCacheEntriesInUse
+	}
+	return nil
+}
+
+// evict frees least recently used dictionaries that are not in use, while the
+// cache is over its limits.
+func (c *PreparedDictionaryCache) evict() {
+	over := func() bool {
+		return (c.options.MaxEntries > 0 && c.stats.Entries > c.options.MaxEntries) ||
+			(c.options.MaxBytes > 0 && c.stats.Bytes > c.options.MaxBytes)
+	}
+	for elem := c.lru.Back(); elem != nil && over(); {
+		e := elem.Value.(*cacheEntry)
+		elem = elem.Prev()
+		c.tryEvict(e)
+	}
+}
+
+// tryEvict frees e if it is prepared and neither referenced by the cache users
+// nor attached to open Writers.
+func (c *PreparedDictionaryCache) tryEvict(e *cacheEntry) {
+	if e.refs != 0 || e.dict == nil {
+		return
+	}
+	// Close fails while Writers use the dictionary.
+	if e.dict.Close() != nil {
+		return
+	}
+	c.stats.Evictions++
+	c.stats.Entries--
+	c.stats.Bytes -= e.size
+	delete(c.byDict, e.dict)
+	c.remove(e)
+}
+
+func (c *PreparedDictionaryCache) remove(e *cacheEntry) {
+	c.lru.Remove(e.elem)
+	delete(c.entries, e.key)
+}
@@ -3,47 +3,47 @@
 // Distributed under MIT license.
 // See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
 
+//go:build cgo
+
 package cbrotli_test
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
+	"log/slog"
 	"math"
 	"math/rand"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
 	"time"
 
 	"github.com/google/brotli/go/cbrotli"
 )
 
-func checkCompressedData(compressedData, wantOriginalData []byte) error {
-	uncompressed, err := cbrotli.Decode(compressedData)
-	if err != nil {
-		return fmt.Errorf("brotli decompress failed: %v", err)
-	}
-	if !bytes.Equal(uncompressed, wantOriginalData) {
-		if len(wantOriginalData) != len(uncompressed) {
-			return fmt.Errorf(""+
-				"Data doesn't uncompress to the original value.\n"+
-				"Length of original: %v\n"+
-				"Length of uncompressed: %v",
-				len(wantOriginalData), len(uncompressed))
-		}
-		for i := range wantOriginalData {
-			if wantOriginalData[i] != uncompressed[i] {
-				return fmt.Errorf(""+
-					"Data doesn't uncompress to the original value.\n"+
-					"Original at %v is %v\n"+
-					"Uncompressed at %v is %v",
-					i, wantOriginalData[i], i, uncompressed[i])
-			}
-		}
-	}
-	return nil
-}
-
 func TestEncoderNoWrite(t *testing.T) {
 	out := bytes.Buffer{}
 	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5})
@@ -262,78 +262,6 @@ func TestDecoderStreaming(t *testing.T) {
 	}
 }
 
-func TestReader(t *testing.T) {
-	content := bytes.Repeat([]byte("hello world!"), 10000)
-	encoded, _ := cbrotli.Encode(content, cbrotli.WriterOptions{Quality: 5})
-	r := cbrotli.NewReader(bytes.NewReader(encoded))
-	var decodedOutput bytes.Buffer
-	n, err := io.Copy(&decodedOutput, r)
-	if err != nil {
-		t.Fatalf("Copy(): n=%v, err=%v", n, err)
-	}
-	if err := r.Close(); err != nil {
-		t.Errorf("Close(): %v", err)
-	}
-	if got := decodedOutput.Bytes(); !bytes.Equal(got, content) {
-		t.Errorf(""+
-			"Reader output:\n"+
-			"%q\n"+
-			"want:\n"+
-			"<%d bytes>",
-			got, len(content))
-	}
-	buf := make([]byte, 4)
-	if _, err := r.Read(buf); err == nil {
-		t.Errorf("Read-after-Close shoule have returned error")
-	}
-}
-
-func TestDecode(t *testing.T) {
-	content := bytes.Repeat([]byte("hello world!"), 10000)
-	encoded, _ := cbrotli.Encode(content, cbrotli.WriterOptions{Quality: 5})
-	decoded, err := cbrotli.Decode(encoded)
-	if err != nil {
-		t.Errorf("Decode: %v", err)
-	}
-	if !bytes.Equal(decoded, content) {
-		t.Errorf(""+
-			"Decode content:\n"+
-			"%q\n"+
-			"want:\n"+
-			"<%d bytes>",
-			decoded, len(content))
-	}
-}
-
-func TestDecodeFuzz(t *testing.T) {
-	// Test that the decoder terminates with corrupted input.
-	content := bytes.Repeat([]byte("hello world!"), 100)
-	src := rand.NewSource(0)
-	encoded, err := cbrotli.Encode(content, cbrotli.WriterOptions{Quality: 5})
-	if err != nil {
-		t.Fatalf("Encode(<%d bytes>, _) = _, %s", len(content), err)
-	}
-	if len(encoded) == 0 {
-		t.Fatalf("Encode(<%d bytes>, _) produced empty output", len(content))
-	}
-	for i := 0; i < 100; i++ {
-		enc := append([]byte{}, encoded...)
-		for j := 0; j < 5; j++ {
-			enc[int(src.Int63())%len(enc)] = byte(src.Int63() % 256)
-		}
-		cbrotli.Decode(enc)
-	}
-}
-
-func TestDecodeTrailingData(t *testing.T) {
-	content := bytes.Repeat([]byte("hello world!"), 100)
-	encoded, _ := cbrotli.Encode(content, cbrotli.WriterOptions{Quality: 5})
-	_, err := cbrotli.Decode(append(encoded, 0))
-	if err == nil {
-		t.Errorf("Expected 'excessive input' error")
-	}
-}
-
 func TestEncodeDecode(t *testing.T) {
 	for _, test := range []struct {
 		data    []byte
@@ -418,3 +346,5779 @@ func TestEncodeDecodeWithDictionary(t *testing.T) {
 			decoded, want)
 	}
 }
+
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func checkWriterClosed(t *testing.T, e *cbrotli.Writer) {
+	t.Helper()
+	if _, err := e.Write([]byte("hi")); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("Write after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+	if _, err := e.WriteString("hi"); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("WriteString after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+	if err := e.Flush(); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("Flush after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+	if _, err := e.ReadFrom(bytes.NewReader([]byte("hi"))); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("ReadFrom after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+	if err := e.Close(); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("Close after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+}
+
+func TestWriterClosed(t *testing.T) {
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5})
+	if _, err := e.WriteString("hello"); err != nil {
+		t.Fatalf("WriteString: %v", err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	checkWriterClosed(t, e)
+}
+
+func TestWriterClosedAfterFailedClose(t *testing.T) {
+	errDst := errors.New("destination failure")
+	e := cbrotli.NewWriter(failingWriter{errDst}, cbrotli.WriterOptions{Quality: 5})
+	if err := e.Close(); !errors.Is(err, errDst) {
+		t.Fatalf("Close: got %v, want %v", err, errDst)
+	}
+	checkWriterClosed(t, e)
+}
+
+func TestWriterReadFrom(t *testing.T) {
+	input := bytes.Repeat([]byte("<html><body><H1>Hello world</H1></body></html>"), 1000)
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5})
+	n, err := e.ReadFrom(bytes.NewReader(input))
+	if err != nil || int(n) != len(input) {
+		t.Errorf("ReadFrom()=%v,%v, want %v,nil", n, err, len(input))
+	}
+	if err := e.Close(); err != nil {
+		t.Errorf("Close(): %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriterResetOptions(t *testing.T) {
+	input := bytes.Repeat([]byte("<html><body><H1>Hello world</H1></body></html>"), 1000)
+	dict := input[:4096]
+	pd := cbrotli.NewPreparedDictionary(dict, cbrotli.DtRaw, 9)
+	defer pd.Close()
+
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 1})
+	// Leave some data in the encoder; ResetOptions must discard it.
+	if _, err := e.Write([]byte("discarded")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	for _, options := range []cbrotli.WriterOptions{
+		{Quality: 4},
+		{Quality: 9, LGWin: 16},
+		{Quality: 9, Dictionary: pd},
+		{Quality: 0},
+		{Quality: 11, LGWin: 24},
+	} {
+		out.Reset()
+		if err := e.ResetOptions(&out, options); err != nil {
+			t.Fatalf("ResetOptions(%+v): %v", options, err)
+		}
+		if _, err := e.Write(input); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		var decoded []byte
+		var err error
+		if options.Dictionary != nil {
+			decoded, err = cbrotli.DecodeWithRawDictionary(out.Bytes(), dict)
+		} else {
+			decoded, err = cbrotli.Decode(out.Bytes())
+		}
+		if err != nil {
+			t.Fatalf("Decode (options=%+v): %v", options, err)
+		}
+		if !bytes.Equal(decoded, input) {
+			t.Errorf("Decode (options=%+v): content mismatch", options)
+		}
+	}
+}
+
+func TestEncodeEmptyStream(t *testing.T) {
+	for _, options := range []cbrotli.WriterOptions{
+		{Quality: 0},
+		{Quality: 5},
+		{Quality: 11, LGWin: 24},
+	} {
+		out := bytes.Buffer{}
+		e := cbrotli.NewWriter(&out, options)
+		if err := e.Close(); err != nil {
+			t.Fatalf("Close (options=%+v): %v", options, err)
+		}
+		if out.Len() == 0 {
+			t.Fatalf("Close (options=%+v) produced no output", options)
+		}
+		for _, input := range [][]byte{nil, {}} {
+			encoded, err := cbrotli.Encode(input, options)
+			if err != nil {
+				t.Fatalf("Encode(%#v, %+v): %v", input, options, err)
+			}
+			if !bytes.Equal(encoded, out.Bytes()) {
+				t.Errorf("Encode(%#v, %+v)=%x, want %x", input, options, encoded, out.Bytes())
+			}
+		}
+		decoded, err := cbrotli.Decode(out.Bytes())
+		if err != nil {
+			t.Fatalf("Decode (options=%+v): %v", options, err)
+		}
+		if decoded == nil || len(decoded) != 0 {
+			t.Errorf("Decode (options=%+v)=%#v, want empty non-nil slice", options, decoded)
+		}
+	}
+}
+
+// limitedWriter accepts at most limit bytes per Write call.
+type limitedWriter struct {
+	dst   io.Writer
+	limit int
+}
+
+func (w limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		p = p[:w.limit]
+	}
+	return w.dst.Write(p)
+}
+
+func TestWriterShortWrites(t *testing.T) {
+	input := make([]byte, 100000)
+	rand.Read(input)
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(limitedWriter{&out, 7}, cbrotli.WriterOptions{Quality: 5})
+	if _, err := e.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := e.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if _, err := e.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), append(input, input...)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriterNoProgressWrite(t *testing.T) {
+	e := cbrotli.NewWriter(limitedWriter{io.Discard, 0}, cbrotli.WriterOptions{Quality: 5})
+	if err := e.Close(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Close: got %v, want %v", err, io.ErrShortWrite)
+	}
+}
+
+// failAfterWriter fails all writes starting with the n-th one (counting from 1).
+type failAfterWriter struct {
+	n     int
+	calls int
+	err   error
+}
+
+func (w *failAfterWriter) Write(p []byte) (int, error) {
+	w.calls++
+	if w.calls >= w.n {
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+func TestWriterStickyDestinationError(t *testing.T) {
+	errDst := errors.New("destination failure")
+	dst := &failAfterWriter{n: 3, err: errDst}
+	e := cbrotli.NewWriter(dst, cbrotli.WriterOptions{Quality: 5})
+	input := make([]byte, 1000)
+	var err error
+	for i := 0; i < 10; i++ {
+		rand.Read(input)
+		if _, err = e.Write(input); err != nil {
+			break
+		}
+		if err = e.Flush(); err != nil {
+			break
+		}
+	}
+	if !errors.Is(err, errDst) {
+		t.Fatalf("got %v, want %v", err, errDst)
+	}
+	calls := dst.calls
+	if _, err := e.Write(input); !errors.Is(err, errDst) {
+		t.Errorf("Write: got %v, want %v", err, errDst)
+	}
+	if err := e.Flush(); !errors.Is(err, errDst) {
+		t.Errorf("Flush: got %v, want %v", err, errDst)
+	}
+	if err := e.Close(); !errors.Is(err, errDst) {
+		t.Errorf("Close: got %v, want %v", err, errDst)
+	}
+	if dst.calls != calls {
+		t.Errorf("destination called %d more times after failure", dst.calls-calls)
+	}
+	if _, err := e.Write(input); !errors.Is(err, cbrotli.ErrWriterClosed) {
+		t.Errorf("Write after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+}
+
+func TestEncodeLevel(t *testing.T) {
+	input := bytes.Repeat([]byte("<html><body><H1>Hello world</H1></body></html>"), 100)
+	for quality := cbrotli.MinQuality; quality <= cbrotli.MaxQuality; quality++ {
+		encoded, err := cbrotli.EncodeLevel(input, quality)
+		if err != nil {
+			t.Fatalf("EncodeLevel(_, %d): %v", quality, err)
+		}
+		if err := checkCompressedData(encoded, input); err != nil {
+			t.Errorf("EncodeLevel(_, %d): %v", quality, err)
+		}
+	}
+	for _, quality := range []int{-1, cbrotli.MaxQuality + 1} {
+		if _, err := cbrotli.EncodeLevel(input, quality); err == nil {
+			t.Errorf("EncodeLevel(_, %d) succeeded, want error", quality)
+		}
+	}
+	encoded, err := cbrotli.Compress(input)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+	if err := checkCompressedData(encoded, input); err != nil {
+		t.Errorf("Compress: %v", err)
+	}
+}
+
+// streamWindowBits decodes the WBITS field of the stream header (RFC 7932,
+// section 9.1).
+func streamWindowBits(encoded []byte) int {
+	bits := uint(encoded[0])
+	if len(encoded) > 1 {
+		bits |= uint(encoded[1]) << 8
+	}
+	if bits&1 == 0 {
+		return 16
+	}
+	if n := (bits >> 1) & 7; n != 0 {
+		return 17 + int(n)
+	}
+	if m := (bits >> 4) & 7; m != 0 {
+		return 8 + int(m)
+	}
+	return 17
+}
+
+func TestWindowBitsFromSizeHint(t *testing.T) {
+	for _, test := range []struct {
+		size     int
+		options  cbrotli.WriterOptions
+		wantBits int
+	}{
+		{100, cbrotli.WriterOptions{Quality: 5}, 10},
+		{3000, cbrotli.WriterOptions{Quality: 5}, 12},
+		{4080, cbrotli.WriterOptions{Quality: 5}, 12},
+		{4081, cbrotli.WriterOptions{Quality: 5}, 13},
+		{100000, cbrotli.WriterOptions{Quality: 9}, 17},
+		{5 << 20, cbrotli.WriterOptions{Quality: 1}, 22},
+		{3000, cbrotli.WriterOptions{Quality: 5, LGWin: 20}, 20},
+	} {
+		input := make([]byte, test.size)
+		for i := range input {
+			input[i] = byte(i*7 + i*i*5)
+		}
+		encoded, err := cbrotli.Encode(input, test.options)
+		if err != nil {
+			t.Fatalf("Encode(<%d bytes>, %+v): %v", test.size, test.options, err)
+		}
+		if got := streamWindowBits(encoded); got != test.wantBits {
+			t.Errorf("Encode(<%d bytes>, %+v): window bits %d, want %d",
+				test.size, test.options, got, test.wantBits)
+		}
+		if err := checkCompressedData(encoded, input); err != nil {
+			t.Error(err)
+		}
+
+		options := test.options
+		options.SizeHint = test.size
+		out := bytes.Buffer{}
+		e := cbrotli.NewWriter(&out, options)
+		if got := e.WindowBits(); got != test.wantBits {
+			t.Errorf("NewWriter(%+v).WindowBits()=%d, want %d", options, got, test.wantBits)
+		}
+		if _, err := e.Write(input); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if got := streamWindowBits(out.Bytes()); got != test.wantBits {
+			t.Errorf("Writer(%+v): window bits %d, want %d", options, got, test.wantBits)
+		}
+	}
+	e := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 5})
+	defer e.Close()
+	if got := e.WindowBits(); got != 22 {
+		t.Errorf("WindowBits() without hint = %d, want 22", got)
+	}
+}
+
+func TestWriterAppendable(t *testing.T) {
+	sessions := [][]byte{
+		bytes.Repeat([]byte("first session; "), 1000),
+		bytes.Repeat([]byte("second session! "), 2000),
+		bytes.Repeat([]byte("third and last session. "), 500),
+	}
+	var file []byte
+	var offset int64
+	for i, input := range sessions {
+		out := bytes.Buffer{}
+		e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5, LGWin: 18, StreamOffset: offset})
+		if _, err := e.Write(input); err != nil {
+			t.Fatalf("session %d: Write: %v", i, err)
+		}
+		last := i == len(sessions)-1
+		var err error
+		if last {
+			err = e.Close()
+		} else {
+			err = e.CloseAppendable()
+		}
+		if err != nil {
+			t.Fatalf("session %d: close: %v", i, err)
+		}
+		file = append(file, out.Bytes()...)
+		offset += int64(len(input))
+		if last {
+			break
+		}
+		// Unfinished file is decodable up to the end of written data.
+		r := cbrotli.NewReader(bytes.NewReader(file))
+		decoded, err := io.ReadAll(r)
+		r.Close()
+		if err != cbrotli.ErrTruncated {
+			t.Errorf("session %d: ReadAll error %v, want %v", i, err, cbrotli.ErrTruncated)
+		}
+		if want := bytes.Join(sessions[:i+1], nil); !bytes.Equal(decoded, want) {
+			t.Errorf("session %d: decoded %d bytes, want %d", i, len(decoded), len(want))
+		}
+	}
+	if err := checkCompressedData(file, bytes.Join(sessions, nil)); err != nil {
+		t.Error(err)
+	}
+}
+
+// mixedCorpus interleaves compressible text with random ("JPEG-like") blocks.
+func mixedCorpus() (data []byte, randomBytes int) {
+	text := bytes.Repeat([]byte("<html><body><H1>Hello world</H1><p>lorem ipsum</p></body></html>\n"), 2000)
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < 4; i++ {
+		data = append(data, text...)
+		noise := make([]byte, 256*1024)
+		src.Read(noise)
+		data = append(data, noise...)
+		randomBytes += len(noise)
+	}
+	return data, randomBytes
+}
+
+func TestWriterDetectIncompressible(t *testing.T) {
+	input, randomBytes := mixedCorpus()
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 9, LGWin: 22, DetectIncompressible: true})
+	// Uneven writes to exercise chunking.
+	for p := input; len(p) > 0; {
+		n := min(len(p), 100000)
+		if _, err := e.Write(p[:n]); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		p = p[n:]
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Fatal(err)
+	}
+	stats := e.Stats()
+	if stats.BytesIn != int64(len(input)) || stats.BytesOut != int64(out.Len()) {
+		t.Errorf("Stats()=%+v, want BytesIn=%d, BytesOut=%d", stats, len(input), out.Len())
+	}
+	// Chunk boundaries do not match block boundaries exactly.
+	if got := stats.IncompressibleBytes; got < int64(randomBytes)/2 || got > int64(randomBytes)*3/2 {
+		t.Errorf("IncompressibleBytes=%d, want about %d", got, randomBytes)
+	}
+}
+
+func BenchmarkWriterMixedCorpus(b *testing.B) {
+	input, _ := mixedCorpus()
+	for _, detect := range []bool{false, true} {
+		b.Run(fmt.Sprintf("detect=%v", detect), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			for i := 0; i < b.N; i++ {
+				e := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 9, DetectIncompressible: detect})
+				if _, err := e.Write(input); err != nil {
+					b.Fatal(err)
+				}
+				if err := e.Close(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestWriterSetQuality(t *testing.T) {
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 9, LGWin: 20})
+	var input []byte
+	sizes := map[int]int64{}
+	for i, quality := range []int{9, 2, 9, 0, 5} {
+		if err := e.SetQuality(quality); err != nil {
+			t.Fatalf("SetQuality(%d): %v", quality, err)
+		}
+		segment := wordSoup(int64(i), 200000)
+		before := e.Stats().BytesOut
+		if _, err := e.Write(segment); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := e.Flush(); err != nil {
+			t.Fatalf("Flush: %v", err)
+		}
+		sizes[quality] = e.Stats().BytesOut - before
+		input = append(input, segment...)
+	}
+	if err := e.SetQuality(cbrotli.MaxQuality + 1); err == nil {
+		t.Error("SetQuality accepted out of range quality")
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Fatal(err)
+	}
+	if sizes[0] <= sizes[9]*11/10 || sizes[2] <= sizes[9]*11/10 {
+		t.Errorf("segment sizes %v do not depend on quality", sizes)
+	}
+	if err := e.SetQuality(5); err != cbrotli.ErrWriterClosed {
+		t.Errorf("SetQuality after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+}
+
+// writeRecords writes short formatted records, flushing now and then.
+func writeRecords(e *cbrotli.Writer, records int) error {
+	for i := 0; i < records; i++ {
+		if _, err := fmt.Fprintf(e, "rec %6d;", i); err != nil {
+			return err
+		}
+		if i%5000 == 4999 {
+			if err := e.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return e.Close()
+}
+
+func TestWriterWriteBuffer(t *testing.T) {
+	const records = 20000
+	encode := func(bufferSize int) ([]byte, int64) {
+		out := bytes.Buffer{}
+		e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5, WriteBufferSize: bufferSize})
+		calls := runtime.NumCgoCall()
+		if err := writeRecords(e, records); err != nil {
+			t.Fatalf("WriteBufferSize %d: %v", bufferSize, err)
+		}
+		return out.Bytes(), runtime.NumCgoCall() - calls
+	}
+	want, unbufferedCalls := encode(0)
+	got, bufferedCalls := encode(4096)
+	if !bytes.Equal(got, want) {
+		t.Errorf("buffered output differs from unbuffered")
+	}
+	if bufferedCalls*10 > unbufferedCalls {
+		t.Errorf("buffered writes made %d cgo calls, unbuffered %d", bufferedCalls, unbufferedCalls)
+	}
+
+	// Writes longer than the buffer bypass it.
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5, WriteBufferSize: 16})
+	input := bytes.Repeat([]byte("0123456789"), 1000)
+	e.Write(input[:10])
+	e.Write(input[10:])
+	if got := e.Stats().BytesIn; got != int64(len(input)) {
+		t.Errorf("BytesIn=%d after long write, want %d", got, len(input))
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkWriterSmallWrites(b *testing.B) {
+	for _, size := range []int{0, 4096} {
+		b.Run(fmt.Sprintf("buffer=%d", size), func(b *testing.B) {
+			chunk := []byte("sixteen bytes!!\n")
+			calls := runtime.NumCgoCall()
+			for i := 0; i < b.N; i++ {
+				e := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 1, WriteBufferSize: size})
+				for j := 0; j < 1000; j++ {
+					e.Write(chunk)
+				}
+				e.Close()
+			}
+			b.ReportMetric(float64(runtime.NumCgoCall()-calls)/float64(b.N), "cgocalls/op")
+			b.SetBytes(int64(1000 * len(chunk)))
+		})
+	}
+}
+
+// TestWriterWriteAllocs checks that steady-state writes do not allocate.
+func TestWriterWriteAllocs(t *testing.T) {
+	for _, size := range []int{16, 256 << 10} {
+		input := wordSoup(187, size)
+		s := string(input)
+		for _, options := range []cbrotli.WriterOptions{
+			{Quality: 1},
+			{Quality: 5},
+			{Quality: 5, WriteBufferSize: 4096},
+			{Quality: 5, DetectIncompressible: true},
+			{Quality: 5, FlushInterval: time.Hour},
+		} {
+			w := cbrotli.NewWriter(io.Discard, options)
+			// The first writes allocate the buffers of the encoder.
+			for i := 0; i < 10; i++ {
+				w.Write(input)
+			}
+			if allocs := testing.AllocsPerRun(100, func() { w.Write(input) }); allocs != 0 {
+				t.Errorf("%d bytes, %+v: Write made %v allocations", size, options, allocs)
+			}
+			if allocs := testing.AllocsPerRun(100, func() { w.WriteString(s) }); allocs != 0 {
+				t.Errorf("%d bytes, %+v: WriteString made %v allocations", size, options, allocs)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkWriterWrite(b *testing.B) {
+	for _, size := range []int{16, 64 << 10} {
+		input := wordSoup(187, size)
+		s := string(input)
+		b.Run(fmt.Sprintf("Write/size=%d", size), func(b *testing.B) {
+			w := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 1})
+			defer w.Close()
+			b.ReportAllocs()
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				w.Write(input)
+			}
+		})
+		b.Run(fmt.Sprintf("WriteString/size=%d", size), func(b *testing.B) {
+			w := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 1})
+			defer w.Close()
+			b.ReportAllocs()
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				w.WriteString(s)
+			}
+		})
+	}
+}
+
+func TestWriterFinished(t *testing.T) {
+	out := bytes.Buffer{}
+	e := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5})
+	e.Write([]byte("hello"))
+	if e.Finished() || e.HasMoreOutput() {
+		t.Errorf("open Writer: Finished()=%v, HasMoreOutput()=%v", e.Finished(), e.HasMoreOutput())
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if !e.Finished() || e.HasMoreOutput() {
+		t.Errorf("closed Writer: Finished()=%v, HasMoreOutput()=%v", e.Finished(), e.HasMoreOutput())
+	}
+
+	e = cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 5})
+	e.Write([]byte("hello"))
+	if err := e.CloseAppendable(); err != nil {
+		t.Fatalf("CloseAppendable: %v", err)
+	}
+	if e.Finished() {
+		t.Error("Finished() after CloseAppendable")
+	}
+
+	// Destination fails before the stream is completed.
+	e = cbrotli.NewWriter(&failAfterWriter{n: 0, err: errors.New("disk full")}, cbrotli.WriterOptions{Quality: 5})
+	e.Write([]byte("hello"))
+	if err := e.Close(); err == nil {
+		t.Fatal("Close succeeded on failing destination")
+	}
+	if e.Finished() || e.HasMoreOutput() {
+		t.Errorf("failed Close: Finished()=%v, HasMoreOutput()=%v", e.Finished(), e.HasMoreOutput())
+	}
+}
+
+func TestBufferWriter(t *testing.T) {
+	input := wordSoup(1, 100000)
+	b := cbrotli.NewBufferWriter(cbrotli.WriterOptions{Quality: 5, SizeHint: len(input)})
+	for i := 0; i < 2; i++ {
+		if _, err := b.Write(input); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if b.Bytes() != nil {
+			t.Error("Bytes() is not nil before Close")
+		}
+		if err := b.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		want, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, SizeHint: len(input)})
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		if !bytes.Equal(b.Bytes(), want) {
+			t.Errorf("BufferWriter output differs from Encode")
+		}
+		if err := b.Reset(); err != nil {
+			t.Fatalf("Reset: %v", err)
+		}
+	}
+	b.Close()
+}
+
+func TestParallelWriter(t *testing.T) {
+	input := wordSoup(2, 1000000)
+	for _, chunkSize := range []int{100000, 999999, 1000000, 4 << 20} {
+		out := bytes.Buffer{}
+		e := cbrotli.NewParallelWriter(&out, cbrotli.WriterOptions{Quality: 5, LGWin: 18, ChunkSize: chunkSize}, 4)
+		for p := input; len(p) > 0; {
+			n := min(len(p), 77777)
+			if _, err := e.Write(p[:n]); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			p = p[n:]
+			if len(p) < 300000 && len(p)+n >= 300000 {
+				if err := e.Flush(); err != nil {
+					t.Fatalf("Flush: %v", err)
+				}
+			}
+		}
+		if err := e.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if err := checkCompressedData(out.Bytes(), input); err != nil {
+			t.Errorf("ChunkSize %d: %v", chunkSize, err)
+		}
+		if _, err := e.Write(input); err != cbrotli.ErrWriterClosed {
+			t.Errorf("Write after Close: got %v, want %v", err, cbrotli.ErrWriterClosed)
+		}
+	}
+
+	// Empty stream.
+	out := bytes.Buffer{}
+	if err := cbrotli.NewParallelWriter(&out, cbrotli.WriterOptions{Quality: 5}, 2).Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParallelWriterError(t *testing.T) {
+	want := errors.New("disk full")
+	e := cbrotli.NewParallelWriter(&failAfterWriter{n: 2, err: want}, cbrotli.WriterOptions{Quality: 2, ChunkSize: 10000}, 4)
+	e.Write(wordSoup(3, 100000))
+	if err := e.Close(); err != want {
+		t.Errorf("Close: got %v, want %v", err, want)
+	}
+	e = cbrotli.NewParallelWriter(io.Discard, cbrotli.WriterOptions{Quality: 42}, 4)
+	if _, err := e.Write([]byte("x")); err == nil {
+		t.Error("Write succeeded with invalid options")
+	}
+	if err := e.Close(); err == nil {
+		t.Error("Close succeeded with invalid options")
+	}
+	e = cbrotli.NewParallelWriter(limitedWriter{io.Discard, 0}, cbrotli.WriterOptions{Quality: 2, ChunkSize: 10000}, 4)
+	e.Write(wordSoup(3, 100000))
+	if err := e.Close(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Close: got %v, want %v", err, io.ErrShortWrite)
+	}
+}
+
+func TestParallelWriterShortWrites(t *testing.T) {
+	input := wordSoup(5, 100000)
+	var out bytes.Buffer
+	e := cbrotli.NewParallelWriter(limitedWriter{&out, 7}, cbrotli.WriterOptions{Quality: 5, ChunkSize: 20000}, 4)
+	if _, err := e.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkParallelWriter(b *testing.B) {
+	input := wordSoup(4, 32<<20)
+	for _, concurrency := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			for i := 0; i < b.N; i++ {
+				e := cbrotli.NewParallelWriter(io.Discard, cbrotli.WriterOptions{Quality: 9, LGWin: 22}, concurrency)
+				e.Write(input)
+				if err := e.Close(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCompressBatch(t *testing.T) {
+	inputs := batchInputs(200)
+	options := cbrotli.WriterOptions{Quality: 5}
+	outputs, err := cbrotli.CompressBatch(inputs, options, 4)
+	if err != nil {
+		t.Fatalf("CompressBatch: %v", err)
+	}
+	for i, input := range inputs {
+		want, err := cbrotli.Encode(input, options)
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		if !bytes.Equal(outputs[i], want) {
+			t.Errorf("item %d: output differs from Encode", i)
+		}
+	}
+
+	outputs[3] = []byte("garbage")
+	outputs[150] = outputs[150][:len(outputs[150])/2]
+	decoded, err := cbrotli.DecompressBatch(outputs, 4)
+	var batchErr *cbrotli.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("DecompressBatch: got %v, want *BatchError", err)
+	}
+	if len(batchErr.Items) != 2 || batchErr.Items[0].Index != 3 || batchErr.Items[1].Index != 150 {
+		t.Errorf("failed items: %v", batchErr.Items)
+	}
+	for i, input := range inputs {
+		if i == 3 || i == 150 {
+			if decoded[i] != nil {
+				t.Errorf("item %d: output of failed item is not nil", i)
+			}
+			continue
+		}
+		if !bytes.Equal(decoded[i], input) {
+			t.Errorf("item %d: decoded output differs from input", i)
+		}
+	}
+
+	if _, err := cbrotli.CompressBatch(inputs, cbrotli.WriterOptions{Quality: 12}, 4); err == nil {
+		t.Error("CompressBatch accepted invalid options")
+	}
+}
+
+func BenchmarkCompressBatch(b *testing.B) {
+	inputs := batchInputs(1000)
+	options := cbrotli.WriterOptions{Quality: 5}
+	var size int64
+	for _, input := range inputs {
+		size += int64(len(input))
+	}
+	b.Run("batch", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		for i := 0; i < b.N; i++ {
+			if _, err := cbrotli.CompressBatch(inputs, options, 8); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("goroutine-per-item", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		for i := 0; i < b.N; i++ {
+			outputs := make([][]byte, len(inputs))
+			done := make(chan struct{})
+			for j := range inputs {
+				go func(j int) {
+					var buf bytes.Buffer
+					w := cbrotli.NewWriter(&buf, options)
+					w.Write(inputs[j])
+					w.Close()
+					outputs[j] = buf.Bytes()
+					done <- struct{}{}
+				}(j)
+			}
+			for range inputs {
+				<-done
+			}
+		}
+	})
+}
+
+func TestJoinStreams(t *testing.T) {
+	src := rand.New(rand.NewSource(5))
+	for _, count := range []int{2, 3, 17, 100} {
+		var parts [][]byte
+		var want []byte
+		for i := 0; i < count; i++ {
+			var content []byte
+			if src.Intn(4) != 0 {
+				content = wordSoup(int64(i), src.Intn(50000))
+			}
+			// Varying windows and qualities.
+			options := cbrotli.WriterOptions{Quality: src.Intn(12), LGWin: 10 + src.Intn(15)}
+			part, err := cbrotli.Encode(content, options)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			parts = append(parts, part)
+			want = append(want, content...)
+		}
+		var joined bytes.Buffer
+		// Partial writes are retried.
+		if err := cbrotli.JoinStreams(limitedWriter{&joined, 1000}, parts...); err != nil {
+			t.Fatalf("JoinStreams: %v", err)
+		}
+		r := cbrotli.NewReaderWithOptions(&joined, cbrotli.ReaderOptions{Multistream: true})
+		got, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("%d parts: ReadAll: %v", count, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%d parts: decoded %d bytes, want %d", count, len(got), len(want))
+		}
+	}
+
+	good, _ := cbrotli.Encode([]byte("hello"), cbrotli.WriterOptions{Quality: 5})
+	for _, bad := range [][]byte{nil, good[:len(good)-1], append(good[:len(good):len(good)], 0)} {
+		var out bytes.Buffer
+		if err := cbrotli.JoinStreams(&out, good, bad); err == nil {
+			t.Errorf("JoinStreams accepted invalid part %x", bad)
+		}
+		if out.Len() != 0 {
+			t.Errorf("JoinStreams wrote output despite invalid part")
+		}
+	}
+	if err := cbrotli.JoinStreams(limitedWriter{io.Discard, 0}, good); err != io.ErrShortWrite {
+		t.Errorf("JoinStreams with no progress: got %v, want %v", err, io.ErrShortWrite)
+	}
+}
+
+func TestSeekableWriter(t *testing.T) {
+	input := wordSoup(6, 300000)
+	for _, size := range []int{0, 1, 1000, 65536, len(input)} {
+		out := bytes.Buffer{}
+		e := cbrotli.NewSeekableWriter(&out, cbrotli.WriterOptions{Quality: 5, ChunkSize: 40000})
+		if _, err := e.Write(input[:size]); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		// Plain readers skip the index.
+		if err := checkCompressedData(out.Bytes(), input[:size]); err != nil {
+			t.Errorf("size %d: %v", size, err)
+		}
+		file := out.Bytes()
+		if last := file[len(file)-1]; last != 0x03 {
+			t.Errorf("size %d: last byte %#x, want 0x03", size, last)
+		}
+		if magic := string(file[len(file)-5 : len(file)-1]); magic != "BrSk" {
+			t.Errorf("size %d: magic %q, want %q", size, magic, "BrSk")
+		}
+	}
+}
+
+func seekableFile(t testing.TB, input []byte, options cbrotli.WriterOptions) []byte {
+	t.Helper()
+	out := bytes.Buffer{}
+	e := cbrotli.NewSeekableWriter(&out, options)
+	if _, err := e.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return out.Bytes()
+}
+
+func TestSeekableReader(t *testing.T) {
+	input := wordSoup(7, 500000)
+	for _, options := range []cbrotli.WriterOptions{
+		{Quality: 1, ChunkSize: 30000},
+		{Quality: 6, ChunkSize: 64 << 10},
+		{Quality: 9, ChunkSize: 100000, LGWin: 16},
+	} {
+		file := seekableFile(t, input, options)
+		r, err := cbrotli.NewSeekableReader(bytes.NewReader(file), int64(len(file)))
+		if err != nil {
+			t.Fatalf("NewSeekableReader: %v", err)
+		}
+		if r.Size() != int64(len(input)) {
+			t.Errorf("Size()=%d, want %d", r.Size(), len(input))
+		}
+		src := rand.New(rand.NewSource(8))
+		for i := 0; i < 100; i++ {
+			// Reads spanning several frames.
+			off := src.Intn(len(input))
+			buf := make([]byte, src.Intn(150000))
+			n, err := r.ReadAt(buf, int64(off))
+			want := input[off:min(len(input), off+len(buf))]
+			if n != len(want) || !bytes.Equal(buf[:n], want) {
+				t.Fatalf("%+v: ReadAt(%d bytes, %d): content mismatch", options, len(buf), off)
+			}
+			if len(want) < len(buf) && err != io.EOF {
+				t.Errorf("ReadAt past the end: got %v, want %v", err, io.EOF)
+			} else if len(want) == len(buf) && err != nil {
+				t.Errorf("ReadAt: %v", err)
+			}
+		}
+		if n, err := r.ReadAt(make([]byte, 1), int64(len(input))); n != 0 || err != io.EOF {
+			t.Errorf("ReadAt at EOF: got %d, %v", n, err)
+		}
+
+		if _, err := r.Seek(-1000, io.SeekEnd); err != nil {
+			t.Fatalf("Seek: %v", err)
+		}
+		tail, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(tail, input[len(input)-1000:]) {
+			t.Errorf("ReadAll after Seek: %d bytes, %v", len(tail), err)
+		}
+	}
+}
+
+func TestSeekableReaderConcurrent(t *testing.T) {
+	input := wordSoup(9, 400000)
+	file := seekableFile(t, input, cbrotli.WriterOptions{Quality: 5, ChunkSize: 20000})
+	r, err := cbrotli.NewSeekableReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("NewSeekableReader: %v", err)
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			src := rand.New(rand.NewSource(seed))
+			buf := make([]byte, 30000)
+			for i := 0; i < 50; i++ {
+				off := src.Intn(len(input) - len(buf))
+				if _, err := r.ReadAt(buf, int64(off)); err != nil || !bytes.Equal(buf, input[off:off+len(buf)]) {
+					t.Errorf("ReadAt(%d): mismatch or %v", off, err)
+					return
+				}
+			}
+		}(int64(g))
+	}
+	wg.Wait()
+}
+
+func BenchmarkReaderDictionary(b *testing.B) {
+	dictionary := wordSoup(12, 1<<20)
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	encoded, err := cbrotli.Encode(dictionary[1000:3000], cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	if err != nil {
+		b.Fatal(err)
+	}
+	decode := func(b *testing.B, newReader func() *cbrotli.Reader) {
+		for i := 0; i < b.N; i++ {
+			r := newReader()
+			if _, err := io.Copy(io.Discard, r); err != nil {
+				b.Fatal(err)
+			}
+			r.Close()
+		}
+	}
+	b.Run("raw", func(b *testing.B) {
+		decode(b, func() *cbrotli.Reader {
+			return cbrotli.NewReaderWithRawDictionary(bytes.NewReader(encoded), dictionary)
+		})
+	})
+	b.Run("decoder-dictionary", func(b *testing.B) {
+		dd, err := cbrotli.NewDecoderDictionary(dictionary)
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer dd.Close()
+		decode(b, func() *cbrotli.Reader {
+			return cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+		})
+	})
+}
+
+func TestGenerateDictionary(t *testing.T) {
+	src := rand.New(rand.NewSource(13))
+	var training, heldOut [][]byte
+	for i := 0; i < 300; i++ {
+		training = append(training, apiResponse(src))
+	}
+	for i := 0; i < 50; i++ {
+		heldOut = append(heldOut, apiResponse(src))
+	}
+	dictionary, err := cbrotli.GenerateDictionary(training, 4096)
+	if err != nil {
+		t.Fatalf("GenerateDictionary: %v", err)
+	}
+	if len(dictionary) == 0 || len(dictionary) > 4096 {
+		t.Fatalf("dictionary size %d", len(dictionary))
+	}
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 9)
+	defer pd.Close()
+	var plain, withDictionary int
+	for _, sample := range heldOut {
+		encoded, err := cbrotli.Encode(sample, cbrotli.WriterOptions{Quality: 9})
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		plain += len(encoded)
+		encoded, err = cbrotli.Encode(sample, cbrotli.WriterOptions{Quality: 9, Dictionary: pd})
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		withDictionary += len(encoded)
+		decoded, err := cbrotli.DecodeWithRawDictionary(encoded, dictionary)
+		if err != nil || !bytes.Equal(decoded, sample) {
+			t.Fatalf("DecodeWithRawDictionary: %v", err)
+		}
+	}
+	if withDictionary > plain*7/10 {
+		t.Errorf("held-out samples: %d bytes with dictionary, %d without", withDictionary, plain)
+	}
+
+	if _, err := cbrotli.GenerateDictionary(nil, 4096); err == nil {
+		t.Error("GenerateDictionary accepted no samples")
+	}
+	if _, err := cbrotli.GenerateDictionaryWithOptions(training, 4096, cbrotli.GeneratorOptions{Weights: []float64{1}}); err == nil {
+		t.Error("GenerateDictionaryWithOptions accepted mismatched weights")
+	}
+}
+
+// requireSerializedDictionaries skips the test if C-Brotli is compiled without
+// BROTLI_EXPERIMENTAL, which serialized dictionaries need.
+func requireSerializedDictionaries(t *testing.T) {
+	t.Helper()
+	d, err := cbrotli.NewSerializedDecoderDictionary([]byte{0x91, 0, 1, 'x', 0, 0})
+	if err != nil {
+		t.Skip("serialized dictionaries are not supported by C-Brotli")
+	}
+	d.Close()
+}
+
+func TestBuildSerializedDictionary(t *testing.T) {
+	requireSerializedDictionaries(t)
+	raw := wordSoup(15, 50000)
+	input := bytes.Clone(raw[10000:30000])
+	words := [][]byte{[]byte("zyxwvutsrq"), []byte("qponmlkj"), []byte("ihgfedcbazyx")}
+	for _, tc := range []struct {
+		name    string
+		raw     []byte
+		options cbrotli.SerializedDictionaryOptions
+	}{
+		{"raw", raw, cbrotli.SerializedDictionaryOptions{}},
+		{"words", raw, cbrotli.SerializedDictionaryOptions{Words: words}},
+		{"identity", raw, cbrotli.SerializedDictionaryOptions{Words: words, IdentityTransformOnly: true}},
+		{"words only", nil, cbrotli.SerializedDictionaryOptions{Words: words}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			serialized, err := cbrotli.BuildSerializedDictionary(tc.raw, tc.options)
+			if err != nil {
+				t.Fatalf("BuildSerializedDictionary: %v", err)
+			}
+			pd := cbrotli.NewPreparedDictionary(serialized, cbrotli.DtSerialized, 5)
+			defer pd.Close()
+			encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			if tc.raw != nil && len(encoded) > len(input)/20 {
+				t.Errorf("encoded to %d bytes; the dictionary is not used", len(encoded))
+			}
+			dd, err := cbrotli.NewSerializedDecoderDictionary(serialized)
+			if err != nil {
+				t.Fatalf("NewSerializedDecoderDictionary: %v", err)
+			}
+			defer dd.Close()
+			r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+			defer r.Close()
+			decoded, err := io.ReadAll(r)
+			if err != nil || !bytes.Equal(decoded, input) {
+				t.Errorf("decoded %d bytes, %v", len(decoded), err)
+			}
+		})
+	}
+}
+
+func TestWriterWithDictionaries(t *testing.T) {
+	global := wordSoup(16, 60000)
+	customer := wordSoup(17, 60000)
+	dictionaries := []cbrotli.Dictionary{
+		{Data: global, Type: cbrotli.DtRaw},
+		{Data: customer, Type: cbrotli.DtRaw},
+	}
+	encode := func(input []byte, dictionaries []cbrotli.Dictionary) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		w := cbrotli.NewWriterWithDictionaries(&buf, cbrotli.WriterOptions{Quality: 5}, dictionaries)
+		if _, err := w.Write(input); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		return buf.Bytes()
+	}
+	for name, input := range map[string][]byte{
+		"global":   bytes.Clone(global[20000:40000]),
+		"customer": bytes.Clone(customer[5000:25000]),
+		"both":     append(bytes.Clone(global[1000:11000]), customer[40000:50000]...),
+	} {
+		plain := encode(input, nil)
+		encoded := encode(input, dictionaries)
+		if len(encoded) > len(plain)/10 {
+			t.Errorf("%s: encoded to %d bytes with dictionaries, %d without", name, len(encoded), len(plain))
+		}
+		r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded),
+			cbrotli.ReaderOptions{Dictionaries: dictionaries})
+		decoded, err := io.ReadAll(r)
+		r.Close()
+		if err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("%s: decoded %d bytes, %v", name, len(decoded), err)
+		}
+	}
+}
+
+func TestOpenDictionaryFile(t *testing.T) {
+	dictionary := wordSoup(19, 100000)
+	path := filepath.Join(t.TempDir(), "dictionary.bin")
+	if err := os.WriteFile(path, dictionary, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pd, err := cbrotli.OpenPreparedDictionaryFile(path, cbrotli.DtRaw, 5)
+	if err != nil {
+		if runtime.GOOS == "windows" || runtime.GOOS == "plan9" || runtime.GOOS == "js" {
+			t.Skipf("OpenPreparedDictionaryFile: %v", err)
+		}
+		t.Fatalf("OpenPreparedDictionaryFile: %v", err)
+	}
+	input := bytes.Clone(dictionary[30000:60000])
+	var buf bytes.Buffer
+	w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	if _, err := w.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := pd.Close(); err == nil {
+		t.Error("PreparedDictionary.Close succeeded while a Writer is open")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := pd.Close(); err != nil {
+		t.Errorf("PreparedDictionary.Close: %v", err)
+	}
+	if buf.Len() > len(input)/20 {
+		t.Errorf("encoded to %d bytes; the dictionary is not used", buf.Len())
+	}
+
+	dd, err := cbrotli.OpenDictionaryFile(path)
+	if err != nil {
+		t.Fatalf("OpenDictionaryFile: %v", err)
+	}
+	r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(buf.Bytes()), dd)
+	decoded, err := io.ReadAll(r)
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoded %d bytes, %v", len(decoded), err)
+	}
+	if err := dd.Close(); err == nil {
+		t.Error("DecoderDictionary.Close succeeded while a Reader is open")
+	}
+	r.Close()
+	if err := dd.Close(); err != nil {
+		t.Errorf("DecoderDictionary.Close: %v", err)
+	}
+
+	if _, err := cbrotli.OpenDictionaryFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("OpenDictionaryFile succeeded for a missing file")
+	}
+}
+
+func TestDictionaryHandler(t *testing.T) {
+	dictionary := wordSoup(21, 50000)
+	body := bytes.Clone(dictionary[10000:30000])
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	dd, err := cbrotli.NewDecoderDictionary(dictionary)
+	if err != nil {
+		t.Fatalf("NewDecoderDictionary: %v", err)
+	}
+	defer dd.Close()
+	handler := cbrotli.NewDictionaryHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Length", fmt.Sprint(len(body)))
+		rw.Write(body)
+	}), cbrotli.WriterOptions{Quality: 5}, pd)
+
+	id := cbrotli.FormatDictionaryID(pd.ID())
+	other := cbrotli.FormatDictionaryID(cbrotli.DictionaryID([]byte("other")))
+	for _, tc := range []struct {
+		accept, available, encoding string
+	}{
+		{"gzip, dcb, br", id, "dcb"},
+		{"br, dcb;q=0", id, "br"},
+		{"dcb, br", other, "br"},
+		{"br", id, "br"},
+		{"gzip", id, ""},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept-Encoding", tc.accept)
+		req.Header.Set("Available-Dictionary", tc.available)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		resp := rec.Result()
+		if got := resp.Header.Get("Content-Encoding"); got != tc.encoding {
+			t.Errorf("%q: Content-Encoding=%q, want %q", tc.accept, got, tc.encoding)
+			continue
+		}
+		if got := resp.Header.Get("Vary"); got != "Accept-Encoding, Available-Dictionary" {
+			t.Errorf("%q: Vary=%q", tc.accept, got)
+		}
+		encoded := rec.Body.Bytes()
+		var decoded []byte
+		switch tc.encoding {
+		case "dcb":
+			want := pd.ID()
+			if len(encoded) < 36 || string(encoded[:4]) != "\xffDCB" || !bytes.Equal(encoded[4:36], want[:]) {
+				t.Errorf("%q: invalid dcb header %x", tc.accept, encoded[:min(36, len(encoded))])
+				continue
+			}
+			r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded[36:]), dd)
+			decoded, err = io.ReadAll(r)
+			r.Close()
+		case "br":
+			decoded, err = cbrotli.Decode(encoded)
+		default:
+			decoded = encoded
+		}
+		if err != nil || !bytes.Equal(decoded, body) {
+			t.Errorf("%q: decoded %d bytes, %v", tc.accept, len(decoded), err)
+		}
+	}
+}
+
+// dcbFixture is a response body in the format of RFC 9842: the "\xffDCB"
+// magic, the SHA-256 of dcbFixtureDictionary and a Brotli stream.
+const (
+	dcbFixture = "ff444342e86cf4c78b8db66aeb6b269a2cb60975984b6cca84b6c2a97e9a4f09" +
+		"36a2dd87a1f801c02f11924128624c087113"
+	dcbFixtureDictionary = "A dictionary for compression dictionary transport. "
+	dcbFixtureContent    = "A dictionary for compression dictionary transport. A dictionary!"
+)
+
+func TestDictionaryTransport(t *testing.T) {
+	fixture, _ := hex.DecodeString(dcbFixture)
+	dd, err := cbrotli.NewDecoderDictionary([]byte(dcbFixtureDictionary))
+	if err != nil {
+		t.Fatalf("NewDecoderDictionary: %v", err)
+	}
+	defer dd.Close()
+	id := cbrotli.FormatDictionaryID(dd.ID())
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Header.Get("Available-Dictionary") == id:
+			rw.Header().Set("Content-Encoding", "dcb")
+			rw.Write(fixture)
+		case req.URL.Path == "/plain":
+			rw.Write([]byte(dcbFixtureContent))
+		default:
+			rw.Header().Set("Content-Encoding", "br")
+			encoded, _ := cbrotli.Encode([]byte(dcbFixtureContent), cbrotli.WriterOptions{Quality: 5})
+			rw.Write(encoded)
+		}
+	}))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		path       string
+		dictionary *cbrotli.DecoderDictionary
+	}{
+		{"/", dd},
+		{"/", nil},
+		{"/plain", nil},
+	} {
+		client := &http.Client{Transport: &cbrotli.DictionaryTransport{
+			Dictionary: func(*http.Request) *cbrotli.DecoderDictionary { return tc.dictionary },
+		}}
+		resp, err := client.Get(server.URL + tc.path)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		got, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil || string(got) != dcbFixtureContent {
+			t.Errorf("%s (dictionary %v): got %q, %v", tc.path, tc.dictionary != nil, got, err)
+		}
+		if resp.Header.Get("Content-Encoding") != "" {
+			t.Errorf("%s: Content-Encoding %q not removed", tc.path, resp.Header.Get("Content-Encoding"))
+		}
+	}
+
+	// The transport decodes what the handler produces.
+	pd := cbrotli.NewPreparedDictionary([]byte(dcbFixtureDictionary), cbrotli.DtRaw, 11)
+	defer pd.Close()
+	server2 := httptest.NewServer(cbrotli.NewDictionaryHandler(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			rw.Write([]byte(dcbFixtureContent))
+		}), cbrotli.WriterOptions{Quality: 11}, pd))
+	defer server2.Close()
+	client := &http.Client{Transport: &cbrotli.DictionaryTransport{
+		Dictionary: func(*http.Request) *cbrotli.DecoderDictionary { return dd },
+	}}
+	resp, err := client.Get(server2.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || string(got) != dcbFixtureContent {
+		t.Errorf("got %q, %v", got, err)
+	}
+}
+
+func TestPreparedDictionaryReprepare(t *testing.T) {
+	dictionary := wordSoup(22, 50000)
+	input := bytes.Clone(dictionary[5000:25000])
+	pd, err := cbrotli.PrepareDictionary(dictionary, cbrotli.DtRaw, cbrotli.PrepareDictionaryOptions{Quality: 5})
+	if err != nil {
+		t.Fatalf("PrepareDictionary: %v", err)
+	}
+	if err := pd.Reprepare(11); err != nil {
+		t.Fatalf("Reprepare: %v", err)
+	}
+	for _, tc := range []struct {
+		quality, setQuality, want int
+	}{
+		{5, 5, 5},
+		{11, 11, 11},
+		{9, 9, 5},
+		{9, 11, 11},
+	} {
+		var buf bytes.Buffer
+		w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: tc.quality, Dictionary: pd})
+		w.Write(input[:1000])
+		if err := w.SetQuality(tc.setQuality); err != nil {
+			t.Fatalf("SetQuality: %v", err)
+		}
+		w.Write(input[1000:])
+		if got := w.Stats().DictionaryQuality; got != tc.want {
+			t.Errorf("quality %d->%d: DictionaryQuality=%d, want %d", tc.quality, tc.setQuality, got, tc.want)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		decoded, err := cbrotli.DecodeWithRawDictionary(buf.Bytes(), dictionary)
+		if err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("quality %d->%d: decoded %d bytes, %v", tc.quality, tc.setQuality, len(decoded), err)
+		}
+	}
+	w := cbrotli.NewWriter(io.Discard, cbrotli.WriterOptions{Quality: 5})
+	if got := w.Stats().DictionaryQuality; got != -1 {
+		t.Errorf("DictionaryQuality=%d without dictionary, want -1", got)
+	}
+	w.Close()
+
+	if err := pd.Reprepare(12); err == nil {
+		t.Error("Reprepare accepted quality 12")
+	}
+	if err := pd.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := pd.Reprepare(9); err == nil {
+		t.Error("Reprepare succeeded after Close")
+	}
+	if _, err := cbrotli.PrepareDictionary(dictionary, cbrotli.DtRaw, cbrotli.PrepareDictionaryOptions{Quality: -1}); err == nil {
+		t.Error("PrepareDictionary accepted quality -1")
+	}
+}
+
+func TestDictionaryEmpty(t *testing.T) {
+	empty := []byte{}
+	if _, err := cbrotli.NewDecoderDictionary(empty); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("NewDecoderDictionary: %v", err)
+	}
+	if _, err := cbrotli.PrepareDictionary(empty, cbrotli.DtRaw, cbrotli.PrepareDictionaryOptions{}); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("PrepareDictionary: %v", err)
+	}
+	if _, err := cbrotli.DecodeWithRawDictionary([]byte{0x3b}, empty); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("DecodeWithRawDictionary: %v", err)
+	}
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader([]byte{0x3b}),
+		cbrotli.ReaderOptions{Dictionaries: []cbrotli.Dictionary{{Data: empty}}})
+	if _, err := r.Read(make([]byte, 1)); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("Reader.Read: %v", err)
+	}
+	r.Close()
+	w := cbrotli.NewWriterWithDictionaries(io.Discard, cbrotli.WriterOptions{}, []cbrotli.Dictionary{{Data: empty}})
+	if _, err := w.Write([]byte("data")); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("Writer.Write: %v", err)
+	}
+	w.Close()
+}
+
+func TestEstimateDictionaryGain(t *testing.T) {
+	src := rand.New(rand.NewSource(24))
+	var training, samples [][]byte
+	for i := 0; i < 200; i++ {
+		training = append(training, apiResponse(src))
+	}
+	for i := 0; i < 40; i++ {
+		samples = append(samples, apiResponse(src))
+	}
+	dictionary, err := cbrotli.GenerateDictionary(training, 4096)
+	if err != nil {
+		t.Fatalf("GenerateDictionary: %v", err)
+	}
+	options := cbrotli.WriterOptions{Quality: 9}
+	report, err := cbrotli.EstimateDictionaryGain(samples, dictionary, options)
+	if err != nil {
+		t.Fatalf("EstimateDictionaryGain: %v", err)
+	}
+	if report.Samples != len(samples) || len(report.Ratios) != len(samples) {
+		t.Fatalf("report of %d samples, %d ratios", report.Samples, len(report.Ratios))
+	}
+	if report.Gain() < 0.3 {
+		t.Errorf("gain %.2f; plain %d, with dictionary %d", report.Gain(), report.PlainSize, report.DictionarySize)
+	}
+	if !(report.P50 <= report.P90 && report.P90 <= report.P99 && report.P99 < 1) {
+		t.Errorf("percentiles %v %v %v", report.P50, report.P90, report.P99)
+	}
+	plain, _ := cbrotli.Encode(samples[3], options)
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 9)
+	defer pd.Close()
+	withDictionary, _ := cbrotli.Encode(samples[3], cbrotli.WriterOptions{Quality: 9, Dictionary: pd})
+	if want := float64(len(withDictionary)) / float64(len(plain)); report.Ratios[3] != want {
+		t.Errorf("Ratios[3]=%v, want %v", report.Ratios[3], want)
+	}
+	again, err := cbrotli.EstimateDictionaryGain(samples, dictionary, options)
+	if err != nil || again.PlainSize != report.PlainSize || again.DictionarySize != report.DictionarySize ||
+		again.P50 != report.P50 {
+		t.Errorf("reports differ: %+v, %+v (%v)", report, again, err)
+	}
+	if _, err := cbrotli.EstimateDictionaryGain(samples, nil, options); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("EstimateDictionaryGain without dictionary: %v", err)
+	}
+}
+
+func TestCompoundDictionaries(t *testing.T) {
+	requireSerializedDictionaries(t)
+	rawA := wordSoup(25, 30000)
+	rawB := wordSoup(26, 30000)
+	words := [][]byte{[]byte("xylophonist"), []byte("quizzically"), []byte("jukeboxes")}
+	serialized, err := cbrotli.BuildSerializedDictionary(rawA, cbrotli.SerializedDictionaryOptions{Words: words})
+	if err != nil {
+		t.Fatalf("BuildSerializedDictionary: %v", err)
+	}
+	dictionaries := []cbrotli.Dictionary{
+		{Data: serialized, Type: cbrotli.DtSerialized},
+		{Data: rawB, Type: cbrotli.DtRaw},
+	}
+	var input []byte
+	input = append(input, rawA[2000:12000]...)
+	input = append(input, rawB[15000:25000]...)
+	for i := 0; i < 50; i++ {
+		input = append(input, words[i%len(words)]...)
+		input = append(input, ' ')
+	}
+	options := cbrotli.WriterOptions{Quality: 9}
+
+	encoded, err := cbrotli.EncodeWithDictionaries(input, options, dictionaries)
+	if err != nil {
+		t.Fatalf("EncodeWithDictionaries: %v", err)
+	}
+	for _, subset := range [][]cbrotli.Dictionary{dictionaries[:1], dictionaries[1:]} {
+		partial, err := cbrotli.EncodeWithDictionaries(input, options, subset)
+		if err != nil {
+			t.Fatalf("EncodeWithDictionaries: %v", err)
+		}
+		if len(encoded)*3 > len(partial) {
+			t.Errorf("encoded to %d bytes with both dictionaries, %d with one", len(encoded), len(partial))
+		}
+	}
+	decoded, err := cbrotli.DecodeWithDictionaries(encoded, dictionaries)
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("DecodeWithDictionaries: %d bytes, %v", len(decoded), err)
+	}
+
+	// The streaming API produces a stream decodable the same way.
+	var buf bytes.Buffer
+	w := cbrotli.NewWriterWithDictionaries(&buf, options, dictionaries)
+	w.Write(input)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	r := cbrotli.NewReaderWithOptions(&buf, cbrotli.ReaderOptions{Dictionaries: dictionaries})
+	decoded, err = io.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("Reader: %d bytes, %v", len(decoded), err)
+	}
+}
+
+func TestTooManyDictionaries(t *testing.T) {
+	var dictionaries []cbrotli.Dictionary
+	for i := 0; i <= cbrotli.MaxDictionaries; i++ {
+		dictionaries = append(dictionaries, cbrotli.Dictionary{Data: wordSoup(int64(i), 100)})
+	}
+	input := []byte("some input")
+	if _, err := cbrotli.EncodeWithDictionaries(input, cbrotli.WriterOptions{}, dictionaries); !errors.Is(err, cbrotli.ErrTooManyDictionaries) {
+		t.Errorf("EncodeWithDictionaries: %v", err)
+	}
+	encoded, err := cbrotli.EncodeWithDictionaries(input, cbrotli.WriterOptions{}, dictionaries[:cbrotli.MaxDictionaries])
+	if err != nil {
+		t.Fatalf("EncodeWithDictionaries with %d dictionaries: %v", cbrotli.MaxDictionaries, err)
+	}
+	if _, err := cbrotli.DecodeWithDictionaries(encoded, dictionaries); !errors.Is(err, cbrotli.ErrTooManyDictionaries) {
+		t.Errorf("DecodeWithDictionaries: %v", err)
+	}
+	if decoded, err := cbrotli.DecodeWithDictionaries(encoded, dictionaries[:cbrotli.MaxDictionaries]); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("DecodeWithDictionaries with %d dictionaries: %q, %v", cbrotli.MaxDictionaries, decoded, err)
+	}
+}
+
+func TestPreparedDictionaryCache(t *testing.T) {
+	var loads atomic.Int64
+	cache := cbrotli.NewPreparedDictionaryCache(cbrotli.PreparedDictionaryCacheOptions{
+		Load: func(key string) ([]byte, error) {
+			loads.Add(1)
+			if key == "missing" {
+				return nil, os.ErrNotExist
+			}
+			return wordSoup(int64(len(key)), 1000), nil
+		},
+		MaxEntries: 1,
+	})
+	if _, err := cache.Get("missing"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Get(missing): %v", err)
+	}
+
+	a, err := cache.Get("a")
+	if err != nil {
+		t.Fatalf("Get(a): %v", err)
+	}
+	if again, err := cache.Get("a"); err != nil || again != a {
+		t.Errorf("Get(a) again: %p, %v; want %p", again, err, a)
+	}
+	// a is referenced, so b is cached beyond MaxEntries.
+	b, err := cache.Get("bb")
+	if err != nil {
+		t.Fatalf("Get(bb): %v", err)
+	}
+	if got := cache.Stats(); got.Entries != 2 || got.Evictions != 0 || got.Hits != 1 || got.Misses != 3 {
+		t.Errorf("Stats: %+v", got)
+	}
+
+	// A Writer keeps b alive after it is released.
+	var buf bytes.Buffer
+	w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 5, Dictionary: b})
+	if err := cache.Release(b); err != nil {
+		t.Fatalf("Release(bb): %v", err)
+	}
+	cache.Release(a)
+	cache.Release(a)
+	if err := cache.Release(a); err == nil {
+		t.Errorf("Release(a) succeeded a third time")
+	}
+	if got := cache.Stats(); got.Entries != 1 || got.Evictions != 1 {
+		t.Errorf("Stats after Release: %+v", got)
+	}
+	input := wordSoup(2, 1000)
+	w.Write(input)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	decoded, err := cbrotli.DecodeWithRawDictionary(buf.Bytes(), wordSoup(2, 1000))
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoding: %d bytes, %v", len(decoded), err)
+	}
+
+	a, err = cache.Get("a")
+	if err != nil {
+		t.Fatalf("Get(a) after eviction: %v", err)
+	}
+	if err := cache.Close(); err == nil {
+		t.Errorf("Close succeeded with a referenced dictionary")
+	}
+	cache.Release(a)
+	if err := cache.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if got := loads.Load(); got != 4 {
+		t.Errorf("%d loads, want 4", got)
+	}
+}
+
+func TestPreparedDictionaryCacheConcurrent(t *testing.T) {
+	cache := cbrotli.NewPreparedDictionaryCache(cbrotli.PreparedDictionaryCacheOptions{
+		Load: func(key string) ([]byte, error) {
+			return []byte(strings.Repeat(key, 100)), nil
+		},
+		MaxBytes: 1000,
+	})
+	keys := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				key := keys[(g+i)%len(keys)]
+				d, err := cache.Get(key)
+				if err != nil {
+					t.Errorf("Get(%s): %v", key, err)
+					return
+				}
+				input := []byte(key + " " + key)
+				encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: d})
+				cache.Release(d)
+				if err != nil {
+					t.Errorf("Encode: %v", err)
+					return
+				}
+				decoded, err := cbrotli.DecodeWithRawDictionary(encoded, []byte(strings.Repeat(key, 100)))
+				if err != nil || !bytes.Equal(decoded, input) {
+					t.Errorf("decoding with %s: %q, %v", key, decoded, err)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	stats := cache.Stats()
+	if stats.Bytes > 1000 || stats.Hits+stats.Misses != 400 || stats.Evictions != stats.Misses-int64(stats.Entries) {
+		t.Errorf("Stats: %+v", stats)
+	}
+	if err := cache.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
+
+// TestParseSerializedDictionaryMatchesC checks that C-Brotli agrees with
+// ParseSerializedDictionary on the fixture and its corruptions.
+func TestParseSerializedDictionaryMatchesC(t *testing.T) {
+	requireSerializedDictionaries(t)
+	fixture := contextDictionary()
+	for n := 0; n <= len(fixture); n++ {
+		for _, b := range []byte{0, 2, 23, 255} {
+			data := bytes.Clone(fixture[:n])
+			if n > 2 && n < len(fixture) {
+				data[n-1] = b
+			}
+			_, err := cbrotli.ParseSerializedDictionary(data)
+			d, cErr := cbrotli.NewSerializedDecoderDictionary(data)
+			if cErr == nil {
+				d.Close()
+			}
+			if (err == nil) != (cErr == nil) {
+				t.Errorf("% x: ParseSerializedDictionary: %v, C-Brotli: %v", data, err, cErr)
+			}
+		}
+	}
+}
+
+func TestExtendBuiltinDictionary(t *testing.T) {
+	requireSerializedDictionaries(t)
+	words := [][]byte{
+		[]byte("kubernetes"), []byte("deployment"), []byte("namespace"),
+		[]byte("replicaset"), []byte("statefulset"), []byte("daemonset"),
+		[]byte("configmap"), []byte("persistentvolumeclaim"),
+		[]byte("time"), // Already built in.
+	}
+	serialized, err := cbrotli.ExtendBuiltinDictionary(words)
+	if err != nil {
+		t.Fatalf("ExtendBuiltinDictionary: %v", err)
+	}
+	if info, err := cbrotli.ParseSerializedDictionary(serialized); err != nil || info.Size != len(serialized) ||
+		info.WordLists != 1 || info.CustomTransforms() || len(info.Prefixes) != 0 {
+		t.Errorf("ParseSerializedDictionary: %v, %v", info, err)
+	}
+
+	rng := rand.New(rand.NewSource(136))
+	var input []byte
+	for len(input) < 2000 {
+		input = append(input, words[rng.Intn(len(words))]...)
+		input = append(input, " the "[rng.Intn(2)*4:]...)
+	}
+	pd := cbrotli.NewPreparedDictionary(serialized, cbrotli.DtSerialized, 11)
+	defer pd.Close()
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 11, Dictionary: pd})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	plain, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 11})
+	if err != nil {
+		t.Fatalf("Encode without dictionary: %v", err)
+	}
+	if len(encoded) >= len(plain) {
+		t.Errorf("%d bytes with the extended dictionary, %d without", len(encoded), len(plain))
+	}
+	dd, err := cbrotli.NewSerializedDecoderDictionary(serialized)
+	if err != nil {
+		t.Fatalf("NewSerializedDecoderDictionary: %v", err)
+	}
+	defer dd.Close()
+	r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+	decoded, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoding: %d bytes, %v", len(decoded), err)
+	}
+
+	// Streams using only built-in words decode with the built-in dictionary.
+	text := []byte("The quick brown fox jumps over the lazy dog, then the lazy dog sleeps.")
+	encoded, err = cbrotli.Encode(text, cbrotli.WriterOptions{Quality: 11, Dictionary: pd})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if decoded, err := cbrotli.Decode(encoded); err != nil || !bytes.Equal(decoded, text) {
+		t.Errorf("Decode: %q, %v", decoded, err)
+	}
+
+	for _, words := range [][][]byte{{[]byte("abc")}, {bytes.Repeat([]byte("a"), 32)}} {
+		if _, err := cbrotli.ExtendBuiltinDictionary(words); err == nil {
+			t.Errorf("ExtendBuiltinDictionary(%q) succeeded", words)
+		}
+	}
+}
+
+func TestWriterSelectDictionary(t *testing.T) {
+	htmlDictionary := wordSoup(137, 20000)
+	jsonDictionary := wordSoup(138, 20000)
+	html := cbrotli.NewPreparedDictionary(htmlDictionary, cbrotli.DtRaw, 5)
+	defer html.Close()
+	jsonPD := cbrotli.NewPreparedDictionary(jsonDictionary, cbrotli.DtRaw, 5)
+	defer jsonPD.Close()
+
+	for _, tc := range []struct {
+		name       string
+		input      []byte
+		dictionary []byte // nil for none
+		writes     int
+	}{
+		{"html", append([]byte("<html>"), htmlDictionary[5000:15000]...), htmlDictionary, 7},
+		{"json", append([]byte("{"), jsonDictionary[1000:9000]...), jsonDictionary, 1},
+		{"none", []byte("plain text, shorter than the sample"), nil, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			var sampled []byte
+			options := cbrotli.WriterOptions{
+				Quality:             5,
+				SelectionSampleSize: 100,
+				SelectDictionary: func(sample []byte) *cbrotli.PreparedDictionary {
+					calls++
+					sampled = bytes.Clone(sample)
+					switch sample[0] {
+					case '<':
+						return html
+					case '{':
+						return jsonPD
+					}
+					return nil
+				},
+			}
+			var buf bytes.Buffer
+			w := cbrotli.NewWriter(&buf, options)
+			step := (len(tc.input) + tc.writes - 1) / tc.writes
+			for p := tc.input; len(p) > 0; p = p[min(step, len(p)):] {
+				if n, err := w.Write(p[:min(step, len(p))]); err != nil || n != min(step, len(p)) {
+					t.Fatalf("Write: %d, %v", n, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+			if calls != 1 || !bytes.Equal(sampled, tc.input[:min(100, len(tc.input))]) {
+				t.Errorf("SelectDictionary called %d times with %q", calls, sampled)
+			}
+			if tc.dictionary == nil {
+				if decoded, err := cbrotli.Decode(buf.Bytes()); err != nil || !bytes.Equal(decoded, tc.input) {
+					t.Errorf("Decode: %q, %v", decoded, err)
+				}
+				return
+			}
+			if _, err := cbrotli.Decode(buf.Bytes()); err == nil {
+				t.Errorf("Decode without the dictionary succeeded")
+			}
+			decoded, err := cbrotli.DecodeWithRawDictionary(buf.Bytes(), tc.dictionary)
+			if err != nil || !bytes.Equal(decoded, tc.input) {
+				t.Errorf("DecodeWithRawDictionary: %d bytes, %v", len(decoded), err)
+			}
+			if got := w.Stats().DictionaryQuality; got != 5 {
+				t.Errorf("DictionaryQuality: %d", got)
+			}
+		})
+	}
+
+	// Flush selects with the partial sample.
+	calls := 0
+	var buf bytes.Buffer
+	w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{
+		Quality: 5,
+		SelectDictionary: func(sample []byte) *cbrotli.PreparedDictionary {
+			calls++
+			return html
+		},
+	})
+	w.Write(htmlDictionary[:10])
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	w.Write(htmlDictionary[10:5000])
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if decoded, err := cbrotli.DecodeWithRawDictionary(buf.Bytes(), htmlDictionary); calls != 1 || err != nil ||
+		!bytes.Equal(decoded, htmlDictionary[:5000]) {
+		t.Errorf("after Flush: %d calls, %d bytes, %v", calls, len(decoded), err)
+	}
+
+	if _, err := cbrotli.Encode(nil, cbrotli.WriterOptions{Dictionary: html,
+		SelectDictionary: func([]byte) *cbrotli.PreparedDictionary { return nil }}); err == nil {
+		t.Errorf("Encode with Dictionary and SelectDictionary succeeded")
+	}
+}
+
+func TestLoadDictionaryFS(t *testing.T) {
+	raw := wordSoup(138, 10000)
+	serialized, err := cbrotli.BuildSerializedDictionary(raw, cbrotli.SerializedDictionaryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"dict/raw.bin":        {Data: raw},
+		"dict/serialized.bin": {Data: serialized},
+		"dict/empty.bin":      {},
+		"dict/broken.bin":     {Data: serialized[:100]},
+	}
+	input := bytes.Clone(raw[2000:6000])
+
+	pd, err := cbrotli.LoadPreparedDictionaryFS(fsys, "dict/raw.bin", cbrotli.DtRaw, cbrotli.PrepareDictionaryOptions{Quality: 5})
+	if err != nil {
+		t.Fatalf("LoadPreparedDictionaryFS: %v", err)
+	}
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	pd.Close()
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	dd, err := cbrotli.LoadDictionaryFS(fsys, "dict/raw.bin", cbrotli.DtRaw)
+	if err != nil {
+		t.Fatalf("LoadDictionaryFS: %v", err)
+	}
+	r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+	decoded, err := io.ReadAll(r)
+	r.Close()
+	dd.Close()
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoding: %d bytes, %v", len(decoded), err)
+	}
+
+	for _, tc := range []struct {
+		path string
+		typ  cbrotli.DictionaryType
+		want error
+	}{
+		{"dict/missing.bin", cbrotli.DtRaw, fs.ErrNotExist},
+		{"dict/empty.bin", cbrotli.DtRaw, cbrotli.ErrDictionaryEmpty},
+		{"dict/broken.bin", cbrotli.DtSerialized, cbrotli.ErrTruncatedDictionary},
+		{"dict/raw.bin", cbrotli.DtSerialized, cbrotli.ErrMalformedDictionary},
+	} {
+		_, err := cbrotli.LoadDictionaryFS(fsys, tc.path, tc.typ)
+		if !errors.Is(err, tc.want) || !strings.Contains(err.Error(), tc.path) {
+			t.Errorf("LoadDictionaryFS(%s): %v, want %v", tc.path, err, tc.want)
+		}
+		_, err = cbrotli.LoadPreparedDictionaryFS(fsys, tc.path, tc.typ, cbrotli.PrepareDictionaryOptions{})
+		if !errors.Is(err, tc.want) || !strings.Contains(err.Error(), tc.path) {
+			t.Errorf("LoadPreparedDictionaryFS(%s): %v, want %v", tc.path, err, tc.want)
+		}
+	}
+
+	cache := cbrotli.NewPreparedDictionaryCache(cbrotli.PreparedDictionaryCacheOptions{
+		Load:    cbrotli.FSDictionaryLoader(fsys, cbrotli.DtRaw),
+		Quality: 5,
+	})
+	first, err := cache.Get("dict/raw.bin")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	second, err := cache.Get("dict/raw.bin")
+	if err != nil || second != first {
+		t.Errorf("second Get: %p, %v; want %p", second, err, first)
+	}
+	cache.Release(first)
+	cache.Release(second)
+	if _, err := cache.Get("dict/empty.bin"); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("Get(empty): %v", err)
+	}
+	if err := cache.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+
+	requireSerializedDictionaries(t)
+	dd, err = cbrotli.LoadDictionaryFS(fsys, "dict/serialized.bin", cbrotli.DtSerialized)
+	if err != nil {
+		t.Fatalf("LoadDictionaryFS(serialized): %v", err)
+	}
+	defer dd.Close()
+	r = cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+	decoded, err = io.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoding with the serialized dictionary: %d bytes, %v", len(decoded), err)
+	}
+}
+
+func TestWriterResetWithDictionaries(t *testing.T) {
+	dictA, dictB := wordSoup(139, 10000), wordSoup(140, 10000)
+	pdA := cbrotli.NewPreparedDictionary(dictA, cbrotli.DtRaw, 5)
+	defer pdA.Close()
+	input := append(bytes.Clone(dictA[1000:4000]), dictB[1000:4000]...)
+
+	var buf bytes.Buffer
+	w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 5, Dictionary: pdA})
+	for _, tc := range []struct {
+		name         string
+		reset        func() error
+		dictionaries [][]byte
+	}{
+		{"A", func() error { return nil }, [][]byte{dictA}},
+		{"none", func() error { return w.ResetOptions(&buf, cbrotli.WriterOptions{Quality: 5}) }, nil},
+		{"B", func() error {
+			return w.ResetWithDictionaries(&buf, cbrotli.WriterOptions{Quality: 5},
+				[]cbrotli.Dictionary{{Data: dictB}})
+		}, [][]byte{dictB}},
+		{"A and B", func() error {
+			return w.ResetWithDictionaries(&buf, cbrotli.WriterOptions{Quality: 5, Dictionary: pdA},
+				[]cbrotli.Dictionary{{Data: dictB}})
+		}, [][]byte{dictA, dictB}},
+		{"empty set", func() error {
+			return w.ResetWithDictionaries(&buf, cbrotli.WriterOptions{Quality: 5}, nil)
+		}, nil},
+	} {
+		buf.Reset()
+		if err := tc.reset(); err != nil {
+			t.Fatalf("%s: reset: %v", tc.name, err)
+		}
+		w.Write(input)
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: Close: %v", tc.name, err)
+		}
+		var dictionaries []cbrotli.Dictionary
+		for _, d := range tc.dictionaries {
+			dictionaries = append(dictionaries, cbrotli.Dictionary{Data: d})
+		}
+		if decoded, err := cbrotli.DecodeWithDictionaries(buf.Bytes(), dictionaries); err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("%s: decoding: %d bytes, %v", tc.name, len(decoded), err)
+		}
+		// Streams referencing dictionaries do not decode without them. (Extra
+		// dictionaries are harmless.)
+		for _, other := range [][]cbrotli.Dictionary{nil, {{Data: dictA}}, {{Data: dictB}}} {
+			if len(dictionaries) == 0 || len(other) == len(dictionaries) && bytes.Equal(other[0].Data, dictionaries[0].Data) {
+				continue
+			}
+			if decoded, err := cbrotli.DecodeWithDictionaries(buf.Bytes(), other); err == nil && bytes.Equal(decoded, input) {
+				t.Errorf("%s: decoding with %d other dictionaries succeeded", tc.name, len(other))
+			}
+		}
+	}
+	if err := pdA.Close(); err != nil {
+		t.Errorf("PreparedDictionary still in use after the Writer is closed: %v", err)
+	}
+
+	// Attach failures leave the Writer closable.
+	if err := w.ResetOptions(&buf, cbrotli.WriterOptions{Quality: 5, Dictionary: pdA}); err == nil {
+		t.Errorf("ResetOptions with a closed dictionary succeeded")
+	}
+	if _, err := w.Write(input); err == nil {
+		t.Errorf("Write after failed reset succeeded")
+	}
+	w.Close()
+	if err := w.ResetWithDictionaries(&buf, cbrotli.WriterOptions{}, []cbrotli.Dictionary{{}}); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("ResetWithDictionaries with an empty dictionary: %v", err)
+	}
+	w.Close()
+}
+
+// TestDegenerateDictionaries passes nil, empty and 1-byte dictionaries to all
+// the constructors accepting dictionaries: empty ones are rejected with
+// ErrDictionaryEmpty, nil ones mean no dictionary where a dictionary is
+// optional, and nothing panics.
+func TestDegenerateDictionaries(t *testing.T) {
+	input := []byte("xxxxxxxxxxxxxxxx, a short input")
+	oneByte := []byte("x")
+	pd := cbrotli.NewPreparedDictionary(oneByte, cbrotli.DtRaw, 5)
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	pd.Close()
+	if err != nil {
+		t.Fatalf("Encode with a 1-byte dictionary: %v", err)
+	}
+	plain, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoders := map[string]func(encoded, dictionary []byte) ([]byte, error){
+		"NewReaderWithRawDictionary": func(encoded, dictionary []byte) ([]byte, error) {
+			r := cbrotli.NewReaderWithRawDictionary(bytes.NewReader(encoded), dictionary)
+			defer r.Close()
+			return io.ReadAll(r)
+		},
+		"DecodeWithRawDictionary": cbrotli.DecodeWithRawDictionary,
+		"DecodeWithDictionaries": func(encoded, dictionary []byte) ([]byte, error) {
+			if dictionary == nil {
+				return cbrotli.DecodeWithDictionaries(encoded, nil)
+			}
+			return cbrotli.DecodeWithDictionaries(encoded, []cbrotli.Dictionary{{Data: dictionary}})
+		},
+		"NewDecoderDictionary": func(encoded, dictionary []byte) ([]byte, error) {
+			d, err := cbrotli.NewDecoderDictionary(dictionary)
+			if err != nil {
+				return nil, err
+			}
+			defer d.Close()
+			r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), d)
+			defer r.Close()
+			return io.ReadAll(r)
+		},
+	}
+	for name, decode := range decoders {
+		if decoded, err := decode(encoded, oneByte); err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("%s(1 byte): %q, %v", name, decoded, err)
+		}
+		if _, err := decode(encoded, []byte{}); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+			t.Errorf("%s(empty): %v", name, err)
+		}
+		decoded, err := decode(plain, nil)
+		if name == "NewDecoderDictionary" {
+			if !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+				t.Errorf("%s(nil): %v", name, err)
+			}
+		} else if err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("%s(nil): %q, %v", name, decoded, err)
+		}
+	}
+	for _, data := range [][]byte{nil, {}, {0x91}} {
+		if _, err := cbrotli.NewSerializedDecoderDictionary(data); err == nil {
+			t.Errorf("NewSerializedDecoderDictionary(%q) succeeded", data)
+		}
+	}
+
+	encoders := map[string]func(dictionary []byte, dictionaryType cbrotli.DictionaryType) ([]byte, error){
+		"NewPreparedDictionary": func(dictionary []byte, dictionaryType cbrotli.DictionaryType) ([]byte, error) {
+			pd := cbrotli.NewPreparedDictionary(dictionary, dictionaryType, 5)
+			defer pd.Close()
+			return cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+		},
+		"PrepareDictionary": func(dictionary []byte, dictionaryType cbrotli.DictionaryType) ([]byte, error) {
+			pd, err := cbrotli.PrepareDictionary(dictionary, dictionaryType, cbrotli.PrepareDictionaryOptions{Quality: 5})
+			if err != nil {
+				return nil, err
+			}
+			defer pd.Close()
+			if err := pd.Reprepare(6); err != nil {
+				return nil, err
+			}
+			return cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+		},
+		"EncodeWithDictionaries": func(dictionary []byte, dictionaryType cbrotli.DictionaryType) ([]byte, error) {
+			return cbrotli.EncodeWithDictionaries(input, cbrotli.WriterOptions{Quality: 5},
+				[]cbrotli.Dictionary{{Data: dictionary, Type: dictionaryType}})
+		},
+	}
+	for name, encode := range encoders {
+		if got, err := encode(oneByte, cbrotli.DtRaw); err != nil || !bytes.Equal(got, encoded) {
+			t.Errorf("%s(1 byte): %v", name, err)
+		}
+		for _, tc := range []struct {
+			data []byte
+			typ  cbrotli.DictionaryType
+		}{{nil, cbrotli.DtRaw}, {[]byte{}, cbrotli.DtRaw}, {nil, cbrotli.DtSerialized}, {[]byte{0x91}, cbrotli.DtSerialized}} {
+			if _, err := encode(tc.data, tc.typ); err == nil {
+				t.Errorf("%s(%q, %d) succeeded", name, tc.data, tc.typ)
+			} else if len(tc.data) == 0 && name != "NewPreparedDictionary" && !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+				t.Errorf("%s(%q, %d): %v", name, tc.data, tc.typ, err)
+			}
+		}
+	}
+	if _, err := cbrotli.EstimateDictionaryGain([][]byte{input}, nil, cbrotli.WriterOptions{}); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("EstimateDictionaryGain(nil): %v", err)
+	}
+}
+
+func TestReaderProgress(t *testing.T) {
+	input := make([]byte, 1<<20)
+	rand.New(rand.NewSource(144)).Read(input[:len(input)/2])
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type call struct{ consumed, produced int64 }
+	var calls []call
+	var r *cbrotli.Reader
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Progress: func(consumed, produced int64) {
+			calls = append(calls, call{consumed, produced})
+			if f := r.Fraction(); f != float64(consumed)/float64(len(encoded)) {
+				t.Errorf("Fraction: %v at %d of %d bytes", f, consumed, len(encoded))
+			}
+		},
+		CompressedSize: int64(len(encoded)),
+	})
+	defer r.Close()
+	buf := make([]byte, 1000)
+	var decoded []byte
+	reads := 0
+	for {
+		n, err := r.Read(buf)
+		decoded = append(decoded, buf[:n]...)
+		reads++
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(decoded, input) {
+		t.Fatal("decoded output does not match")
+	}
+	if len(calls) < 2 || len(calls) > reads || len(calls) > 2*len(input)/(64<<10)+1 {
+		t.Fatalf("%d calls in %d reads", len(calls), reads)
+	}
+	for i := 1; i < len(calls); i++ {
+		if calls[i].consumed < calls[i-1].consumed || calls[i].produced < calls[i-1].produced {
+			t.Errorf("call %d: %+v after %+v", i, calls[i], calls[i-1])
+		}
+	}
+	if last := calls[len(calls)-1]; last != (call{int64(len(encoded)), int64(len(input))}) {
+		t.Errorf("last call: %+v, want %d, %d", last, len(encoded), len(input))
+	}
+	n := len(calls)
+	if read, err := r.Read(buf); read != 0 || err != io.EOF {
+		t.Errorf("Read after EOF: %d, %v", read, err)
+	}
+	if len(calls) != n {
+		t.Errorf("Progress called again after EOF")
+	}
+
+	// A panicking callback does not break the Reader.
+	panics := 0
+	r2 := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Progress: func(consumed, produced int64) { panic("progress") },
+	})
+	defer r2.Close()
+	decoded = decoded[:0]
+	for {
+		n, err := func() (n int, err error) {
+			defer func() {
+				if recover() != nil {
+					panics++
+				}
+			}()
+			return r2.Read(buf)
+		}()
+		decoded = append(decoded, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if panics > len(input) {
+			t.Fatal("no EOF")
+		}
+	}
+	if panics == 0 {
+		t.Error("Progress was not called")
+	}
+	if !bytes.Equal(decoded, input) {
+		t.Error("decoded output does not match after panics")
+	}
+	r3 := cbrotli.NewReader(bytes.NewReader(encoded))
+	defer r3.Close()
+	if f := r3.Fraction(); f != -1 {
+		t.Errorf("Fraction without CompressedSize: %v", f)
+	}
+}
+
+func TestWriterProgress(t *testing.T) {
+	input := make([]byte, 1<<20)
+	rand.New(rand.NewSource(145)).Read(input[:len(input)/2])
+	type call struct{ in, out int64 }
+	var calls []call
+	var out bytes.Buffer
+	w := cbrotli.NewWriter(&out, cbrotli.WriterOptions{
+		Quality: 5,
+		Progress: func(in, out int64) {
+			calls = append(calls, call{in, out})
+		},
+	})
+	writes := 0
+	for i := 0; i < len(input); i += 100 {
+		if _, err := w.Write(input[i:min(i+100, len(input))]); err != nil {
+			t.Fatal(err)
+		}
+		writes++
+		if i == len(input)/2 {
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	final := call{int64(len(input)), int64(out.Len())}
+	if len(calls) < 2 || len(calls) > 2*len(input)/(64<<10)+1 {
+		t.Fatalf("%d calls in %d writes", len(calls), writes)
+	}
+	for i := 1; i < len(calls); i++ {
+		if calls[i].in < calls[i-1].in || calls[i].out < calls[i-1].out {
+			t.Errorf("call %d: %+v after %+v", i, calls[i], calls[i-1])
+		}
+		if calls[i-1] == final {
+			t.Errorf("call %d: final totals %+v reported before the last call", i-1, final)
+		}
+	}
+	if last := calls[len(calls)-1]; last != final {
+		t.Errorf("last call: %+v, want %+v", last, final)
+	}
+	if decoded, err := cbrotli.Decode(out.Bytes()); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoding failed: %v", err)
+	}
+
+	// Short streams report the totals once, at Close; Encode reports too.
+	calls = nil
+	encoded, err := cbrotli.Encode([]byte("hello"), cbrotli.WriterOptions{
+		Progress: func(in, out int64) {
+			calls = append(calls, call{in, out})
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (call{5, int64(len(encoded))}); len(calls) != 1 || calls[0] != want {
+		t.Errorf("Encode: calls %+v, want [%+v]", calls, want)
+	}
+}
+
+// recordingHandler is a slog.Handler that keeps the records it handles.
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+// take returns the records handled since the last call and their attributes.
+func (h *recordingHandler) take() ([]slog.Record, []map[string]slog.Value) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	records := h.records
+	h.records = nil
+	var attrs []map[string]slog.Value
+	for _, r := range records {
+		m := map[string]slog.Value{}
+		r.Attrs(func(a slog.Attr) bool {
+			m[a.Key] = a.Value
+			return true
+		})
+		attrs = append(attrs, m)
+	}
+	return records, attrs
+}
+
+// emptyReadReader returns (0, nil) before each chunk of data.
+type emptyReadReader struct {
+	data  []byte
+	empty bool
+}
+
+func (e *emptyReadReader) Read(p []byte) (int, error) {
+	if e.empty = !e.empty; e.empty {
+		return 0, nil
+	}
+	if len(e.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, e.data[:min(len(e.data), 1000)])
+	e.data = e.data[n:]
+	return n, nil
+}
+
+func TestSetLogger(t *testing.T) {
+	h := &recordingHandler{}
+	cbrotli.SetLogger(slog.New(h))
+	defer cbrotli.SetLogger(nil)
+	input := wordSoup(146, 10000)
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records, _ := h.take(); len(records) != 0 {
+		t.Errorf("records of normal operation: %v", records)
+	}
+
+	// Trailing input.
+	if _, err := cbrotli.Decode(append(bytes.Clone(encoded), 1, 2, 3)); err == nil {
+		t.Error("Decode with trailing input succeeded")
+	}
+	records, attrs := h.take()
+	if len(records) != 1 || records[0].Level != slog.LevelWarn ||
+		attrs[0]["offset"].Int64() != int64(len(encoded)) || attrs[0]["buffered"].Int64() != 3 {
+		t.Errorf("trailing input: %v %v", records, attrs)
+	}
+
+	// Sources returning (0, nil).
+	r := cbrotli.NewReader(&emptyReadReader{data: encoded})
+	decoded, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Fatalf("decoding with empty reads: %v", err)
+	}
+	records, attrs = h.take()
+	if len(records) < 2 || records[0].Level != slog.LevelDebug || attrs[0]["offset"].Int64() != 0 ||
+		attrs[1]["offset"].Int64() != 1000 {
+		t.Errorf("empty reads: %d records, %v", len(records), attrs)
+	}
+
+	// Writers reset after a failure.
+	sentinel := errors.New("sentinel")
+	w := cbrotli.NewWriter(failingWriter{sentinel}, cbrotli.WriterOptions{})
+	w.Write(input)
+	if err := w.Flush(); !errors.Is(err, sentinel) {
+		t.Fatalf("Flush: %v", err)
+	}
+	var out bytes.Buffer
+	if err := w.ResetOptions(&out, cbrotli.WriterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	records, attrs = h.take()
+	if len(records) != 1 || records[0].Level != slog.LevelDebug ||
+		!errors.Is(attrs[0]["error"].Any().(error), sentinel) || attrs[0]["bytes_in"].Int64() != int64(len(input)) {
+		t.Errorf("reset after failure: %v %v", records, attrs)
+	}
+	// A healthy Writer is reset silently.
+	if err := w.ResetOptions(&out, cbrotli.WriterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	if records, _ := h.take(); len(records) != 0 {
+		t.Errorf("records of a healthy reset: %v", records)
+	}
+
+	// A nil logger disables logging.
+	cbrotli.SetLogger(nil)
+	cbrotli.Decode(append(bytes.Clone(encoded), 0))
+	if records, _ := h.take(); len(records) != 0 {
+		t.Errorf("records with a nil logger: %v", records)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	cbrotli.EnableMetrics()
+	before := cbrotli.Metrics()
+	const goroutines, iterations = 8, 20
+	var wg sync.WaitGroup
+	var encodedTotal, decodedTotal atomic.Int64
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				input := wordSoup(int64(g*iterations+i), 1000+i*100)
+				var buf bytes.Buffer
+				w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 4})
+				w.Write(input)
+				if err := w.Close(); err != nil {
+					t.Error(err)
+					return
+				}
+				encodedTotal.Add(int64(buf.Len()))
+				decoded, err := cbrotli.Decode(buf.Bytes())
+				if err != nil || !bytes.Equal(decoded, input) {
+					t.Errorf("Decode: %v", err)
+					return
+				}
+				decodedTotal.Add(int64(len(decoded)))
+				// One error of each category.
+				if _, err := cbrotli.Decode(nil); !errors.Is(err, cbrotli.ErrTruncated) {
+					t.Errorf("Decode(nil): %v", err)
+				}
+				if _, err := cbrotli.Decode([]byte{0xff, 0xff}); !errors.Is(err, cbrotli.ErrCorrupt) {
+					t.Errorf("corrupt Decode: %v", err)
+				}
+				w = cbrotli.NewWriter(failingWriter{errors.New("failed")}, cbrotli.WriterOptions{})
+				if w.Close() == nil {
+					t.Error("Close to a failing destination succeeded")
+				}
+				// Empty input takes the pooled streaming path.
+				empty, err := cbrotli.Encode(nil, cbrotli.WriterOptions{})
+				if err != nil {
+					t.Error(err)
+				}
+				encodedTotal.Add(int64(len(empty)))
+			}
+		}(g)
+	}
+	wg.Wait()
+	after := cbrotli.Metrics()
+	n := int64(goroutines * iterations)
+	for _, c := range []struct {
+		name        string
+		got, want   int64
+		beforeValue int64
+	}{
+		{"ReadersOpened", after.ReadersOpened, 3 * n, before.ReadersOpened},
+		{"ReadersClosed", after.ReadersClosed, 3 * n, before.ReadersClosed},
+		{"WriterStreamsStarted", after.WriterStreamsStarted, 3 * n, before.WriterStreamsStarted},
+		{"WriterStreamsEnded", after.WriterStreamsEnded, 3 * n, before.WriterStreamsEnded},
+		{"DecoderBytesOut", after.DecoderBytesOut, decodedTotal.Load(), before.DecoderBytesOut},
+		{"EncoderBytesIn", after.EncoderBytesIn, decodedTotal.Load(), before.EncoderBytesIn},
+		{"EncoderBytesOut", after.EncoderBytesOut, encodedTotal.Load(), before.EncoderBytesOut},
+		{"TruncatedErrors", after.TruncatedErrors, n, before.TruncatedErrors},
+		{"DecoderErrors", after.DecoderErrors, n, before.DecoderErrors},
+		{"DestinationErrors", after.DestinationErrors, n, before.DestinationErrors},
+		{"EncoderErrors", after.EncoderErrors, 0, before.EncoderErrors},
+		{"PoolHits+PoolMisses", after.PoolHits + after.PoolMisses, n, before.PoolHits + before.PoolMisses},
+	} {
+		if got := c.got - c.beforeValue; got != c.want {
+			t.Errorf("%s: %d, want %d", c.name, got, c.want)
+		}
+	}
+	// The corrupt streams consume some input too.
+	if got := after.DecoderBytesIn - before.DecoderBytesIn; got < encodedTotal.Load()-n || got > encodedTotal.Load()+2*n {
+		t.Errorf("DecoderBytesIn: %d, want about %d", got, encodedTotal.Load())
+	}
+}
+
+func TestReaderOnBlockBoundary(t *testing.T) {
+	noise := make([]byte, 200000)
+	rand.New(rand.NewSource(148)).Read(noise)
+	text := wordSoup(148, 300000)
+	input := append(append(append([]byte{}, text[:100000]...), noise...), text[100000:]...)
+	type event struct {
+		compressed, decompressed int64
+		meta                     cbrotli.BlockInfo
+	}
+	decode := func(encoded []byte, options cbrotli.ReaderOptions) []event {
+		t.Helper()
+		var events []event
+		var r *cbrotli.Reader
+		returned := int64(0)
+		options.CompressedSize = int64(len(encoded))
+		options.OnBlockBoundary = func(compressed, decompressed int64, meta cbrotli.BlockInfo) {
+			// The decoder has passed the boundary.
+			if consumed := int64(r.Fraction()*float64(len(encoded)) + 0.5); compressed > consumed {
+				t.Errorf("block %+v ends at %d, after %d consumed bytes", meta, compressed, consumed)
+			}
+			if decompressed > returned {
+				t.Errorf("block %+v ends at %d, after %d returned bytes", meta, decompressed, returned)
+			}
+			events = append(events, event{compressed, decompressed, meta})
+		}
+		r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), options)
+		defer r.Close()
+		buf := make([]byte, 10000)
+		var decoded []byte
+		for {
+			n, err := r.Read(buf)
+			decoded = append(decoded, buf[:n]...)
+			returned += int64(n)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !bytes.Equal(decoded, bytes.Repeat(input, len(decoded)/len(input))) {
+			t.Fatal("decoded output does not match")
+		}
+		return events
+	}
+	check := func(events []event, encoded []byte, streams int) map[cbrotli.BlockType]int {
+		t.Helper()
+		types := map[cbrotli.BlockType]int{}
+		bit, offset, last := int64(0), int64(0), 0
+		for i, e := range events {
+			m := e.meta
+			types[m.Type]++
+			start := m.CompressedOffset*8 + int64(m.StartBit)
+			if start < bit || (i != 0 && !events[i-1].meta.Last && start != bit) {
+				t.Errorf("block %d starts at bit %d, previous ended at %d", i, start, bit)
+			}
+			if m.DecompressedOffset != offset {
+				t.Errorf("block %d starts at %d, previous ended at %d", i, m.DecompressedOffset, offset)
+			}
+			bit = start + m.CompressedBits
+			if m.Type != cbrotli.BlockMetadata {
+				offset += m.Length
+			}
+			if e.compressed != (bit+7)/8 || e.decompressed != offset {
+				t.Errorf("block %d: boundary at %d, %d, want %d, %d", i, e.compressed, e.decompressed, (bit+7)/8, offset)
+			}
+			if m.Last {
+				last++
+			}
+		}
+		if offset != int64(streams*len(input)) || (bit+7)/8 != int64(len(encoded)) || last != streams ||
+			!events[len(events)-1].meta.Last {
+			t.Errorf("blocks end at %d, bit %d after %d streams, want %d, byte %d, %d",
+				offset, bit, last, streams*len(input), len(encoded), streams)
+		}
+		return types
+	}
+
+	for _, quality := range []int{0, 5, 11} {
+		var out bytes.Buffer
+		w := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: quality, LGWin: 18})
+		for _, part := range [][]byte{input[:100000], input[100000:300000], input[300000:]} {
+			if _, err := w.Write(part); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		encoded := out.Bytes()
+		types := check(decode(encoded, cbrotli.ReaderOptions{}), encoded, 1)
+		// Flushes end meta-blocks with empty metadata ones, and noise is
+		// stored.
+		if types[cbrotli.BlockCompressed] < 2 || types[cbrotli.BlockMetadata] == 0 ||
+			types[cbrotli.BlockUncompressed] == 0 {
+			t.Errorf("quality %d: blocks %v", quality, types)
+		}
+
+		if quality == 11 {
+			// Offsets are counted over all streams.
+			joined := append(append([]byte{}, encoded...), encoded...)
+			check(decode(joined, cbrotli.ReaderOptions{Multistream: true}), joined, 2)
+		}
+	}
+
+	// References to raw dictionaries are followed.
+	dictionary := input[150000:250000]
+	prepared := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 11)
+	defer prepared.Close()
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 11, Dictionary: prepared})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(decode(encoded, cbrotli.ReaderOptions{RawDictionary: dictionary}), encoded, 1)
+	check(decode(encoded, cbrotli.ReaderOptions{
+		Dictionaries: []cbrotli.Dictionary{{Data: dictionary, Type: cbrotli.DtRaw}},
+	}), encoded, 1)
+
+	// Closing a Reader in the middle of the stream stops the Go decoder.
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		RawDictionary:   dictionary,
+		OnBlockBoundary: func(int64, int64, cbrotli.BlockInfo) {},
+	})
+	if _, err := r.Read(make([]byte, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyRoundTrip(t *testing.T) {
+	input := wordSoup(7, 200000)
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cbrotli.VerifyRoundTripBytes(input, encoded); err != nil {
+		t.Errorf("VerifyRoundTripBytes: %v", err)
+	}
+	// Streamed with small buffers and short reads on both sides.
+	err = cbrotli.VerifyRoundTripWithOptions(iotest.HalfReader(bytes.NewReader(input)),
+		iotest.OneByteReader(bytes.NewReader(encoded)), cbrotli.VerifyOptions{BufferSize: 1000})
+	if err != nil {
+		t.Errorf("VerifyRoundTripWithOptions: %v", err)
+	}
+
+	changed := append([]byte(nil), input...)
+	changed[123456] ^= 1
+	for _, c := range []struct {
+		name     string
+		original []byte
+		offset   int64
+	}{
+		{"changed", changed, 123456},
+		{"longer", append(append([]byte(nil), input...), 'x'), int64(len(input))},
+		{"shorter", input[:150000], 150000},
+		{"empty", nil, 0},
+	} {
+		err := cbrotli.VerifyRoundTripWithOptions(bytes.NewReader(c.original), bytes.NewReader(encoded),
+			cbrotli.VerifyOptions{BufferSize: 4096})
+		var mismatch *cbrotli.MismatchError
+		if !errors.As(err, &mismatch) || mismatch.Offset != c.offset {
+			t.Errorf("%s: got %v, want a mismatch at offset %d", c.name, err, c.offset)
+		}
+	}
+
+	sum := sha256.Sum256(input)
+	if err := cbrotli.VerifyRoundTripWithOptions(nil, bytes.NewReader(encoded),
+		cbrotli.VerifyOptions{SHA256: &sum}); err != nil {
+		t.Errorf("VerifyRoundTripWithOptions with digest: %v", err)
+	}
+	badSum := sha256.Sum256(changed)
+	err = cbrotli.VerifyRoundTripWithOptions(nil, bytes.NewReader(encoded), cbrotli.VerifyOptions{SHA256: &badSum})
+	var mismatch *cbrotli.MismatchError
+	if !errors.As(err, &mismatch) || mismatch.Offset != -1 {
+		t.Errorf("VerifyRoundTripWithOptions with wrong digest: %v", err)
+	}
+	if err := cbrotli.VerifyRoundTripWithOptions(nil, bytes.NewReader(encoded), cbrotli.VerifyOptions{}); err == nil {
+		t.Error("VerifyRoundTripWithOptions without original or digest succeeded")
+	}
+
+	// Decoding and read errors are not mismatches.
+	if err := cbrotli.VerifyRoundTripBytes(input, encoded[:len(encoded)/2]); !errors.Is(err, cbrotli.ErrTruncated) {
+		t.Errorf("truncated: got %v, want ErrTruncated", err)
+	}
+	failed := errors.New("failed")
+	err = cbrotli.VerifyRoundTrip(iotest.ErrReader(failed), bytes.NewReader(encoded))
+	if !errors.Is(err, failed) || errors.As(err, &mismatch) {
+		t.Errorf("failing original: got %v, want %v", err, failed)
+	}
+
+	// Memory use does not depend on the size of the content.
+	big := bytes.Repeat(input, 20)
+	bigEncoded, err := cbrotli.Encode(big, cbrotli.WriterOptions{Quality: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocs := func(data, encoded []byte) uint64 {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		if err := cbrotli.VerifyRoundTrip(bytes.NewReader(data), bytes.NewReader(encoded)); err != nil {
+			t.Fatal(err)
+		}
+		runtime.ReadMemStats(&after)
+		return after.TotalAlloc - before.TotalAlloc
+	}
+	if small, large := allocs(input, encoded), allocs(big, bigEncoded); large > 2*small {
+		t.Errorf("VerifyRoundTrip allocated %d bytes for %d bytes, %d for %d", small, len(input), large, len(big))
+	}
+}
+
+func TestHTTPHandler(t *testing.T) {
+	text := wordSoup(31, 20000)
+	handler := cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body := text
+		if n := req.URL.Query().Get("size"); n != "" {
+			size, _ := strconv.Atoi(n)
+			body = text[:size]
+		}
+		for _, kv := range strings.Split(req.URL.Query().Get("header"), ",") {
+			if k, v, ok := strings.Cut(kv, ":"); ok {
+				rw.Header().Set(k, v)
+			}
+		}
+		if status, _ := strconv.Atoi(req.URL.Query().Get("status")); status != 0 {
+			rw.WriteHeader(status)
+		}
+		// Writes of a few bytes, then the rest.
+		rw.Write(body[:min(10, len(body))])
+		rw.Write(body[min(10, len(body)):])
+	}), cbrotli.HTTPOptions{Writer: cbrotli.WriterOptions{Quality: 5}, MinSize: 100})
+
+	for _, tc := range []struct {
+		name, query, accept string
+		compressed, vary    bool
+	}{
+		{"text", "header=Content-Type:text/plain", "gzip, br", true, true},
+		{"not accepted", "header=Content-Type:text/plain", "gzip", false, true},
+		{"refused", "header=Content-Type:text/plain", "br;q=0, gzip", false, true},
+		{"sniffed", "", "br", true, true},
+		{"json with parameters", "header=Content-Type:application/json; charset=utf-8", "br", true, true},
+		{"other type", "header=Content-Type:image/png", "br", false, false},
+		{"small", "size=99&header=Content-Type:text/plain", "br", false, false},
+		{"at MinSize", "size=100&header=Content-Type:text/plain", "br", true, true},
+		{"empty", "size=0", "br", false, false},
+		{"small Content-Length", "size=50&header=Content-Type:text/plain,Content-Length:50", "br", false, false},
+		{"Content-Length", "header=Content-Type:text/plain,Content-Length:20000", "br", true, true},
+		{"encoded", "header=Content-Type:text/plain,Content-Encoding:gzip", "br", false, false},
+		{"identity", "header=Content-Type:text/plain,Content-Encoding:identity", "br", true, true},
+		{"range", "status=206&header=Content-Type:text/plain,Content-Range:bytes 0-19999/30000", "br", false, false},
+		{"error", "status=404&header=Content-Type:text/plain", "br", true, true},
+	} {
+		req := httptest.NewRequest("GET", "/?"+strings.ReplaceAll(tc.query, " ", "%20"), nil)
+		req.Header.Set("Accept-Encoding", tc.accept)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		resp := rec.Result()
+		want := text
+		if n := req.URL.Query().Get("size"); n != "" {
+			size, _ := strconv.Atoi(n)
+			want = text[:size]
+		}
+		body := rec.Body.Bytes()
+		if got := resp.Header.Get("Content-Encoding") == "br"; got != tc.compressed {
+			t.Errorf("%s: Content-Encoding=%q", tc.name, resp.Header.Get("Content-Encoding"))
+			continue
+		}
+		if got := resp.Header.Get("Vary") == "Accept-Encoding"; got != tc.vary {
+			t.Errorf("%s: Vary=%q", tc.name, resp.Header.Get("Vary"))
+		}
+		if tc.compressed {
+			if resp.Header.Get("Content-Length") != "" {
+				t.Errorf("%s: Content-Length=%q", tc.name, resp.Header.Get("Content-Length"))
+			}
+			var err error
+			if body, err = cbrotli.Decode(body); err != nil {
+				t.Errorf("%s: Decode: %v", tc.name, err)
+				continue
+			}
+		}
+		if !bytes.Equal(body, want) {
+			t.Errorf("%s: got %d bytes, want %d", tc.name, len(body), len(want))
+		}
+		if tc.name == "sniffed" && resp.Header.Get("Content-Type") != "text/plain; charset=utf-8" {
+			t.Errorf("%s: Content-Type=%q", tc.name, resp.Header.Get("Content-Type"))
+		}
+	}
+
+	// Responses without a body and upgrades are untouched.
+	for _, status := range []int{http.StatusNoContent, http.StatusNotModified} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/?header=Content-Type:text/plain&size=0&status="+strconv.Itoa(status), nil)
+		req.Header.Set("Accept-Encoding", "br")
+		handler.ServeHTTP(rec, req)
+		if rec.Code != status || len(rec.Header()) != 1 || rec.Body.Len() != 0 {
+			t.Errorf("status %d: got %d, header %v, %d bytes", status, rec.Code, rec.Header(), rec.Body.Len())
+		}
+	}
+	upgrade := cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if _, ok := rw.(*httptest.ResponseRecorder); !ok {
+			t.Errorf("upgrade: ResponseWriter is wrapped")
+		}
+	}), cbrotli.HTTPOptions{})
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Encoding", "br")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	upgrade.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestHTTPHandlerStreaming(t *testing.T) {
+	parts := [][]byte{wordSoup(41, 5000), wordSoup(42, 5000), wordSoup(43, 5000)}
+	received := make(chan bool)
+	server := httptest.NewServer(cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/plain")
+		for _, part := range parts {
+			rw.Write(part)
+			// Without automatic flushes, the client would wait forever.
+			<-received
+		}
+	}), cbrotli.HTTPOptions{Writer: cbrotli.WriterOptions{Quality: 5, FlushInterval: 10 * time.Millisecond}}))
+	defer server.Close()
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Accept-Encoding", "br")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Encoding") != "br" {
+		t.Fatalf("Content-Encoding=%q", resp.Header.Get("Content-Encoding"))
+	}
+	r := cbrotli.NewReader(resp.Body)
+	defer r.Close()
+	for i, part := range parts {
+		got := make([]byte, len(part))
+		if _, err := io.ReadFull(r, got); err != nil || !bytes.Equal(got, part) {
+			t.Fatalf("part %d: %v", i, err)
+		}
+		received <- true
+	}
+	if rest, err := io.ReadAll(r); err != nil || len(rest) != 0 {
+		t.Errorf("end of stream: %d bytes, %v", len(rest), err)
+	}
+}
+
+// closeTracker records whether the bodies of the responses of a
+// RoundTripper have been closed.
+type closeTracker struct {
+	base   http.RoundTripper
+	closed atomic.Int32
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	t *closeTracker
+}
+
+func (b trackedBody) Close() error {
+	b.t.closed.Add(1)
+	return b.ReadCloser.Close()
+}
+
+func (t *closeTracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		resp.Body = trackedBody{resp.Body, t}
+	}
+	return resp, err
+}
+
+func TestRoundTripper(t *testing.T) {
+	content := wordSoup(51, 10000)
+	encoded, err := cbrotli.Encode(content, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !strings.Contains(req.Header.Get("Accept-Encoding"), "br") || req.URL.Path == "/plain" {
+			rw.Write(content)
+			return
+		}
+		rw.Header().Set("Content-Encoding", "br")
+		if req.URL.Path == "/empty" {
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+		rw.Header().Set("Content-Length", strconv.Itoa(len(encoded)))
+		rw.Write(encoded)
+	}))
+	defer server.Close()
+	tracker := &closeTracker{base: http.DefaultTransport}
+	client := &http.Client{Transport: cbrotli.NewRoundTripper(tracker)}
+
+	for _, tc := range []struct {
+		method, path, accept string
+		decoded              bool
+	}{
+		{"GET", "/", "", true},
+		{"GET", "/plain", "", false},
+		{"GET", "/", "br", false},
+		{"GET", "/empty", "", false},
+		{"HEAD", "/", "", false},
+	} {
+		req, _ := http.NewRequest(tc.method, server.URL+tc.path, nil)
+		if tc.accept != "" {
+			req.Header.Set("Accept-Encoding", tc.accept)
+		}
+		before := tracker.closed.Load()
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", tc.method, tc.path, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Errorf("%s %s (Accept-Encoding %q): %v", tc.method, tc.path, tc.accept, err)
+			continue
+		}
+		if got := tracker.closed.Load() - before; got != 1 {
+			t.Errorf("%s %s: body closed %d times", tc.method, tc.path, got)
+		}
+		want := content
+		switch {
+		case tc.method == "HEAD" || tc.path == "/empty":
+			want = nil
+		case tc.accept != "":
+			want = encoded
+		}
+		if !bytes.Equal(body, want) {
+			t.Errorf("%s %s (Accept-Encoding %q): got %d bytes, want %d", tc.method, tc.path, tc.accept, len(body), len(want))
+		}
+		if resp.Uncompressed != tc.decoded {
+			t.Errorf("%s %s: Uncompressed=%v", tc.method, tc.path, resp.Uncompressed)
+		}
+		if tc.decoded && (resp.Header.Get("Content-Encoding") != "" || resp.Header.Get("Content-Length") != "" || resp.ContentLength != -1) {
+			t.Errorf("%s %s: header %v, ContentLength %d", tc.method, tc.path, resp.Header, resp.ContentLength)
+		}
+		if tc.accept != "" && resp.Header.Get("Content-Encoding") != "br" {
+			t.Errorf("%s %s: Content-Encoding removed from a response requested compressed", tc.method, tc.path)
+		}
+	}
+}
+
+// readerFromRecorder is a ResponseRecorder that counts ReadFrom calls.
+type readerFromRecorder struct {
+	*httptest.ResponseRecorder
+	readFroms int
+}
+
+func (r *readerFromRecorder) ReadFrom(src io.Reader) (int64, error) {
+	r.readFroms++
+	return io.Copy(r.ResponseRecorder, src)
+}
+
+func TestHTTPHandlerFlush(t *testing.T) {
+	events := []string{"data: first\n\n", "data: second\n\n", "data: third\n\n"}
+	received := make(chan bool)
+	server := httptest.NewServer(cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		controller := http.NewResponseController(rw)
+		for i, event := range events {
+			io.WriteString(rw, event)
+			// Both ways of flushing work.
+			if i%2 == 0 {
+				rw.(http.Flusher).Flush()
+			} else if err := controller.Flush(); err != nil {
+				t.Errorf("ResponseController.Flush: %v", err)
+			}
+			<-received
+		}
+	}), cbrotli.HTTPOptions{Writer: cbrotli.WriterOptions{Quality: 5}, ContentTypes: []string{"text/event-stream"}}))
+	defer server.Close()
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Accept-Encoding", "br")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Encoding") != "br" {
+		t.Fatalf("Content-Encoding=%q", resp.Header.Get("Content-Encoding"))
+	}
+	r := cbrotli.NewReader(resp.Body)
+	defer r.Close()
+	for _, event := range events {
+		got := make([]byte, len(event))
+		if _, err := io.ReadFull(r, got); err != nil || string(got) != event {
+			t.Fatalf("got %q, %v; want %q", got, err, event)
+		}
+		received <- true
+	}
+	if rest, err := io.ReadAll(r); err != nil || len(rest) != 0 {
+		t.Errorf("end of stream: %d bytes, %v", len(rest), err)
+	}
+}
+
+func TestHTTPHandlerHijackAndReadFrom(t *testing.T) {
+	// Hijacking works when the server supports it.
+	server := httptest.NewServer(cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, buf, err := rw.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("Hijack: %v", err)
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+		buf.Flush()
+	}), cbrotli.HTTPOptions{}))
+	defer server.Close()
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Accept-Encoding", "br")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || string(body) != "hijacked" {
+		t.Errorf("hijacked response: %q, %v", body, err)
+	}
+
+	text := wordSoup(61, 20000)
+	handler := cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", req.URL.Query().Get("type"))
+		// ResponseRecorder lacks the capabilities.
+		if _, _, err := http.NewResponseController(rw).Hijack(); !errors.Is(err, http.ErrNotSupported) {
+			t.Errorf("Hijack: %v, want ErrNotSupported", err)
+		}
+		if n, err := rw.(io.ReaderFrom).ReadFrom(bytes.NewReader(text)); n != int64(len(text)) || err != nil {
+			t.Errorf("ReadFrom: %d, %v", n, err)
+		}
+	}), cbrotli.HTTPOptions{Writer: cbrotli.WriterOptions{Quality: 5}})
+	for _, tc := range []struct {
+		contentType string
+		compressed  bool
+	}{
+		{"text/plain", true},
+		{"image/png", false},
+	} {
+		rec := &readerFromRecorder{ResponseRecorder: httptest.NewRecorder()}
+		req := httptest.NewRequest("GET", "/?type="+tc.contentType, nil)
+		req.Header.Set("Accept-Encoding", "br")
+		handler.ServeHTTP(rec, req)
+		body := rec.Body.Bytes()
+		if tc.compressed {
+			if body, err = cbrotli.Decode(body); err != nil {
+				t.Errorf("%s: Decode: %v", tc.contentType, err)
+				continue
+			}
+		}
+		if !bytes.Equal(body, text) {
+			t.Errorf("%s: got %d bytes, want %d", tc.contentType, len(body), len(text))
+		}
+		// Uncompressed responses are read by the wrapped ResponseWriter.
+		if want := map[bool]int{true: 0, false: 1}[tc.compressed]; rec.readFroms != want {
+			t.Errorf("%s: %d ReadFrom calls, want %d", tc.contentType, rec.readFroms, want)
+		}
+	}
+}
+
+func TestPrecompressedHandler(t *testing.T) {
+	js := bytes.Repeat([]byte("console.log('hello');\n"), 50)
+	jsBr, err := cbrotli.Encode(js, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := []byte("<!DOCTYPE html>\n<title>index</title>\n")
+	indexBr, err := cbrotli.Encode(index, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	root := fstest.MapFS{
+		"app.js":            {Data: js, ModTime: mtime},
+		"app.js.br":         {Data: jsBr, ModTime: mtime},
+		"app.js.gz":         {Data: []byte("not really gzip"), ModTime: mtime},
+		"plain.txt":         {Data: []byte("plain\n"), ModTime: mtime},
+		"sub/index.html":    {Data: index},
+		"sub/index.html.br": {Data: indexBr},
+		"only.css.br":       {Data: jsBr, ModTime: mtime},
+	}
+	h := cbrotli.NewPrecompressedHandler(root, cbrotli.PrecompressedOptions{})
+
+	get := func(target string, header ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest("GET", target, nil)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Sidecars by negotiation.
+	br := get("/app.js", "Accept-Encoding", "gzip, br")
+	if br.Code != 200 || br.Header().Get("Content-Encoding") != "br" || !bytes.Equal(br.Body.Bytes(), jsBr) {
+		t.Fatalf("br: %d %q %d bytes", br.Code, br.Header().Get("Content-Encoding"), br.Body.Len())
+	}
+	if got := br.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/javascript") {
+		t.Errorf("br: Content-Type = %q", got)
+	}
+	if got := br.Header().Get("Vary"); got != "Accept-Encoding" {
+		t.Errorf("br: Vary = %q", got)
+	}
+	gz := get("/app.js", "Accept-Encoding", "gzip;q=1, br;q=0.5")
+	if gz.Header().Get("Content-Encoding") != "gzip" || gz.Body.String() != "not really gzip" {
+		t.Errorf("gzip: %q %q", gz.Header().Get("Content-Encoding"), gz.Body.String())
+	}
+	identity := get("/app.js")
+	if identity.Header().Get("Content-Encoding") != "" || !bytes.Equal(identity.Body.Bytes(), js) {
+		t.Errorf("identity: %q %d bytes", identity.Header().Get("Content-Encoding"), identity.Body.Len())
+	}
+	if got := identity.Header().Get("Vary"); got != "Accept-Encoding" {
+		t.Errorf("identity: Vary = %q", got)
+	}
+	refused := get("/app.js", "Accept-Encoding", "*;q=0")
+	if refused.Code != 200 || !bytes.Equal(refused.Body.Bytes(), js) {
+		t.Errorf("all refused: %d %d bytes", refused.Code, refused.Body.Len())
+	}
+
+	// One ETag per variant, honored by conditional requests.
+	tags := map[string]bool{}
+	for _, rec := range []*httptest.ResponseRecorder{br, gz, identity} {
+		tag := rec.Header().Get("Etag")
+		if !strings.HasPrefix(tag, `"`) || tags[tag] {
+			t.Errorf("ETag %q is not a distinct strong ETag", tag)
+		}
+		tags[tag] = true
+	}
+	if again := get("/app.js", "Accept-Encoding", "br"); again.Header().Get("Etag") != br.Header().Get("Etag") {
+		t.Errorf("ETag changed: %q, was %q", again.Header().Get("Etag"), br.Header().Get("Etag"))
+	}
+	if rec := get("/app.js", "Accept-Encoding", "br", "If-None-Match", br.Header().Get("Etag")); rec.Code != http.StatusNotModified {
+		t.Errorf("If-None-Match of the br variant: %d", rec.Code)
+	}
+	if rec := get("/app.js", "If-None-Match", br.Header().Get("Etag")); rec.Code != 200 {
+		t.Errorf("If-None-Match of the br variant for identity: %d", rec.Code)
+	}
+
+	// Ranges select bytes of the compressed variant.
+	rec := get("/app.js", "Accept-Encoding", "br", "Range", "bytes=0-3")
+	if rec.Code != http.StatusPartialContent || !bytes.Equal(rec.Body.Bytes(), jsBr[:4]) {
+		t.Errorf("range: %d %x", rec.Code, rec.Body.Bytes())
+	}
+	if got, want := rec.Header().Get("Content-Range"), fmt.Sprintf("bytes 0-3/%d", len(jsBr)); got != want {
+		t.Errorf("range: Content-Range = %q, want %q", got, want)
+	}
+
+	// No sidecar: as http.FileServer, without Vary.
+	if rec := get("/plain.txt", "Accept-Encoding", "br"); rec.Body.String() != "plain\n" || rec.Header().Get("Vary") != "" {
+		t.Errorf("plain: %q, Vary %q", rec.Body.String(), rec.Header().Get("Vary"))
+	}
+	// Index files, with a digest ETag for want of a modification time.
+	rec = get("/sub/", "Accept-Encoding", "br")
+	if rec.Header().Get("Content-Encoding") != "br" || !bytes.Equal(rec.Body.Bytes(), indexBr) {
+		t.Errorf("index: %q %d bytes", rec.Header().Get("Content-Encoding"), rec.Body.Len())
+	}
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/html") {
+		t.Errorf("index: Content-Type = %q", got)
+	}
+	if rec.Header().Get("Etag") == "" {
+		t.Error("index: no ETag")
+	}
+	if rec := get("/sub"); rec.Code != http.StatusMovedPermanently {
+		t.Errorf("directory without slash: %d", rec.Code)
+	}
+	if rec := get("/sub/index.html", "Accept-Encoding", "br"); rec.Code != http.StatusMovedPermanently {
+		t.Errorf("index.html: %d", rec.Code)
+	}
+	// Sidecar without identity file.
+	if rec := get("/only.css", "Accept-Encoding", "br"); rec.Code != 200 || rec.Header().Get("Content-Encoding") != "br" {
+		t.Errorf("sidecar only: %d %q", rec.Code, rec.Header().Get("Content-Encoding"))
+	}
+	if rec := get("/only.css"); rec.Code != http.StatusNotAcceptable {
+		t.Errorf("sidecar only, identity: %d", rec.Code)
+	}
+	// Paths are cleaned as by http.FileServer.
+	for _, target := range []string{"/../app.js", "/sub/../app.js"} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.URL.Path = target
+		req.Header.Set("Accept-Encoding", "br")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != 200 || !bytes.Equal(rec.Body.Bytes(), jsBr) {
+			t.Errorf("%s: %d %d bytes", target, rec.Code, rec.Body.Len())
+		}
+	}
+	if rec := get("/missing.js", "Accept-Encoding", "br"); rec.Code != http.StatusNotFound {
+		t.Errorf("missing: %d", rec.Code)
+	}
+}
+
+func TestCompressFile(t *testing.T) {
+	dir := t.TempDir()
+	content := bytes.Repeat([]byte("file content\n"), 1000)
+	src := filepath.Join(dir, "data.txt")
+	if err := os.WriteFile(src, content, 0640); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	if err := os.Chtimes(src, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	// Only the expected files, without temporary ones.
+	checkDir := func(want ...string) {
+		t.Helper()
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Name())
+		}
+		if strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Errorf("directory has %q, want %q", got, want)
+		}
+	}
+
+	compressed := src + ".br"
+	if err := cbrotli.CompressFile(src, compressed, cbrotli.WriterOptions{Quality: 5}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0640 {
+		t.Errorf("mode = %v, want 0640", info.Mode().Perm())
+	}
+	if !info.ModTime().Equal(mtime) {
+		t.Errorf("modification time = %v, want %v", info.ModTime(), mtime)
+	}
+	data, err := os.ReadFile(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCompressedData(data, content); err != nil {
+		t.Error(err)
+	}
+
+	// Existing destinations are kept unless overwriting is allowed.
+	if err := cbrotli.CompressFile(src, compressed, cbrotli.WriterOptions{Quality: 1}); !errors.Is(err, fs.ErrExist) {
+		t.Errorf("existing destination: got %v, want fs.ErrExist", err)
+	}
+	if got, _ := os.ReadFile(compressed); !bytes.Equal(got, data) {
+		t.Error("existing destination changed")
+	}
+	if err := cbrotli.CompressFile(src, src, cbrotli.WriterOptions{}); err == nil {
+		t.Error("compressing a file onto itself succeeded")
+	}
+	err = cbrotli.CompressFileWithOptions(src, compressed, cbrotli.FileOptions{
+		Writer: cbrotli.WriterOptions{Quality: 1}, Overwrite: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(compressed); bytes.Equal(got, data) {
+		t.Error("destination not overwritten")
+	}
+
+	// Decompress, removing the source.
+	decompressed := filepath.Join(dir, "decompressed.txt")
+	if err := cbrotli.DecompressFileWithOptions(compressed, decompressed, cbrotli.FileOptions{RemoveSource: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(decompressed); !bytes.Equal(got, content) {
+		t.Error("decompressed content differs")
+	}
+	checkDir("data.txt", "decompressed.txt")
+
+	// Failures midway leave nothing.
+	truncated := filepath.Join(dir, "truncated.br")
+	if err := os.WriteFile(truncated, data[:len(data)/2], 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := cbrotli.DecompressFile(truncated, filepath.Join(dir, "out.txt")); err == nil {
+		t.Error("decompressing a truncated file succeeded")
+	}
+	if err := cbrotli.CompressFile(src, filepath.Join(dir, "out.br"), cbrotli.WriterOptions{Quality: 99}); err == nil {
+		t.Error("compressing with invalid options succeeded")
+	}
+	if err := cbrotli.CompressFile(filepath.Join(dir, "missing"), filepath.Join(dir, "out.br"), cbrotli.WriterOptions{}); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("missing source: got %v", err)
+	}
+	checkDir("data.txt", "decompressed.txt", "truncated.br")
+}
+
+func TestNewWriterLevel(t *testing.T) {
+	// JSON-like records, and a dictionary that only helps with their end.
+	rnd := rand.New(rand.NewSource(1))
+	names := strings.Fields("alice bob carol dave erin frank grace heidi ivan judy")
+	var records bytes.Buffer
+	for i := 0; records.Len() < 64<<10; i++ {
+		fmt.Fprintf(&records, `{"id":%d,"name":%q,"score":%d,"active":%t}`+"\n",
+			i, names[rnd.Intn(len(names))], rnd.Intn(1000), rnd.Intn(2) == 0)
+	}
+	dict := []byte(fmt.Sprint(rnd.Perm(2000)))
+	content := append(records.Bytes(), dict...)
+	compress := func(level int, dict []byte) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		w, err := cbrotli.NewWriterDict(&buf, level, dict)
+		if err != nil {
+			t.Fatalf("level %d: %v", level, err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	prev := -1
+	for level := cbrotli.NoCompression; level <= cbrotli.BestCompression; level++ {
+		out := compress(level, nil)
+		if err := checkCompressedData(out, content); err != nil {
+			t.Fatalf("level %d: %v", level, err)
+		}
+		if prev >= 0 && len(out) > prev {
+			t.Errorf("level %d: %d bytes, more than %d at level %d", level, len(out), prev, level-1)
+		}
+		prev = len(out)
+	}
+	if got, want := len(compress(cbrotli.DefaultCompression, nil)), len(compress(6, nil)); got != want {
+		t.Errorf("DefaultCompression: %d bytes, level 6: %d", got, want)
+	}
+	for _, level := range []int{-3, 10} {
+		if w, err := cbrotli.NewWriterLevel(io.Discard, level); err == nil || w != nil {
+			t.Errorf("level %d: got %v, %v; want an error", level, w, err)
+		}
+	}
+	w, err := cbrotli.NewWriterLevel(io.Discard, cbrotli.HuffmanOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	withDict := compress(5, dict)
+	if len(withDict) >= len(compress(5, nil)) {
+		t.Error("the dictionary does not help")
+	}
+	r := cbrotli.NewReaderDict(bytes.NewReader(withDict), dict)
+	defer r.Close()
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Error("content decoded with the dictionary differs")
+	}
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	dictionary := []byte(strings.Repeat("shared dictionary content; ", 20))
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	enc, err := cbrotli.NewEncoder(cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer enc.Close()
+	dec, err := cbrotli.NewDecoder(cbrotli.ReaderOptions{RawDictionary: dictionary, MaxDecodedSize: 10000})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				content := []byte(fmt.Sprintf("%s%d/%d", dictionary[:100+g*10+i], g, i))
+				prefix := []byte("prefix")
+				encoded := enc.EncodeAll(content, prefix)
+				if !bytes.HasPrefix(encoded, prefix) {
+					t.Error("EncodeAll dropped dst")
+					return
+				}
+				decoded, err := dec.DecodeAll(encoded[len(prefix):], prefix[:3:3])
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if want := append([]byte("pre"), content...); !bytes.Equal(decoded, want) {
+					t.Errorf("DecodeAll = %q, want %q", decoded, want)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	// The size limit applies to each call.
+	big := enc.EncodeAll(bytes.Repeat([]byte{'x'}, 10001), nil)
+	if out, err := dec.DecodeAll(big, []byte("dst")); err != cbrotli.ErrDecodedTooLarge || string(out) != "dst" {
+		t.Errorf("too large: got %q, %v", out, err)
+	}
+	exact := enc.EncodeAll(bytes.Repeat([]byte{'x'}, 10000), nil)
+	if out, err := dec.DecodeAll(exact, nil); err != nil || len(out) != 10000 {
+		t.Errorf("at the limit: got %d bytes, %v", len(out), err)
+	}
+	if _, err := dec.DecodeAll(exact[:len(exact)-1], nil); err != cbrotli.ErrTruncated {
+		t.Errorf("truncated: got %v", err)
+	}
+	if err := dec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dec.DecodeAll(exact, nil); err == nil {
+		t.Error("DecodeAll succeeded after Close")
+	}
+
+	if _, err := cbrotli.NewEncoder(cbrotli.WriterOptions{Quality: 12}); err == nil {
+		t.Error("NewEncoder accepted an invalid quality")
+	}
+	if _, err := cbrotli.NewDecoder(cbrotli.ReaderOptions{Dictionaries: []cbrotli.Dictionary{{Type: cbrotli.DtSerialized, Data: []byte("x")}}}); err == nil {
+		t.Error("NewDecoder accepted an invalid dictionary")
+	}
+}
+
+func BenchmarkEncodeAll(b *testing.B) {
+	inputs := batchInputs(100)
+	options := cbrotli.WriterOptions{Quality: 5}
+	var size int64
+	for _, input := range inputs {
+		size += int64(len(input))
+	}
+	enc, err := cbrotli.NewEncoder(options)
+	if err != nil {
+		b.Fatal(err)
+	}
+	dec, err := cbrotli.NewDecoder(cbrotli.ReaderOptions{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer dec.Close()
+	encoded := make([][]byte, len(inputs))
+	for i, input := range inputs {
+		encoded[i] = enc.EncodeAll(input, nil)
+	}
+	b.Run("EncodeAll", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		var dst []byte
+		for i := 0; i < b.N; i++ {
+			for _, input := range inputs {
+				dst = enc.EncodeAll(input, dst[:0])
+			}
+		}
+	})
+	b.Run("Encode", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		for i := 0; i < b.N; i++ {
+			for _, input := range inputs {
+				if _, err := cbrotli.Encode(input, options); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("DecodeAll", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		var dst []byte
+		for i := 0; i < b.N; i++ {
+			for _, e := range encoded {
+				if dst, err = dec.DecodeAll(e, dst[:0]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("Decode", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(size)
+		for i := 0; i < b.N; i++ {
+			for _, e := range encoded {
+				if _, err := cbrotli.Decode(e); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestHTTPHandlerBuffered(t *testing.T) {
+	const bufferSize = 4096
+	content := wordSoup(44, 2*bufferSize)
+	waiting, release := make(chan bool), make(chan bool)
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/plain")
+		n, _ := strconv.Atoi(req.URL.Query().Get("n"))
+		// Several writes, so that the threshold can be crossed midway.
+		for i := 0; i < n; i += 1000 {
+			rw.Write(content[i:min(i+1000, n)])
+		}
+		switch req.URL.Query().Get("then") {
+		case "wait":
+			waiting <- true
+			<-release
+		case "flush":
+			rw.(http.Flusher).Flush()
+		case "panic":
+			panic(http.ErrAbortHandler)
+		}
+	})
+	h := cbrotli.NewHTTPHandler(handler, cbrotli.HTTPOptions{
+		Writer:       cbrotli.WriterOptions{Quality: 5},
+		MinSize:      100,
+		BufferSize:   bufferSize,
+		BufferMemory: 5000, // room for a single buffer
+	})
+	get := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", target, nil)
+		req.Header.Set("Accept-Encoding", "br")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+	check := func(rec *httptest.ResponseRecorder, n int, buffered bool) {
+		t.Helper()
+		if err := checkCompressedData(rec.Body.Bytes(), content[:n]); err != nil {
+			t.Errorf("%d bytes: %v", n, err)
+		}
+		length := rec.Header().Get("Content-Length")
+		if buffered && length != strconv.Itoa(rec.Body.Len()) {
+			t.Errorf("%d bytes: Content-Length = %q, want %d", n, length, rec.Body.Len())
+		}
+		if !buffered && length != "" {
+			t.Errorf("%d bytes: streamed response has Content-Length %q", n, length)
+		}
+	}
+
+	// The threshold, also crossed by the bytes held until MinSize.
+	check(get("/?n=4096"), bufferSize, true)
+	check(get("/?n=4097"), bufferSize+1, false)
+	check(get("/?n=8192"), 2*bufferSize, false)
+	check(get("/?n=500&then=flush"), 500, false)
+
+	// Without memory for a second buffer, responses are streamed.
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- get("/?n=3000&then=wait") }()
+	<-waiting
+	check(get("/?n=2000"), 2000, false)
+	release <- true
+	check(<-done, 3000, true)
+	check(get("/?n=2000"), 2000, true)
+
+	// A handler failing after partial writes sends nothing.
+	server := httptest.NewServer(h)
+	defer server.Close()
+	req, _ := http.NewRequest("GET", server.URL+"/?n=2000&then=panic", nil)
+	req.Header.Set("Accept-Encoding", "br")
+	if resp, err := server.Client().Do(req); err == nil {
+		resp.Body.Close()
+		t.Errorf("aborted response: got %s", resp.Status)
+	}
+	check(get("/?n=2000"), 2000, true)
+
+	// HEAD responses have the length of the GET ones.
+	for _, method := range []string{"GET", "HEAD"} {
+		req, _ := http.NewRequest(method, server.URL+"/?n=3000", nil)
+		req.Header.Set("Accept-Encoding", "br")
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if method == "GET" {
+			if err := checkCompressedData(body, content[:3000]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if resp.ContentLength <= 0 || (method == "GET" && resp.ContentLength != int64(len(body))) {
+			t.Errorf("%s: Content-Length %d, body %d bytes", method, resp.ContentLength, len(body))
+		}
+	}
+}
+
+func TestHTTPHandlerEventLatency(t *testing.T) {
+	const events = 10
+	sent := make(chan time.Time, events)
+	server := httptest.NewServer(cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		for i := 0; i < events; i++ {
+			// Events are never flushed by the handler.
+			sent <- time.Now()
+			fmt.Fprintf(rw, "data: event %d\n\n", i)
+			time.Sleep(20 * time.Millisecond)
+		}
+	}), cbrotli.HTTPOptions{
+		Writer:        cbrotli.WriterOptions{Quality: 5},
+		MinSize:       1,
+		FlushInterval: 10 * time.Millisecond,
+	}))
+	defer server.Close()
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("Accept-Encoding", "br")
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	r := cbrotli.NewReader(resp.Body)
+	defer r.Close()
+	var worst time.Duration
+	for i := 0; i < events; i++ {
+		want := fmt.Sprintf("data: event %d\n\n", i)
+		got := make([]byte, len(want))
+		if _, err := io.ReadFull(r, got); err != nil || string(got) != want {
+			t.Fatalf("event %d: %q, %v", i, got, err)
+		}
+		worst = max(worst, time.Since(<-sent))
+		// A slow consumer.
+		time.Sleep(5 * time.Millisecond)
+	}
+	// Much less than the duration of the response, whatever the load.
+	if worst > 150*time.Millisecond {
+		t.Errorf("worst event latency %v", worst)
+	}
+}
+
+func TestConn(t *testing.T) {
+	a, b := net.Pipe()
+	client := cbrotli.NewConn(a, cbrotli.ConnOptions{Writer: cbrotli.WriterOptions{Quality: 5}})
+	server := cbrotli.NewConn(b, cbrotli.ConnOptions{Writer: cbrotli.WriterOptions{Quality: 1}})
+	const rounds = 20
+	serverErr := make(chan error, 1)
+	go func() {
+		// An echo server that upper-cases lines, until the client half-closes.
+		br := bufio.NewReader(server)
+		for {
+			line, err := br.ReadString('\n')
+			if err == io.EOF && line == "" {
+				break
+			}
+			if err != nil {
+				serverErr <- err
+				return
+			}
+			if _, err := io.WriteString(server, strings.ToUpper(line)); err != nil {
+				serverErr <- err
+				return
+			}
+		}
+		io.WriteString(server, "BYE\n")
+		serverErr <- server.Close()
+	}()
+
+	br := bufio.NewReader(client)
+	for i := 0; i < rounds; i++ {
+		request := fmt.Sprintf("request %d %s\n", i, strings.Repeat("x", i*100))
+		if _, err := io.WriteString(client, request); err != nil {
+			t.Fatal(err)
+		}
+		// Each request is answered before the next one is sent.
+		if err := client.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		reply, err := br.ReadString('\n')
+		if err != nil {
+			t.Fatalf("round %d: %v", i, err)
+		}
+		if reply != strings.ToUpper(request) {
+			t.Fatalf("round %d: got %q", i, reply)
+		}
+	}
+	if err := client.(interface{ CloseWrite() error }).CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Write([]byte("late")); err == nil {
+		t.Error("Write after CloseWrite succeeded")
+	}
+	rest, err := io.ReadAll(br)
+	if err != nil || string(rest) != "BYE\n" {
+		t.Errorf("after CloseWrite: %q, %v", rest, err)
+	}
+	if err := <-serverErr; err != nil {
+		t.Errorf("server: %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Error(err)
+	}
+	if _, err := client.Read(make([]byte, 1)); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("Read after Close: %v", err)
+	}
+}
+
+func TestConnDeadline(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+	c := cbrotli.NewConn(a, cbrotli.ConnOptions{})
+	done := make(chan error)
+	go func() {
+		_, err := c.Read(make([]byte, 10))
+		done <- err
+	}()
+	if err := c.SetReadDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("Read past the deadline: %v", err)
+	}
+	// Reads can be retried with a new deadline.
+	peer := cbrotli.NewConn(b, cbrotli.ConnOptions{})
+	go peer.Write([]byte("hello"))
+	c.SetReadDeadline(time.Time{})
+	got := make([]byte, 5)
+	if _, err := io.ReadFull(c, got); err != nil || string(got) != "hello" {
+		t.Errorf("Read after the deadline: %q, %v", got, err)
+	}
+	// Close unblocks a Read; the outgoing stream can not be ended without a
+	// reader at the other end, within the write deadline.
+	c.SetWriteDeadline(time.Now().Add(10 * time.Millisecond))
+	go func() {
+		_, err := c.Read(make([]byte, 10))
+		done <- err
+	}()
+	c.Close()
+	if err := <-done; err == nil {
+		t.Error("Read during Close succeeded")
+	}
+}
+
+// cancelingReader returns chunks of data, and cancels a context once n bytes
+// have been read.
+type cancelingReader struct {
+	data   []byte
+	n      int
+	cancel context.CancelFunc
+}
+
+func (r *cancelingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	m := copy(p[:min(len(p), 1000)], r.data)
+	r.data = r.data[m:]
+	if r.n -= m; r.n <= 0 {
+		r.cancel()
+	}
+	return m, nil
+}
+
+func TestCompressCopy(t *testing.T) {
+	content := wordSoup(45, 100000)
+	ctx := context.Background()
+	var compressed bytes.Buffer
+	written, err := cbrotli.CompressCopy(ctx, &compressed, bytes.NewReader(content), cbrotli.WriterOptions{Quality: 5})
+	if err != nil || written != int64(compressed.Len()) {
+		t.Fatalf("CompressCopy: %d, %v; %d bytes written", written, err, compressed.Len())
+	}
+	var decompressed bytes.Buffer
+	written, err = cbrotli.DecompressCopy(ctx, &decompressed, bytes.NewReader(compressed.Bytes()), cbrotli.ReaderOptions{})
+	if err != nil || written != int64(len(content)) || !bytes.Equal(decompressed.Bytes(), content) {
+		t.Fatalf("DecompressCopy: %d, %v", written, err)
+	}
+
+	// Cancellation midway.
+	ctx, cancel := context.WithCancel(context.Background())
+	var partial bytes.Buffer
+	written, err = cbrotli.CompressCopy(ctx, &partial, &cancelingReader{data: content, n: 50000, cancel: cancel}, cbrotli.WriterOptions{Quality: 5})
+	if err != context.Canceled || written != int64(partial.Len()) {
+		t.Errorf("canceled CompressCopy: %d, %v", written, err)
+	}
+	// Not a stream of the first part of the content.
+	if _, err := cbrotli.Decode(partial.Bytes()); err == nil {
+		t.Errorf("canceled CompressCopy wrote a complete stream of %d bytes", partial.Len())
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	src := &cancelingReader{data: compressed.Bytes(), n: 2000, cancel: cancel}
+	written, err = cbrotli.DecompressCopy(ctx, io.Discard, src, cbrotli.ReaderOptions{})
+	if err != context.Canceled || written == 0 || written == int64(len(content)) {
+		t.Errorf("canceled DecompressCopy: %d, %v", written, err)
+	}
+
+	// Destination errors.
+	dstErr := errors.New("destination failed")
+	_, err = cbrotli.CompressCopy(context.Background(), &quotaWriter{n: 100, err: dstErr}, bytes.NewReader(content), cbrotli.WriterOptions{Quality: 5})
+	if !errors.Is(err, dstErr) {
+		t.Errorf("CompressCopy to a failing destination: %v", err)
+	}
+	written, err = cbrotli.DecompressCopy(context.Background(), &quotaWriter{n: 40000, err: dstErr}, bytes.NewReader(compressed.Bytes()), cbrotli.ReaderOptions{})
+	if !errors.Is(err, dstErr) || written != 40000 {
+		t.Errorf("DecompressCopy to a failing destination: %d, %v", written, err)
+	}
+}
+
+// quotaWriter accepts n bytes, then fails with err.
+type quotaWriter struct {
+	n   int
+	err error
+}
+
+func (w *quotaWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, w.err
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func gzipObject(t testing.TB, content []byte, name string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Name = name
+	zw.Write(content)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestTranscodeGzipToBrotli(t *testing.T) {
+	content := wordSoup(47, 300000)
+	object := gzipObject(t, content, "report.txt")
+
+	var out bytes.Buffer
+	written, err := cbrotli.TranscodeGzipToBrotli(&out, bytes.NewReader(object), cbrotli.WriterOptions{Quality: 5})
+	if err != nil || written != int64(out.Len()) {
+		t.Fatalf("TranscodeGzipToBrotli: %d, %v; output has %d bytes", written, err, out.Len())
+	}
+	if err := checkCompressedData(out.Bytes(), content); err != nil {
+		t.Error(err)
+	}
+	if bytes.Contains(out.Bytes(), []byte("report.txt")) {
+		t.Errorf("file name kept without KeepName")
+	}
+
+	// Concatenated members are transcoded to one stream; KeepName copies the
+	// name of the first.
+	multi := append(gzipObject(t, content[:1000], "first.txt"), gzipObject(t, content[1000:], "second.txt")...)
+	out.Reset()
+	_, err = cbrotli.TranscodeGzipToBrotliWithOptions(&out, bytes.NewReader(multi),
+		cbrotli.TranscodeOptions{Writer: cbrotli.WriterOptions{Quality: 5}, KeepName: true})
+	if err != nil {
+		t.Fatalf("TranscodeGzipToBrotliWithOptions: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), content); err != nil {
+		t.Error(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("gzip-name:first.txt")) {
+		t.Errorf("file name not kept in metadata")
+	}
+
+	// Back to gzip.
+	var back bytes.Buffer
+	written, err = cbrotli.TranscodeBrotliToGzip(&back, bytes.NewReader(out.Bytes()), gzip.BestSpeed)
+	if err != nil || written != int64(back.Len()) {
+		t.Fatalf("TranscodeBrotliToGzip: %d, %v; output has %d bytes", written, err, back.Len())
+	}
+	zr, err := gzip.NewReader(&back)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded, err := io.ReadAll(zr); err != nil || !bytes.Equal(decoded, content) {
+		t.Errorf("gzip output decodes to %d bytes, %v", len(decoded), err)
+	}
+	if _, err := cbrotli.TranscodeBrotliToGzip(io.Discard, bytes.NewReader(out.Bytes()[:100]), gzip.BestSpeed); err != cbrotli.ErrTruncated {
+		t.Errorf("truncated Brotli input: got %v, want %v", err, cbrotli.ErrTruncated)
+	}
+	if _, err := cbrotli.TranscodeBrotliToGzip(io.Discard, bytes.NewReader(out.Bytes()), 42); err == nil {
+		t.Errorf("invalid gzip level accepted")
+	}
+
+	// Damaged gzip objects fail with GzipError, and leave no valid stream.
+	badCRC := bytes.Clone(object)
+	badCRC[len(badCRC)-8] ^= 1
+	badSize := bytes.Clone(object)
+	badSize[len(badSize)-1] ^= 1
+	for _, tc := range []struct {
+		name   string
+		object []byte
+		want   error
+	}{
+		{"checksum", badCRC, gzip.ErrChecksum},
+		{"size", badSize, gzip.ErrChecksum},
+		{"header", append([]byte("\x1f\x8c"), object[2:]...), gzip.ErrHeader},
+		{"truncated", object[:len(object)/2], io.ErrUnexpectedEOF},
+		{"empty", nil, io.ErrUnexpectedEOF},
+	} {
+		var out bytes.Buffer
+		written, err := cbrotli.TranscodeGzipToBrotli(&out, bytes.NewReader(tc.object), cbrotli.WriterOptions{Quality: 5})
+		var gzipErr cbrotli.GzipError
+		if !errors.As(err, &gzipErr) || !errors.Is(err, tc.want) || written != int64(out.Len()) {
+			t.Errorf("%s: got %d, %v; want GzipError of %v", tc.name, written, err, tc.want)
+		}
+		if _, err := cbrotli.Decode(out.Bytes()); err == nil {
+			t.Errorf("%s: output is a complete stream", tc.name)
+		}
+	}
+
+	// Source and destination errors are not GzipError.
+	errSource := errors.New("source failed")
+	_, err = cbrotli.TranscodeGzipToBrotli(io.Discard,
+		io.MultiReader(bytes.NewReader(object[:len(object)/2]), iotest.ErrReader(errSource)), cbrotli.WriterOptions{})
+	if err != errSource {
+		t.Errorf("failing source: got %v, want %v", err, errSource)
+	}
+	errDestination := errors.New("destination failed")
+	_, err = cbrotli.TranscodeGzipToBrotli(failingWriter{errDestination}, bytes.NewReader(object), cbrotli.WriterOptions{})
+	if err != errDestination {
+		t.Errorf("failing destination: got %v, want %v", err, errDestination)
+	}
+}
+
+func BenchmarkTranscodeGzipToBrotli(b *testing.B) {
+	content := wordSoup(48, 4<<20)
+	object := gzipObject(b, content, "")
+	options := cbrotli.WriterOptions{Quality: 5}
+	b.Run("streaming", func(b *testing.B) {
+		b.SetBytes(int64(len(content)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := cbrotli.TranscodeGzipToBrotli(io.Discard, bytes.NewReader(object), options); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("buffered", func(b *testing.B) {
+		b.SetBytes(int64(len(content)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			zr, err := gzip.NewReader(bytes.NewReader(object))
+			if err != nil {
+				b.Fatal(err)
+			}
+			decoded, err := io.ReadAll(zr)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := cbrotli.Encode(decoded, options); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestCompressingTransport(t *testing.T) {
+	content := wordSoup(49, 100000)
+	type received struct {
+		path, encoding string
+		length         int64
+		body           []byte
+	}
+	var (
+		mu  sync.Mutex
+		got []received
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		got = append(got, received{req.URL.Path, req.Header.Get("Content-Encoding"), req.ContentLength, body})
+		mu.Unlock()
+		if req.URL.Path == "/moved" {
+			http.Redirect(rw, req, "/upload", http.StatusTemporaryRedirect)
+		}
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: cbrotli.NewCompressingTransport(server.Client().Transport,
+		cbrotli.CompressingTransportOptions{Writer: cbrotli.WriterOptions{Quality: 5}})}
+
+	post := func(path, contentType string, body io.Reader, header http.Header) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+path, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("POST %s: %v", path, err)
+		}
+		resp.Body.Close()
+	}
+	check := func(name string, r received, wantPath string, compressed bool, want []byte) {
+		t.Helper()
+		body := r.body
+		if r.path != wantPath {
+			t.Errorf("%s: request to %s, want %s", name, r.path, wantPath)
+		}
+		if compressed {
+			if r.encoding != "br" || r.length != -1 {
+				t.Errorf("%s: Content-Encoding %q, ContentLength %d; want br, -1", name, r.encoding, r.length)
+			}
+			var err error
+			if body, err = cbrotli.Decode(body); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		} else if r.encoding == "br" {
+			t.Errorf("%s: compressed", name)
+		}
+		if !bytes.Equal(body, want) {
+			t.Errorf("%s: got body of %d bytes, want %d", name, len(body), len(want))
+		}
+	}
+
+	post("/upload", "application/json", bytes.NewReader(content), nil)
+	// Unknown length.
+	post("/upload", "application/json; charset=utf-8", io.MultiReader(bytes.NewReader(content)), nil)
+	// Redirects send the body again, compressed from GetBody.
+	post("/moved", "text/plain", bytes.NewReader(content), nil)
+	// Passed through.
+	post("/upload", "application/json", bytes.NewReader(content[:100]), nil)
+	post("/upload", "image/png", bytes.NewReader(content), nil)
+	post("/upload", "application/json", bytes.NewReader(content), http.Header{"Content-Encoding": {"gzip"}})
+	if len(got) != 7 {
+		t.Fatalf("server got %d requests, want 7", len(got))
+	}
+	check("known length", got[0], "/upload", true, content)
+	check("unknown length", got[1], "/upload", true, content)
+	check("before redirect", got[2], "/moved", true, content)
+	check("after redirect", got[3], "/upload", true, content)
+	check("short", got[4], "/upload", false, content[:100])
+	check("content type", got[5], "/upload", false, content)
+	if got[6].encoding != "gzip" || !bytes.Equal(got[6].body, content) {
+		t.Errorf("encoded body changed: Content-Encoding %q, %d bytes", got[6].encoding, len(got[6].body))
+	}
+
+	// GetBody compresses a new copy of the original body.
+	var sent *http.Request
+	transport := cbrotli.NewCompressingTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent = req
+		req.Body.Close()
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}), cbrotli.CompressingTransportOptions{})
+	req, _ := http.NewRequest(http.MethodPut, "http://example.com/", bytes.NewReader(content))
+	req.Header.Set("Content-Type", "text/csv")
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if sent.GetBody == nil || sent.Header.Get("Content-Length") != "" {
+		t.Fatalf("compressed request has GetBody %v, Content-Length %q", sent.GetBody != nil, sent.Header.Get("Content-Length"))
+	}
+	for i := 0; i < 2; i++ {
+		body, err := sent.GetBody()
+		if err != nil {
+			t.Fatal(err)
+		}
+		compressed, _ := io.ReadAll(body)
+		body.Close()
+		if err := checkCompressedData(compressed, content); err != nil {
+			t.Errorf("GetBody #%d: %v", i, err)
+		}
+	}
+	if req.Header.Get("Content-Encoding") != "" {
+		t.Errorf("original request modified")
+	}
+}
+
+func TestServeSeekable(t *testing.T) {
+	input := wordSoup(50, 300000)
+	var out bytes.Buffer
+	e := cbrotli.NewSeekableWriter(&out, cbrotli.WriterOptions{Quality: 5, ChunkSize: 40000})
+	e.Write(input)
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	sr, err := cbrotli.NewSeekableReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatalf("NewSeekableReader: %v", err)
+	}
+	modtime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/plain")
+		rw.Header().Set("Etag", `"v1"`)
+		cbrotli.ServeSeekable(rw, req, sr, modtime)
+	}))
+	defer server.Close()
+
+	get := func(header ...string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp, body
+	}
+
+	resp, body := get()
+	if resp.StatusCode != http.StatusOK || resp.ContentLength != int64(len(input)) || !bytes.Equal(body, input) {
+		t.Errorf("full content: status %d, length %d, %d bytes", resp.StatusCode, resp.ContentLength, len(body))
+	}
+
+	// Single ranges, within a frame, across frames, and from the end.
+	for _, tc := range []struct {
+		spec       string
+		start, end int
+	}{
+		{"bytes=0-99", 0, 100},
+		{"bytes=1000-1999", 1000, 2000},
+		{"bytes=39990-120009", 39990, 120010},
+		{"bytes=299000-", 299000, 300000},
+		{"bytes=-500", 299500, 300000},
+		{"bytes=250000-999999", 250000, 300000},
+	} {
+		resp, body := get("Range", tc.spec)
+		want := fmt.Sprintf("bytes %d-%d/%d", tc.start, tc.end-1, len(input))
+		if resp.StatusCode != http.StatusPartialContent || resp.Header.Get("Content-Range") != want {
+			t.Errorf("%s: status %d, Content-Range %q; want 206, %q", tc.spec, resp.StatusCode, resp.Header.Get("Content-Range"), want)
+		}
+		if !bytes.Equal(body, input[tc.start:tc.end]) {
+			t.Errorf("%s: body differs from the original", tc.spec)
+		}
+	}
+
+	// Multiple ranges.
+	resp, body = get("Range", "bytes=10-19,80000-80099,-10")
+	mediaType, params, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if resp.StatusCode != http.StatusPartialContent || mediaType != "multipart/byteranges" {
+		t.Fatalf("multiple ranges: status %d, Content-Type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	parts := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for _, want := range [][2]int{{10, 20}, {80000, 80100}, {299990, 300000}} {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		data, _ := io.ReadAll(part)
+		contentRange := fmt.Sprintf("bytes %d-%d/%d", want[0], want[1]-1, len(input))
+		if part.Header.Get("Content-Range") != contentRange || !bytes.Equal(data, input[want[0]:want[1]]) {
+			t.Errorf("part %q: got %q, %d bytes", contentRange, part.Header.Get("Content-Range"), len(data))
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("extra part: %v", err)
+	}
+
+	// Unsatisfiable ranges, If-Range and conditional requests.
+	if resp, _ := get("Range", "bytes=300000-"); resp.StatusCode != http.StatusRequestedRangeNotSatisfiable ||
+		resp.Header.Get("Content-Range") != "bytes */300000" {
+		t.Errorf("unsatisfiable range: status %d, Content-Range %q", resp.StatusCode, resp.Header.Get("Content-Range"))
+	}
+	if resp, body := get("Range", "bytes=0-9", "If-Range", `"v1"`); resp.StatusCode != http.StatusPartialContent || !bytes.Equal(body, input[:10]) {
+		t.Errorf("If-Range with current ETag: status %d", resp.StatusCode)
+	}
+	if resp, body := get("Range", "bytes=0-9", "If-Range", `"v0"`); resp.StatusCode != http.StatusOK || !bytes.Equal(body, input) {
+		t.Errorf("If-Range with old ETag: status %d", resp.StatusCode)
+	}
+	if resp, _ := get("If-None-Match", `"v1"`); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-None-Match: status %d, want 304", resp.StatusCode)
+	}
+	if resp, _ := get("If-Modified-Since", modtime.Format(http.TimeFormat)); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-Modified-Since: status %d, want 304", resp.StatusCode)
+	}
+	// The position for Read is not used.
+	if pos, _ := sr.Seek(0, io.SeekCurrent); pos != 0 {
+		t.Errorf("position moved to %d", pos)
+	}
+}
+
+func TestRecompressResponse(t *testing.T) {
+	content := wordSoup(51, 50000)
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/events":
+			// A slow gzip stream with a trailer.
+			rw.Header().Set("Content-Type", "text/event-stream")
+			rw.Header().Set("Content-Encoding", "gzip")
+			rw.Header().Set("Trailer", "X-Checksum")
+			zw := gzip.NewWriter(rw)
+			io.WriteString(zw, "data: first\n\n")
+			zw.Flush()
+			rw.(http.Flusher).Flush()
+			<-release
+			io.WriteString(zw, "data: second\n\n")
+			zw.Close()
+			rw.Header().Set("X-Checksum", "1234")
+		case "/gzip":
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Header().Set("Content-Encoding", "gzip")
+			rw.Header().Set("Etag", `"abc"`)
+			rw.Header().Set("Vary", "Accept-Encoding, Origin")
+			zw := gzip.NewWriter(rw)
+			zw.Write(content)
+			zw.Close()
+		case "/identity":
+			rw.Header().Set("Content-Type", "text/plain")
+			rw.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			rw.Write(content)
+		case "/short":
+			rw.Header().Set("Content-Type", "text/plain")
+			rw.Write(content[:100])
+		case "/image":
+			rw.Header().Set("Content-Type", "image/png")
+			rw.Write(content)
+		}
+	}))
+	defer upstream.Close()
+	target, _ := url.Parse(upstream.URL)
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.ModifyResponse = cbrotli.RecompressResponse(cbrotli.ProxyOptions{Writer: cbrotli.WriterOptions{Quality: 5}})
+	edge := httptest.NewServer(proxy)
+	defer edge.Close()
+
+	get := func(path, acceptEncoding string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, edge.URL+path, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	// Incremental delivery: the first event is decoded before the upstream
+	// sends the second one.
+	resp := get("/events", "br, gzip")
+	if resp.Header.Get("Content-Encoding") != "br" {
+		t.Fatalf("events: Content-Encoding %q, want br", resp.Header.Get("Content-Encoding"))
+	}
+	r := cbrotli.NewReader(resp.Body)
+	first := make([]byte, len("data: first\n\n"))
+	if _, err := io.ReadFull(r, first); err != nil || string(first) != "data: first\n\n" {
+		t.Fatalf("first event: %q, %v", first, err)
+	}
+	close(release)
+	rest, err := io.ReadAll(r)
+	if err != nil || string(rest) != "data: second\n\n" {
+		t.Errorf("second event: %q, %v", rest, err)
+	}
+	r.Close()
+	resp.Body.Close()
+	if got := resp.Trailer.Get("X-Checksum"); got != "1234" {
+		t.Errorf("trailer X-Checksum = %q, want 1234", got)
+	}
+
+	for _, tc := range []struct {
+		path, acceptEncoding, encoding string
+	}{
+		{"/gzip", "br, gzip", "br"},
+		{"/gzip", "gzip", "gzip"},
+		{"/identity", "br", "br"},
+		{"/identity", "gzip", ""},
+		{"/short", "br", ""},
+		{"/image", "br", ""},
+	} {
+		resp := get(tc.path, tc.acceptEncoding)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := tc.path + " with " + tc.acceptEncoding
+		if got := resp.Header.Get("Content-Encoding"); got != tc.encoding {
+			t.Errorf("%s: Content-Encoding %q, want %q", name, got, tc.encoding)
+			continue
+		}
+		switch tc.encoding {
+		case "br":
+			if resp.ContentLength != -1 {
+				t.Errorf("%s: ContentLength %d, want -1", name, resp.ContentLength)
+			}
+			body, err = cbrotli.Decode(body)
+		case "gzip":
+			var zr *gzip.Reader
+			if zr, err = gzip.NewReader(bytes.NewReader(body)); err == nil {
+				body, err = io.ReadAll(zr)
+			}
+		}
+		want := content
+		if tc.path == "/short" {
+			want = content[:100]
+		}
+		if err != nil || !bytes.Equal(body, want) {
+			t.Errorf("%s: decoded %d bytes, %v", name, len(body), err)
+		}
+	}
+
+	resp = get("/gzip", "br")
+	resp.Body.Close()
+	if etag := resp.Header.Get("Etag"); etag != `W/"abc"` {
+		t.Errorf("ETag %q, want %q", etag, `W/"abc"`)
+	}
+	if vary := resp.Header.Values("Vary"); len(vary) != 1 || vary[0] != "Accept-Encoding, Origin" {
+		t.Errorf("Vary %q, want Accept-Encoding listed once", vary)
+	}
+	resp = get("/identity", "br")
+	resp.Body.Close()
+	if vary := resp.Header.Values("Vary"); len(vary) != 1 || vary[0] != "Accept-Encoding" {
+		t.Errorf("Vary %q, want Accept-Encoding", vary)
+	}
+
+	// The Transport variant behaves the same.
+	client := &http.Client{Transport: cbrotli.NewRecompressingTransport(nil, cbrotli.ProxyOptions{})}
+	req, _ := http.NewRequest(http.MethodGet, upstream.URL+"/gzip", nil)
+	req.Header.Set("Accept-Encoding", "br")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err := checkCompressedData(body, content); err != nil || resp.Header.Get("Content-Encoding") != "br" {
+		t.Errorf("NewRecompressingTransport: Content-Encoding %q, %v", resp.Header.Get("Content-Encoding"), err)
+	}
+}
+
+func TestHTTPHandlerETag(t *testing.T) {
+	content := wordSoup(52, 20000)
+	modtime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/plain")
+		rw.Header().Set("Etag", `"v1"`)
+		rw.Header().Add("Vary", "Origin")
+		rw.Header().Add("Vary", "accept-encoding")
+		http.ServeContent(rw, req, "", modtime, bytes.NewReader(content))
+	})
+	for _, suffix := range []string{"", "-br"} {
+		handler := cbrotli.NewHTTPHandler(next, cbrotli.HTTPOptions{ETagSuffix: suffix})
+		get := func(header ...string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for i := 0; i < len(header); i += 2 {
+				req.Header.Set(header[i], header[i+1])
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec
+		}
+		want := `W/"v1"`
+		if suffix != "" {
+			want = `"v1-br"`
+		}
+		br := get("Accept-Encoding", "br")
+		if etag := br.Header().Get("Etag"); br.Header().Get("Content-Encoding") != "br" || etag != want {
+			t.Errorf("suffix %q: compressed ETag %q, want %q", suffix, etag, want)
+		}
+		if vary := br.Header().Values("Vary"); len(vary) != 2 || vary[0] != "Origin" || vary[1] != "accept-encoding" {
+			t.Errorf("suffix %q: Vary %q, want Accept-Encoding once", suffix, vary)
+		}
+		identity := get()
+		if etag := identity.Header().Get("Etag"); etag != `"v1"` {
+			t.Errorf("suffix %q: identity ETag %q, want %q", suffix, etag, `"v1"`)
+		}
+
+		// Revalidation with the rewritten tag gets the 304 of next as it is.
+		rec := get("Accept-Encoding", "br", "If-None-Match", want)
+		if rec.Code != http.StatusNotModified || rec.Header().Get("Content-Encoding") != "" || rec.Body.Len() != 0 {
+			t.Errorf("suffix %q: revalidation: status %d, Content-Encoding %q", suffix, rec.Code, rec.Header().Get("Content-Encoding"))
+		}
+		if etag := rec.Header().Get("Etag"); etag != `"v1"` {
+			t.Errorf("suffix %q: 304 ETag %q, want it untouched", suffix, etag)
+		}
+		rec = get("Accept-Encoding", "br", "If-None-Match", `"v0", `+want)
+		if rec.Code != http.StatusNotModified {
+			t.Errorf("suffix %q: revalidation with a list: status %d", suffix, rec.Code)
+		}
+		if rec := get("Accept-Encoding", "br", "If-None-Match", `"v0"`); rec.Code != http.StatusOK {
+			t.Errorf("suffix %q: stale tag: status %d", suffix, rec.Code)
+		}
+		if rec := get("If-None-Match", `"v1"`); rec.Code != http.StatusNotModified {
+			t.Errorf("suffix %q: identity revalidation: status %d", suffix, rec.Code)
+		}
+	}
+
+	// Vary without Accept-Encoding gets it appended.
+	handler := cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Vary", "Origin, Cookie")
+		rw.Write(content)
+	}), cbrotli.HTTPOptions{})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if vary := rec.Header().Values("Vary"); len(vary) != 2 || vary[1] != "Accept-Encoding" {
+		t.Errorf("Vary %q, want Accept-Encoding added", vary)
+	}
+}
+
+func TestHTTPHandlerPassThrough(t *testing.T) {
+	content := wordSoup(53, 20000)
+	png := append([]byte("\x89PNG\r\n\x1a\n"), content...)
+	for _, tc := range []struct {
+		name     string
+		options  cbrotli.HTTPOptions
+		header   []string // name, value pairs, set before writing
+		body     []byte
+		compress bool
+	}{
+		{"gzip", cbrotli.HTTPOptions{}, []string{"Content-Type", "text/plain", "Content-Encoding", "gzip"}, content, false},
+		{"identity", cbrotli.HTTPOptions{}, []string{"Content-Type", "text/plain", "Content-Encoding", "identity"}, content, true},
+		{"excluded", cbrotli.HTTPOptions{ContentTypes: []string{"application/*"}}, []string{"Content-Type", "application/zip"}, content, false},
+		{"excluded image", cbrotli.HTTPOptions{ContentTypes: []string{"image/*"}}, []string{"Content-Type", "image/png"}, png, false},
+		{"svg", cbrotli.HTTPOptions{ContentTypes: []string{"image/*"}}, []string{"Content-Type", "image/svg+xml"}, content, true},
+		{"custom exclusion", cbrotli.HTTPOptions{ExcludedContentTypes: []string{"text/csv"}}, []string{"Content-Type", "text/csv"}, content, false},
+		{"no exclusion", cbrotli.HTTPOptions{ContentTypes: []string{"image/*"}, ExcludedContentTypes: []string{}}, []string{"Content-Type", "image/png"}, png, true},
+		{"sniffed image", cbrotli.HTTPOptions{ContentTypes: []string{"image/*", "text/*"}}, nil, png, false},
+		{"sniffed text", cbrotli.HTTPOptions{}, nil, content, true},
+		{"short", cbrotli.HTTPOptions{MinSize: 200}, []string{"Content-Type", "text/plain"}, content[:100], false},
+	} {
+		handler := cbrotli.NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			for i := 0; i < len(tc.header); i += 2 {
+				rw.Header().Set(tc.header[i], tc.header[i+1])
+			}
+			rw.Header().Set("Content-Length", strconv.Itoa(len(tc.body)))
+			rw.WriteHeader(http.StatusOK)
+			rw.Write(tc.body)
+		}), tc.options)
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "br")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		body := rec.Body.Bytes()
+		if tc.compress {
+			if rec.Header().Get("Content-Encoding") != "br" || rec.Header().Get("Content-Length") != "" {
+				t.Errorf("%s: Content-Encoding %q, Content-Length %q; want compressed", tc.name,
+					rec.Header().Get("Content-Encoding"), rec.Header().Get("Content-Length"))
+				continue
+			}
+			var err error
+			if body, err = cbrotli.Decode(body); err != nil {
+				t.Errorf("%s: %v", tc.name, err)
+			}
+		} else {
+			if rec.Header().Get("Content-Encoding") == "br" || rec.Header().Get("Content-Length") != strconv.Itoa(len(tc.body)) {
+				t.Errorf("%s: Content-Encoding %q, Content-Length %q; want pass-through", tc.name,
+					rec.Header().Get("Content-Encoding"), rec.Header().Get("Content-Length"))
+			}
+		}
+		if !bytes.Equal(body, tc.body) {
+			t.Errorf("%s: body of %d bytes, want %d", tc.name, len(body), len(tc.body))
+		}
+	}
+}
+
+func TestCachingFileServer(t *testing.T) {
+	js := wordSoup(54, 20000)
+	css := wordSoup(55, 30000)
+	modtime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	root := fstest.MapFS{
+		"app.js":     {Data: js, ModTime: modtime},
+		"style.css":  {Data: css, ModTime: modtime},
+		"big.txt":    {Data: wordSoup(56, 100000), ModTime: modtime},
+		"logo.png":   {Data: js, ModTime: modtime},
+		"small.txt":  {Data: js[:100], ModTime: modtime},
+		"index.html": {Data: css, ModTime: modtime},
+	}
+	server := cbrotli.NewCachingFileServer(root, cbrotli.CachingFileServerOptions{
+		Writer:       cbrotli.WriterOptions{Quality: 5},
+		MaxEntrySize: 50000,
+	})
+	get := func(path string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+	checkBrotli := func(name string, rec *httptest.ResponseRecorder, want []byte) {
+		t.Helper()
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Encoding") != "br" {
+			t.Errorf("%s: status %d, Content-Encoding %q", name, rec.Code, rec.Header().Get("Content-Encoding"))
+			return
+		}
+		if err := checkCompressedData(rec.Body.Bytes(), want); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	// Concurrent misses compress once.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkBrotli("concurrent", get("/app.js", "Accept-Encoding", "br"), js)
+		}()
+	}
+	wg.Wait()
+	if stats := server.Stats(); stats.Misses != 1 || stats.Hits != 9 || stats.Entries != 1 {
+		t.Errorf("after concurrent requests: %+v", stats)
+	}
+
+	rec := get("/app.js", "Accept-Encoding", "br")
+	checkBrotli("hit", rec, js)
+	etag := rec.Header().Get("Etag")
+	if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `-br"`) ||
+		rec.Header().Get("Last-Modified") != modtime.Format(http.TimeFormat) ||
+		rec.Header().Get("Vary") != "Accept-Encoding" || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/javascript") {
+		t.Errorf("hit: header %v", rec.Header())
+	}
+	if rec := get("/app.js", "Accept-Encoding", "br", "If-None-Match", etag); rec.Code != http.StatusNotModified {
+		t.Errorf("revalidation: status %d", rec.Code)
+	}
+	if rec := get("/app.js"); rec.Header().Get("Content-Encoding") != "" || !bytes.Equal(rec.Body.Bytes(), js) ||
+		rec.Header().Get("Vary") != "Accept-Encoding" {
+		t.Errorf("identity: Content-Encoding %q, Vary %q", rec.Header().Get("Content-Encoding"), rec.Header().Get("Vary"))
+	}
+	checkBrotli("index", get("/", "Accept-Encoding", "br"), css)
+
+	// A changed file is compressed again and replaces its stale entry.
+	before := server.Stats()
+	js2 := wordSoup(57, 25000)
+	root["app.js"] = &fstest.MapFile{Data: js2, ModTime: modtime.Add(time.Hour)}
+	rec = get("/app.js", "Accept-Encoding", "br")
+	checkBrotli("changed", rec, js2)
+	if rec.Header().Get("Etag") == etag {
+		t.Errorf("changed file kept ETag %q", etag)
+	}
+	if stats := server.Stats(); stats.Misses != before.Misses+1 || stats.Entries != before.Entries {
+		t.Errorf("after change: %+v, was %+v", stats, before)
+	}
+
+	// Files over MaxEntrySize are compressed without caching; small and
+	// incompressible ones are served as they are.
+	before = server.Stats()
+	checkBrotli("big", get("/big.txt", "Accept-Encoding", "br"), root["big.txt"].Data)
+	for _, name := range []string{"/logo.png", "/small.txt"} {
+		if rec := get(name, "Accept-Encoding", "br"); rec.Header().Get("Content-Encoding") != "" || !bytes.Equal(rec.Body.Bytes(), root[name[1:]].Data) {
+			t.Errorf("%s: Content-Encoding %q", name, rec.Header().Get("Content-Encoding"))
+		}
+	}
+	if stats := server.Stats(); stats != before {
+		t.Errorf("uncached files changed the stats to %+v, was %+v", stats, before)
+	}
+
+	// Least recently used files are evicted.
+	limited := cbrotli.NewCachingFileServer(root, cbrotli.CachingFileServerOptions{MaxBytes: 12000})
+	for _, name := range []string{"/app.js", "/style.css", "/app.js"} {
+		req := httptest.NewRequest(http.MethodGet, name, nil)
+		req.Header.Set("Accept-Encoding", "br")
+		limited.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	if stats := limited.Stats(); stats.Misses != 3 || stats.Evictions != 2 || stats.Entries != 1 {
+		t.Errorf("limited cache: %+v", stats)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	v := cbrotli.Version()
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Version() = %q", v)
+	}
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err != nil {
+			t.Fatalf("Version() = %q", v)
+		}
+	}
+
+	// BundledVersion is that of the bundled sources.
+	header, err := os.ReadFile("csrc/common/version.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var numbers []string
+	for _, name := range []string{"MAJOR", "MINOR", "PATCH"} {
+		prefix := "#define BROTLI_VERSION_" + name + " "
+		for _, line := range strings.Split(string(header), "\n") {
+			if value, ok := strings.CutPrefix(line, prefix); ok {
+				numbers = append(numbers, strings.TrimSpace(value))
+			}
+		}
+	}
+	if got := strings.Join(numbers, "."); got != cbrotli.BundledVersion {
+		t.Errorf("csrc/common/version.h has version %q, BundledVersion is %q", got, cbrotli.BundledVersion)
+	}
+}
+
+// TestBundledSources checks that csrc is a copy of the C sources of the
+// repository, when they are available; csrc/update.sh refreshes it.
+func TestBundledSources(t *testing.T) {
+	const repo = "../../c"
+	if _, err := os.Stat(repo); err != nil {
+		t.Skip("C sources of the repository not found")
+	}
+	for _, dir := range []string{"common", "dec", "enc", "include/brotli"} {
+		for _, pattern := range []string{"*.c", "*.h"} {
+			want, _ := filepath.Glob(filepath.Join(repo, dir, pattern))
+			got, _ := filepath.Glob(filepath.Join("csrc", dir, pattern))
+			if len(got) != len(want) {
+				t.Errorf("csrc/%s has %d %s files, want %d", dir, len(got), pattern, len(want))
+				continue
+			}
+			for _, name := range want {
+				a, _ := os.ReadFile(name)
+				b, err := os.ReadFile(filepath.Join("csrc", dir, filepath.Base(name)))
+				if err != nil || !bytes.Equal(a, b) {
+					t.Errorf("csrc/%s/%s differs from %s", dir, filepath.Base(name), name)
+				}
+			}
+		}
+	}
+}
+
+// framedGolden is the content of testdata/frame/golden-v1.brc: it is written
+// in chunks of 16KiB at quality 5, with a Flush after 20000 bytes.
+func framedGolden(w io.Writer) error {
+	f := cbrotli.NewFrameWriter(w, cbrotli.WriterOptions{Quality: 5, ChunkSize: 16 << 10})
+	content := wordSoup(182, 100000)
+	if _, err := f.Write(content[:20000]); err != nil {
+		return err
+	}
+	if err := f.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.Write(content[20000:]); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func TestFrameWriter(t *testing.T) {
+	for _, size := range []int{0, 1, 16 << 10, 100000} {
+		content := wordSoup(int64(size), size)
+		var out bytes.Buffer
+		f := cbrotli.NewFrameWriter(&out, cbrotli.WriterOptions{Quality: 5, ChunkSize: 16 << 10})
+		if _, err := f.Write(content); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+		chunks, err := parseFramed(out.Bytes())
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		// Each full chunk of input is flushed; Close adds the end of the stream.
+		if want := size/(16<<10) + 1; len(chunks) != want {
+			t.Errorf("size %d: %d chunks, want %d", size, len(chunks), want)
+		}
+		if _, err := f.Write(nil); err != cbrotli.ErrWriterClosed {
+			t.Errorf("Write after Close: %v", err)
+		}
+	}
+
+	// Flush ends a chunk, which decodes to all of the input written before.
+	var out bytes.Buffer
+	f := cbrotli.NewFrameWriter(&out, cbrotli.WriterOptions{Quality: 5})
+	f.Write([]byte("hello "))
+	if err := f.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	flushed := out.Len()
+	f.Write([]byte("world"))
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	chunks, err := parseFramed(out.Bytes())
+	if err != nil || len(chunks) != 2 || flushed != 8+8+len(chunks[0]) {
+		t.Fatalf("%d chunks, %v", len(chunks), err)
+	}
+	r := cbrotli.NewReader(bytes.NewReader(chunks[0]))
+	defer r.Close()
+	p := make([]byte, 10)
+	if n, err := io.ReadAtLeast(r, p, 6); err != nil || string(p[:n]) != "hello " {
+		t.Errorf("first chunk: %q, %v", p[:n], err)
+	}
+
+	if err := cbrotli.NewFrameWriter(io.Discard, cbrotli.WriterOptions{Quality: 5, ChunkSize: 2 << 30}).Close(); err == nil {
+		t.Error("FrameWriter accepted chunks of 2GiB")
+	}
+
+	f = cbrotli.NewFrameWriter(limitedWriter{io.Discard, 7}, cbrotli.WriterOptions{Quality: 5})
+	f.Write([]byte("hello"))
+	if err := f.Flush(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Flush with short writes: got %v, want %v", err, io.ErrShortWrite)
+	}
+	if err := f.Close(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Close after a short write: got %v, want %v", err, io.ErrShortWrite)
+	}
+}
+
+func TestFrameWriterGolden(t *testing.T) {
+	golden := framedGoldenFile
+	if _, err := parseFramed(golden); err != nil {
+		t.Fatalf("golden file: %v", err)
+	}
+	if cbrotli.Version() != cbrotli.BundledVersion {
+		t.Skipf("C-Brotli %s may compress differently from %s", cbrotli.Version(), cbrotli.BundledVersion)
+	}
+	var out bytes.Buffer
+	if err := framedGolden(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), golden) {
+		t.Error("FrameWriter output differs from the golden file")
+	}
+}
+
+func TestRecoverPrefix(t *testing.T) {
+	input := append(wordSoup(186, 150000), make([]byte, 20000)...)
+	rand.New(rand.NewSource(186)).Read(input[150000:])
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, LGWin: 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, consumed, complete, err := cbrotli.RecoverPrefix(encoded)
+	if err != nil || !complete || consumed != len(encoded) || !bytes.Equal(out, input) {
+		t.Fatalf("RecoverPrefix: %d bytes, consumed %d, %v, %v", len(out), consumed, complete, err)
+	}
+	out, consumed, complete, err = cbrotli.RecoverPrefix(append(encoded, 'x'))
+	if err == nil || !complete || consumed != len(encoded) || !bytes.Equal(out, input) {
+		t.Errorf("RecoverPrefix with trailing data: %d bytes, consumed %d, %v, %v", len(out), consumed, complete, err)
+	}
+
+	// The content recovered from a truncated stream is a prefix of the
+	// original, and grows with the input.
+	prev := 0
+	for n := 0; n < len(encoded); n += 1 + n/50 {
+		out, consumed, complete, err := cbrotli.RecoverPrefix(encoded[:n])
+		if !errors.Is(err, cbrotli.ErrTruncated) || complete || consumed != n || !bytes.HasPrefix(input, out) {
+			t.Fatalf("truncated to %d: %d bytes, consumed %d, %v, %v", n, len(out), consumed, complete, err)
+		}
+		if len(out) < prev {
+			t.Errorf("truncated to %d: %d bytes, less than %d for a shorter input", n, len(out), prev)
+		}
+		prev = len(out)
+	}
+	if prev < len(input)/2 {
+		t.Errorf("recovered %d bytes of %d near the end of the stream", prev, len(input))
+	}
+
+	// The decoder cannot tell a corruption until it detects it, but the
+	// content decoded from the input before it is that of the original.
+	rng := rand.New(rand.NewSource(187))
+	for i := 0; i < 200; i++ {
+		k := rng.Intn(len(encoded))
+		corrupt := bytes.Clone(encoded)
+		corrupt[k] ^= byte(1 + rng.Intn(255))
+		out, consumed, complete, err := cbrotli.RecoverPrefix(corrupt)
+		good, _, _, _ := cbrotli.RecoverPrefix(encoded[:k])
+		if !bytes.HasPrefix(out, good) || consumed > len(corrupt) || (err == nil && !complete) {
+			t.Errorf("corrupt at %d: %d bytes (%d before it), consumed %d, %v, %v",
+				k, len(out), len(good), consumed, complete, err)
+		}
+	}
+
+	// The streaming variant writes the same content, and stops at errors of
+	// the destination.
+	var buf bytes.Buffer
+	written, consumed64, complete, err := cbrotli.RecoverPrefixTo(&buf, iotest.HalfReader(bytes.NewReader(encoded[:len(encoded)/2])))
+	want, _, _, _ := cbrotli.RecoverPrefix(encoded[:len(encoded)/2])
+	if !errors.Is(err, cbrotli.ErrTruncated) || complete || consumed64 != int64(len(encoded)/2) ||
+		written != int64(buf.Len()) || !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("RecoverPrefixTo: %d bytes, consumed %d, %v, %v", written, consumed64, complete, err)
+	}
+	errDst := errors.New("destination failure")
+	if _, _, complete, err := cbrotli.RecoverPrefixTo(failingWriter{errDst}, bytes.NewReader(encoded)); err != errDst || complete {
+		t.Errorf("RecoverPrefixTo with a failing destination: %v, %v", complete, err)
+	}
+}
+
+func TestWriterPipeline(t *testing.T) {
+	input := append(wordSoup(188, 300000), make([]byte, 200000)...)
+	rand.New(rand.NewSource(188)).Read(input[300000:])
+	dictionary := cbrotli.NewPreparedDictionary(input[:20000], cbrotli.DtRaw, 5)
+	defer dictionary.Close()
+	for _, options := range []cbrotli.WriterOptions{
+		{Quality: 1},
+		{Quality: 11, LGWin: 18},
+		{Quality: 5, WriteBufferSize: 4096},
+		{Quality: 5, DetectIncompressible: true},
+		{Quality: 5, SelectDictionary: func([]byte) *cbrotli.PreparedDictionary { return dictionary }},
+	} {
+		encode := func(depth int) []byte {
+			options := options
+			options.PipelineDepth = depth
+			var out bytes.Buffer
+			w := cbrotli.NewWriter(&out, options)
+			rng := rand.New(rand.NewSource(189))
+			for rest := input; len(rest) > 0; {
+				n := min(len(rest), rng.Intn(40000))
+				if _, err := w.Write(rest[:n]); err != nil {
+					t.Fatal(err)
+				}
+				rest = rest[n:]
+				if rng.Intn(10) == 0 {
+					if err := w.Flush(); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			return out.Bytes()
+		}
+		want := encode(0)
+		for _, depth := range []int{1, 4} {
+			if got := encode(depth); !bytes.Equal(got, want) {
+				t.Errorf("%+v, depth %d: output differs from synchronous mode", options, depth)
+			}
+		}
+	}
+}
+
+// gateWriter blocks its first Write until release is closed.
+type gateWriter struct {
+	dst      io.Writer
+	started  chan struct{}
+	release  chan struct{}
+	once     sync.Once
+	released bool
+}
+
+func (g *gateWriter) Write(p []byte) (int, error) {
+	if !g.released {
+		g.once.Do(func() { close(g.started) })
+		<-g.release
+		g.released = true
+	}
+	return g.dst.Write(p)
+}
+
+func TestWriterPipelineBackpressure(t *testing.T) {
+	chunk := make([]byte, 1<<20)
+	rand.Read(chunk)
+	var out bytes.Buffer
+	dst := &gateWriter{dst: &out, started: make(chan struct{}), release: make(chan struct{})}
+	w := cbrotli.NewWriter(dst, cbrotli.WriterOptions{Quality: 1, PipelineDepth: 2})
+	// The first write is encoded at once, and blocks in dst.
+	if _, err := w.Write(chunk); err != nil {
+		t.Fatal(err)
+	}
+	<-dst.started
+	for i := 0; i < 2; i++ {
+		if _, err := w.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The queue is full.
+	done := make(chan error)
+	go func() {
+		_, err := w.Write(chunk)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Write with a full queue returned %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(dst.release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCompressedData(out.Bytes(), bytes.Repeat(chunk, 4)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriterPipelineErrors(t *testing.T) {
+	chunk := make([]byte, 1<<20)
+	rand.Read(chunk)
+	errDst := errors.New("destination failure")
+	w := cbrotli.NewWriter(failingWriter{errDst}, cbrotli.WriterOptions{Quality: 1, PipelineDepth: 2})
+	// The error of the goroutine is reported by the next call.
+	if _, err := w.Write(chunk); err != nil {
+		t.Fatalf("first Write: %v", err)
+	}
+	if err := w.Flush(); err != errDst {
+		t.Errorf("Flush: got %v, want %v", err, errDst)
+	}
+	if _, err := w.Write(chunk); err != errDst {
+		t.Errorf("Write: got %v, want %v", err, errDst)
+	}
+	if err := w.Close(); err != errDst {
+		t.Errorf("Close: got %v, want %v", err, errDst)
+	}
+	checkWriterClosed(t, w)
+
+	if _, err := cbrotli.Encode(nil, cbrotli.WriterOptions{Quality: 5, PipelineDepth: -1}); err == nil {
+		t.Errorf("negative PipelineDepth: no error")
+	}
+}
+
+// latencyReader waits for delay before each Read of r.
+type latencyReader struct {
+	r     io.Reader
+	delay time.Duration
+}
+
+func (l latencyReader) Read(p []byte) (int, error) {
+	time.Sleep(l.delay)
+	return l.r.Read(p)
+}
+
+// BenchmarkReaderPrefetch decodes a stream read from a source with a latency
+// of 5ms per read, for a consumer that spends as long on the content as the
+// source takes to deliver it, so prefetching can at best halve the time.
+func BenchmarkReaderPrefetch(b *testing.B) {
+	const delay = 5 * time.Millisecond
+	input := wordSoup(189, 4<<20)
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		b.Fatal(err)
+	}
+	// The consumer takes the content in as many pieces as the source
+	// delivers the stream in reads of 32KiB, and works on each for delay.
+	reads := (len(encoded) + 32<<10 - 1) / (32 << 10)
+	piece := (len(input) + reads - 1) / reads
+	for _, buffers := range []int{0, 4} {
+		b.Run(fmt.Sprintf("buffers=%d", buffers), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			buf := make([]byte, piece)
+			for i := 0; i < b.N; i++ {
+				r := cbrotli.NewReaderWithOptions(latencyReader{bytes.NewReader(encoded), delay},
+					cbrotli.ReaderOptions{PrefetchBuffers: buffers})
+				for {
+					_, err := io.ReadFull(r, buf)
+					if err == io.EOF || err == io.ErrUnexpectedEOF {
+						break
+					}
+					if err != nil {
+						b.Fatal(err)
+					}
+					time.Sleep(delay)
+				}
+				r.Close()
+			}
+		})
+	}
+}
+
+func BenchmarkReaderFillBuffer(b *testing.B) {
+	input := wordSoup(190, 8<<20)
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, 1<<20)
+	for _, fill := range []bool{false, true} {
+		b.Run(fmt.Sprintf("fill=%v", fill), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			reads := 0
+			calls := runtime.NumCgoCall()
+			for i := 0; i < b.N; i++ {
+				r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{FillBuffer: fill})
+				for err == nil {
+					_, err = r.Read(buf)
+					reads++
+				}
+				if err != io.EOF {
+					b.Fatal(err)
+				}
+				err = nil
+				r.Close()
+			}
+			b.ReportMetric(float64(reads)/float64(b.N), "reads/op")
+			b.ReportMetric(float64(runtime.NumCgoCall()-calls)/float64(b.N), "cgocalls/op")
+		})
+	}
+}
+
+func TestWriterSmallStream(t *testing.T) {
+	for _, size := range []int{1, 1000, 4 << 10, 4<<10 + 1} {
+		input := wordSoup(int64(size), size)
+		for _, options := range []cbrotli.WriterOptions{
+			{Quality: 1}, {Quality: 5}, {Quality: 11, SizeHint: 1 << 20},
+			{Quality: 5, LGWin: 18}, {Quality: 5, AutoMode: true}, {Quality: 5, WriteBufferSize: 100},
+		} {
+			// Encode is given the exact size, like the Writer at Close.
+			exact := options
+			exact.SizeHint = 0
+			want, err := cbrotli.Encode(input, exact)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			w := cbrotli.NewWriter(&out, options)
+			// Writes of a few bytes are held together.
+			for p := input; len(p) > 0; p = p[min(len(p), 300):] {
+				if _, err := w.Write(p[:min(len(p), 300)]); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+			// Sampled and buffered input is not counted until it is passed on.
+			held := !options.AutoMode && options.WriteBufferSize == 0
+			if stats := w.Stats(); held && stats.BytesIn != int64(size) {
+				t.Errorf("%d bytes, %+v: BytesIn %d before Close", size, options, stats.BytesIn)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+			if decoded, err := cbrotli.Decode(out.Bytes()); err != nil || !bytes.Equal(decoded, input) {
+				t.Errorf("%d bytes, %+v: decoded %d bytes, %v", size, options, len(decoded), err)
+			}
+			stats := w.Stats()
+			if stats.BytesIn != int64(size) || stats.BytesOut != int64(out.Len()) || !w.Finished() {
+				t.Errorf("%d bytes, %+v: %+v, Finished %v", size, options, stats, w.Finished())
+			}
+			if size <= 4<<10 && !bytes.Equal(out.Bytes(), want) {
+				t.Errorf("%d bytes, %+v: output differs from Encode", size, options)
+			}
+		}
+	}
+
+	// A flushed stream is not compressed at once.
+	input := wordSoup(1, 1000)
+	want, _ := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5})
+	var out bytes.Buffer
+	w := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5})
+	w.Write(input[:500])
+	if err := w.Flush(); err != nil || out.Len() == 0 {
+		t.Fatalf("Flush: %d bytes, %v", out.Len(), err)
+	}
+	w.Write(input[500:])
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if decoded, err := cbrotli.Decode(out.Bytes()); err != nil || !bytes.Equal(decoded, input) || bytes.Equal(out.Bytes(), want) {
+		t.Errorf("flushed stream: decoded %d bytes, %v", len(decoded), err)
+	}
+
+	// Destination errors are reported by Close, and stick.
+	errDst := errors.New("destination failure")
+	w = cbrotli.NewWriter(&failAfterWriter{n: 1, err: errDst}, cbrotli.WriterOptions{Quality: 5})
+	if _, err := w.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != errDst {
+		t.Errorf("Close: got %v, want %v", err, errDst)
+	}
+	if w.Finished() {
+		t.Error("Finished after a destination error")
+	}
+	if err := w.Close(); err != cbrotli.ErrWriterClosed {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+// BenchmarkWriterSmallPayload compares the Writer with Encode on small
+// payloads, for time and compressed size. Both are given the size of the
+// input; "flushed" is the Writer made to stream by a Flush before Close.
+func BenchmarkWriterSmallPayload(b *testing.B) {
+	for _, quality := range []int{1, 5, 11} {
+		for _, size := range []int{1 << 10, 4 << 10} {
+			input := wordSoup(int64(size), size)
+			options := cbrotli.WriterOptions{Quality: quality, SizeHint: size}
+			for _, flush := range []bool{false, true} {
+				name := "writer"
+				if flush {
+					name = "flushed"
+				}
+				b.Run(fmt.Sprintf("q%d/%d/%s", quality, size, name), func(b *testing.B) {
+					b.SetBytes(int64(size))
+					var buf bytes.Buffer
+					for i := 0; i < b.N; i++ {
+						buf.Reset()
+						w := cbrotli.NewWriter(&buf, options)
+						if _, err := w.Write(input); err != nil {
+							b.Fatal(err)
+						}
+						if flush {
+							if err := w.Flush(); err != nil {
+								b.Fatal(err)
+							}
+						}
+						if err := w.Close(); err != nil {
+							b.Fatal(err)
+						}
+					}
+					b.ReportMetric(float64(buf.Len()), "encoded-bytes")
+				})
+			}
+			b.Run(fmt.Sprintf("q%d/%d/encode", quality, size), func(b *testing.B) {
+				b.SetBytes(int64(size))
+				var encoded []byte
+				for i := 0; i < b.N; i++ {
+					var err error
+					if encoded, err = cbrotli.Encode(input, options); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(encoded)), "encoded-bytes")
+			})
+		}
+	}
+}
+
+// bufferAt is an io.WriterAt over a preallocated buffer.
+type bufferAt []byte
+
+func (b bufferAt) WriteAt(p []byte, off int64) (int, error) {
+	if off+int64(len(p)) > int64(len(b)) {
+		return 0, errors.New("write past the end")
+	}
+	return copy(b[off:], p), nil
+}
+
+// failingWriterAt fails the writes at offset off.
+type failingWriterAt struct {
+	off int64
+	err error
+}
+
+func (w failingWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	if off == w.off {
+		return 0, w.err
+	}
+	return len(p), nil
+}
+
+func TestSeekableDecodeAllParallel(t *testing.T) {
+	input := wordSoup(192, 700000)
+	file := seekableFile(t, input, cbrotli.WriterOptions{Quality: 5, ChunkSize: 30000})
+	r, err := cbrotli.NewSeekableReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("NewSeekableReader: %v", err)
+	}
+	for _, concurrency := range []int{0, 1, 3, 100} {
+		out := make(bufferAt, len(input))
+		if err := r.DecodeAllParallel(context.Background(), out, concurrency); err != nil {
+			t.Fatalf("concurrency %d: %v", concurrency, err)
+		}
+		if !bytes.Equal(out, input) {
+			t.Errorf("concurrency %d: content mismatch", concurrency)
+		}
+	}
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	// Longer files are truncated.
+	if _, err := f.Write(make([]byte, len(input)+100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.DecodeToFile(context.Background(), f, 4); err != nil {
+		t.Fatalf("DecodeToFile: %v", err)
+	}
+	if got, err := os.ReadFile(f.Name()); err != nil || !bytes.Equal(got, input) {
+		t.Errorf("DecodeToFile: %d bytes, %v; want %d bytes", len(got), err, len(input))
+	}
+
+	errWrite := errors.New("write failed")
+	if err := r.DecodeAllParallel(context.Background(), failingWriterAt{90000, errWrite}, 4); err != errWrite {
+		t.Errorf("failing dst: got %v, want %v", err, errWrite)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := r.DecodeAllParallel(ctx, make(bufferAt, len(input)), 4); err != context.Canceled {
+		t.Errorf("canceled: got %v, want %v", err, context.Canceled)
+	}
+
+	// A corrupted frame stops decoding.
+	corrupted := bytes.Clone(file)
+	corrupted[len(file)/2] ^= 0xff
+	r, err = cbrotli.NewSeekableReader(bytes.NewReader(corrupted), int64(len(corrupted)))
+	if err != nil {
+		t.Fatalf("NewSeekableReader: %v", err)
+	}
+	if err := r.DecodeAllParallel(context.Background(), make(bufferAt, len(input)), 4); err == nil {
+		t.Error("corrupted frame: got no error")
+	}
+}
+
+func BenchmarkSeekableDecodeAllParallel(b *testing.B) {
+	input := wordSoup(192, 32<<20)
+	file := seekableFile(b, input, cbrotli.WriterOptions{Quality: 5, ChunkSize: 1 << 20})
+	r, err := cbrotli.NewSeekableReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	out := make(bufferAt, len(input))
+	for _, concurrency := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			for i := 0; i < b.N; i++ {
+				if err := r.DecodeAllParallel(context.Background(), out, concurrency); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestDecompressBatchLimit(t *testing.T) {
+	inputs := batchInputs(200)
+	encoded, err := cbrotli.CompressBatch(inputs, cbrotli.WriterOptions{Quality: 5}, 4)
+	if err != nil {
+		t.Fatalf("CompressBatch: %v", err)
+	}
+	encoded[3] = []byte("garbage")
+	encoded[150] = encoded[150][:len(encoded[150])/2]
+	const limit = 2500
+	outputs, errs := cbrotli.DecompressBatchLimit(context.Background(), encoded, 4, limit)
+	if len(outputs) != len(inputs) || len(errs) != len(inputs) {
+		t.Fatalf("got %d outputs and %d errors, want %d", len(outputs), len(errs), len(inputs))
+	}
+	for i, input := range inputs {
+		switch {
+		case i == 3:
+			if !errors.Is(errs[i], cbrotli.ErrCorrupt) {
+				t.Errorf("item %d: got %v, want %v", i, errs[i], cbrotli.ErrCorrupt)
+			}
+		case i == 150:
+			if errs[i] != cbrotli.ErrTruncated {
+				t.Errorf("item %d: got %v, want %v", i, errs[i], cbrotli.ErrTruncated)
+			}
+		case len(input) > limit:
+			if errs[i] != cbrotli.ErrDecodedTooLarge {
+				t.Errorf("item %d: got %v, want %v", i, errs[i], cbrotli.ErrDecodedTooLarge)
+			}
+		default:
+			if errs[i] != nil || !bytes.Equal(outputs[i], input) {
+				t.Errorf("item %d: %v, or decoded output differs from input", i, errs[i])
+			}
+			continue
+		}
+		if outputs[i] != nil {
+			t.Errorf("item %d: output of failed item is not nil", i)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	outputs, errs = cbrotli.DecompressBatchLimit(ctx, encoded, 2, 0)
+	for i := range encoded {
+		if outputs[i] != nil || errs[i] != context.Canceled {
+			t.Fatalf("item %d after cancel: got %v, want %v", i, errs[i], context.Canceled)
+		}
+	}
+}
+
+func BenchmarkDecompressBatchLimit(b *testing.B) {
+	encoded, err := cbrotli.CompressBatch(batchInputs(2000), cbrotli.WriterOptions{Quality: 5}, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var size int64
+	for _, e := range encoded {
+		size += int64(len(e))
+	}
+	b.Run("batch", func(b *testing.B) {
+		b.SetBytes(size)
+		for i := 0; i < b.N; i++ {
+			cbrotli.DecompressBatchLimit(context.Background(), encoded, 0, 0)
+		}
+	})
+	b.Run("goroutine-per-item", func(b *testing.B) {
+		b.SetBytes(size)
+		outputs := make([][]byte, len(encoded))
+		errs := make([]error, len(encoded))
+		for i := 0; i < b.N; i++ {
+			var wg sync.WaitGroup
+			for j, e := range encoded {
+				wg.Add(1)
+				go func(j int, e []byte) {
+					defer wg.Done()
+					outputs[j], errs[j] = cbrotli.Decode(e)
+				}(j, e)
+			}
+			wg.Wait()
+		}
+	})
+}
+
+func TestEncodeIfSmaller(t *testing.T) {
+	options := cbrotli.WriterOptions{Quality: 5}
+	random := make([]byte, 10000)
+	rand.New(rand.NewSource(194)).Read(random)
+	for _, percent := range []float64{0, 10} {
+		out, compressed, err := cbrotli.EncodeIfSmaller(random, options, percent)
+		if err != nil || compressed || len(out) != len(random) || &out[0] != &random[0] {
+			t.Errorf("random, %v%%: got %d bytes, compressed=%v, %v; want the input", percent, len(out), compressed, err)
+		}
+	}
+
+	input := wordSoup(194, 10000)
+	encoded, err := cbrotli.Encode(input, options)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	// The savings of encoded, which meet a threshold of exactly that much.
+	savings := float64(len(input)-len(encoded)) * 100 / float64(len(input))
+	for _, test := range []struct {
+		percent    float64
+		compressed bool
+	}{
+		{0, true},
+		{savings / 2, true},
+		{savings, true},
+		{math.Nextafter(savings, 100), false},
+		{100, false},
+	} {
+		out, compressed, err := cbrotli.EncodeIfSmaller(input, options, test.percent)
+		if err != nil || compressed != test.compressed {
+			t.Errorf("%v%% (savings %v%%): compressed=%v, %v; want %v", test.percent, savings, compressed, err, test.compressed)
+			continue
+		}
+		if !compressed {
+			if &out[0] != &input[0] {
+				t.Errorf("%v%%: uncompressed output is not the input", test.percent)
+			}
+			continue
+		}
+		if !bytes.Equal(out, encoded) {
+			t.Errorf("%v%%: output differs from Encode", test.percent)
+		}
+		// The output does not alias the pooled buffer.
+		if other, _, _ := cbrotli.EncodeIfSmaller(wordSoup(1, 10000), options, 0); bytes.Equal(other, out) || !bytes.Equal(out, encoded) {
+			t.Errorf("%v%%: output changed by a later call", test.percent)
+		}
+	}
+
+	if out, compressed, err := cbrotli.EncodeIfSmaller(nil, options, 0); out != nil || compressed || err != nil {
+		t.Errorf("empty input: got %v, %v, %v", out, compressed, err)
+	}
+	for _, percent := range []float64{-1, 101, math.NaN()} {
+		if _, _, err := cbrotli.EncodeIfSmaller(input, options, percent); err == nil {
+			t.Errorf("%v%%: got no error", percent)
+		}
+	}
+	if _, _, err := cbrotli.EncodeIfSmaller(input, cbrotli.WriterOptions{Quality: 12}, 0); err == nil {
+		t.Error("invalid options: got no error")
+	}
+}
+
+func TestWriterMaxOutputBytes(t *testing.T) {
+	random := make([]byte, 10000)
+	rand.New(rand.NewSource(195)).Read(random)
+	options := cbrotli.WriterOptions{Quality: 5}
+	var out bytes.Buffer
+	w := cbrotli.NewWriter(&out, options)
+	w.Write(random)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	encoded := bytes.Clone(out.Bytes())
+
+	// The output fits the budget exactly.
+	options.MaxOutputBytes = int64(len(encoded))
+	out.Reset()
+	w = cbrotli.NewWriter(&out, options)
+	w.Write(random)
+	if err := w.Close(); err != nil || !bytes.Equal(out.Bytes(), encoded) {
+		t.Errorf("Writer within budget: %d bytes, %v", out.Len(), err)
+	}
+
+	// The encoder holds all output until Close, which crosses the budget.
+	options.MaxOutputBytes = int64(len(encoded)) - 1
+	out.Reset()
+	w = cbrotli.NewWriter(&out, options)
+	if _, err := w.Write(random); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if stats := w.Stats(); stats.BytesOut != 0 {
+		t.Fatalf("BytesOut before Close: %d, want 0", stats.BytesOut)
+	}
+	if err := w.Close(); err != cbrotli.ErrOutputBudgetExceeded {
+		t.Errorf("Close: got %v, want %v", err, cbrotli.ErrOutputBudgetExceeded)
+	}
+	if int64(out.Len()) > options.MaxOutputBytes || w.Finished() {
+		t.Errorf("after Close: %d bytes written, Finished()=%v", out.Len(), w.Finished())
+	}
+	options.MaxOutputBytes = 5000
+	if _, err := cbrotli.Encode(random, options); err != cbrotli.ErrOutputBudgetExceeded {
+		t.Errorf("Encode: got %v, want %v", err, cbrotli.ErrOutputBudgetExceeded)
+	}
+	out.Reset()
+	w = cbrotli.NewWriter(&out, options)
+	w.Write(random)
+	if err := w.Flush(); err != cbrotli.ErrOutputBudgetExceeded {
+		t.Errorf("Flush: got %v, want %v", err, cbrotli.ErrOutputBudgetExceeded)
+	}
+	w.Close()
+
+	// Output produced by Write crosses the budget; the Writer is closed.
+	// Quality 1 emits output while compressing.
+	input := make([]byte, 1<<20)
+	rand.New(rand.NewSource(196)).Read(input)
+	options = cbrotli.WriterOptions{Quality: 1, MaxOutputBytes: 100000}
+	out.Reset()
+	w = cbrotli.NewWriter(&out, options)
+	if _, err := w.Write(input); err != cbrotli.ErrOutputBudgetExceeded {
+		t.Errorf("Write: got %v, want %v", err, cbrotli.ErrOutputBudgetExceeded)
+	}
+	if stats := w.Stats(); stats.BytesOut != int64(out.Len()) || stats.BytesOut > options.MaxOutputBytes {
+		t.Errorf("BytesOut=%d, %d bytes written; budget %d", stats.BytesOut, out.Len(), options.MaxOutputBytes)
+	}
+	if w.HasMoreOutput() {
+		t.Error("HasMoreOutput after the budget is exceeded")
+	}
+	if _, err := w.Write(input[:1]); err != cbrotli.ErrWriterClosed {
+		t.Errorf("Write after the budget is exceeded: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+	if err := w.Close(); err != cbrotli.ErrWriterClosed {
+		t.Errorf("Close after the budget is exceeded: got %v, want %v", err, cbrotli.ErrWriterClosed)
+	}
+
+	// The Writer can be reused.
+	options.MaxOutputBytes = 0
+	out.Reset()
+	if err := w.ResetOptions(&out, options); err != nil {
+		t.Fatalf("ResetOptions: %v", err)
+	}
+	if _, err := w.Write(input); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := checkCompressedData(out.Bytes(), input); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, MaxOutputBytes: -1}); err == nil {
+		t.Error("negative budget: got no error")
+	}
+}
+
+func TestEncodeCopy(t *testing.T) {
+	content := wordSoup(198, 200000)
+	options := cbrotli.WriterOptions{Quality: 5}
+	for i := 0; i < 3; i++ {
+		// Pooled Writers are reused.
+		var compressed bytes.Buffer
+		read, written, err := cbrotli.EncodeCopy(context.Background(), &compressed, bytes.NewReader(content), options)
+		if err != nil || read != int64(len(content)) || written != int64(compressed.Len()) {
+			t.Fatalf("EncodeCopy: %d, %d, %v; %d bytes written", read, written, err, compressed.Len())
+		}
+		if err := checkCompressedData(compressed.Bytes(), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Cancellation, and errors of src and dst, abort the stream.
+	srcErr := errors.New("source failed")
+	dstErr := errors.New("destination failed")
+	for _, test := range []struct {
+		name    string
+		src     func(cancel func()) io.Reader
+		dst     io.Writer
+		wantErr error
+		read    int64
+	}{
+		{"canceled", func(cancel func()) io.Reader {
+			return &cancelingReader{data: content, n: 50000, cancel: cancel}
+		}, nil, context.Canceled, 50000},
+		{"source error", func(func()) io.Reader {
+			return &failingReader{data: content[:70000], err: srcErr}
+		}, nil, srcErr, 70000},
+		{"destination error", func(func()) io.Reader {
+			return bytes.NewReader(content)
+		}, &quotaWriter{n: 100, err: dstErr}, dstErr, -1},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		var partial bytes.Buffer
+		dst := test.dst
+		if dst == nil {
+			dst = &partial
+		}
+		read, written, err := cbrotli.EncodeCopy(ctx, dst, test.src(cancel), options)
+		cancel()
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.wantErr)
+		}
+		if test.read >= 0 && read != test.read {
+			t.Errorf("%s: read %d bytes, want %d", test.name, read, test.read)
+		}
+		if test.dst == nil && written != int64(partial.Len()) {
+			t.Errorf("%s: written %d, %d bytes written", test.name, written, partial.Len())
+		}
+		if _, err := cbrotli.Decode(partial.Bytes()); test.dst == nil && err == nil {
+			t.Errorf("%s: wrote a complete stream of %d bytes", test.name, partial.Len())
+		}
+	}
+
+	if _, _, err := cbrotli.EncodeCopy(context.Background(), io.Discard, bytes.NewReader(content), cbrotli.WriterOptions{Quality: 12}); err == nil {
+		t.Error("invalid options: got no error")
+	}
+	// The Writer that reported invalid options is reusable.
+	if _, _, err := cbrotli.EncodeCopy(context.Background(), io.Discard, bytes.NewReader(content), options); err != nil {
+		t.Errorf("EncodeCopy after invalid options: %v", err)
+	}
+}
+
+func TestWriterAutoMode(t *testing.T) {
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(199)).Read(random)
+	// The sample ends in the middle of "é".
+	accented := []byte(strings.Repeat("x", 4095) + strings.Repeat("café à la crème\n", 5000))
+	withZeros := bytes.Clone(wordSoup(199, 100000))
+	for i := 0; i < len(withZeros); i += 50 {
+		withZeros[i] = 0
+	}
+	// Binary data after a text sample does not change the choice.
+	mixed := append(bytes.Clone(wordSoup(200, 4096)), random...)
+	for _, test := range []struct {
+		name       string
+		input      []byte
+		text, font bool
+	}{
+		{"words", wordSoup(199, 100000), true, false},
+		{"accented", accented, true, false},
+		{"mixed", mixed, true, false},
+		{"random", random, false, false},
+		{"zeros", withZeros, false, false},
+		{"empty", nil, false, false},
+		{"font", fakeFont(), false, true},
+	} {
+		options := cbrotli.WriterOptions{Quality: 5, AutoMode: true}
+		var out bytes.Buffer
+		w := cbrotli.NewWriter(&out, options)
+		// Writes of varied sizes cross the end of the sample.
+		src := rand.New(rand.NewSource(int64(len(test.input))))
+		for p := test.input; len(p) > 0; {
+			n := min(len(p), 1+src.Intn(300))
+			if _, err := w.Write(p[:n]); err != nil {
+				t.Fatalf("%s: Write: %v", test.name, err)
+			}
+			p = p[n:]
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: Close: %v", test.name, err)
+		}
+		if err := checkCompressedData(out.Bytes(), test.input); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		// Close chooses the mode of streams shorter than the sample.
+		stats := w.Stats()
+		if !stats.ModeChosen || stats.TextMode != test.text || stats.FontMode != test.font {
+			t.Errorf("%s: %+v; want text mode %v, font mode %v", test.name, stats, test.text, test.font)
+		}
+		encoded, err := cbrotli.Encode(test.input, options)
+		if err != nil {
+			t.Fatalf("%s: Encode: %v", test.name, err)
+		}
+		if err := checkCompressedData(encoded, test.input); err != nil {
+			t.Errorf("%s: Encode: %v", test.name, err)
+		}
+		// The font mode changes the coding of distances.
+		generic, _ := cbrotli.Encode(test.input, cbrotli.WriterOptions{Quality: 5})
+		if changed := !bytes.Equal(encoded, generic); changed != test.font {
+			t.Errorf("%s: AutoMode changed the output: %v, want %v", test.name, changed, test.font)
+		}
+	}
+
+	// Flush chooses the mode from the input so far.
+	var out bytes.Buffer
+	w := cbrotli.NewWriter(&out, cbrotli.WriterOptions{Quality: 5, AutoMode: true})
+	w.Write(random[:100])
+	if stats := w.Stats(); stats.ModeChosen {
+		t.Error("mode chosen before the sample is complete")
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	w.Write(wordSoup(201, 10000))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if stats := w.Stats(); !stats.ModeChosen || stats.TextMode {
+		t.Errorf("after Flush: ModeChosen=%v, TextMode=%v", stats.ModeChosen, stats.TextMode)
+	}
+	if err := checkCompressedData(out.Bytes(), append(bytes.Clone(random[:100]), wordSoup(201, 10000)...)); err != nil {
+		t.Error(err)
+	}
+}
+
+// fakeFont returns data with the header and table directory of a TrueType
+// font, followed by tables of 16-bit values.
+func fakeFont() []byte {
+	font := []byte{0, 1, 0, 0, 0, 4, 0, 64, 0, 2, 0, 0}
+	for _, tag := range []string{"cmap", "glyf", "head", "loca"} {
+		font = append(font, tag...)
+		font = append(font, make([]byte, 12)...)
+	}
+	src := rand.New(rand.NewSource(203))
+	for len(font) < 50000 {
+		v := 100 + src.Intn(400)
+		font = append(font, byte(v>>8), byte(v), 0, byte(src.Intn(4)))
+	}
+	return font
+}
+
+func TestEncodeTo(t *testing.T) {
+	input := wordSoup(202, 50000)
+	options := cbrotli.WriterOptions{Quality: 5}
+	want, err := cbrotli.Encode(input, options)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	dst := make([]byte, cbrotli.CompressBound(len(input)))
+	n, err := cbrotli.EncodeTo(dst, input, options)
+	if err != nil {
+		t.Fatalf("EncodeTo: %v", err)
+	}
+	if !bytes.Equal(dst[:n], want) {
+		t.Errorf("EncodeTo: got %d bytes, want the %d bytes of Encode", n, len(want))
+	}
+	if allocs := testing.AllocsPerRun(10, func() { cbrotli.EncodeTo(dst, input, options) }); allocs != 0 {
+		t.Errorf("EncodeTo: %v allocations", allocs)
+	}
+
+	// The exact size fits; one byte less does not.
+	if n, err := cbrotli.EncodeTo(make([]byte, len(want)), input, options); err != nil || n != len(want) {
+		t.Errorf("EncodeTo into %d bytes: %d, %v", len(want), n, err)
+	}
+	if _, err := cbrotli.EncodeTo(make([]byte, len(want)-1), input, options); err != io.ErrShortBuffer {
+		t.Errorf("EncodeTo into %d bytes: got %v, want io.ErrShortBuffer", len(want)-1, err)
+	}
+
+	// The bound holds for incompressible input.
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(203)).Read(random)
+	dst = make([]byte, cbrotli.CompressBound(len(random)))
+	if n, err := cbrotli.EncodeTo(dst, random, options); err != nil {
+		t.Errorf("EncodeTo of random data: %v", err)
+	} else if err := checkCompressedData(dst[:n], random); err != nil {
+		t.Error(err)
+	}
+
+	// Empty input, and options that need the streaming encoder.
+	for _, test := range []struct {
+		name    string
+		input   []byte
+		options cbrotli.WriterOptions
+	}{
+		{"empty", nil, options},
+		{"budget", input, cbrotli.WriterOptions{Quality: 5, MaxOutputBytes: 1 << 20}},
+	} {
+		want, err := cbrotli.Encode(test.input, test.options)
+		if err != nil {
+			t.Fatalf("%s: Encode: %v", test.name, err)
+		}
+		dst := make([]byte, cbrotli.CompressBound(len(test.input)))
+		n, err := cbrotli.EncodeTo(dst, test.input, test.options)
+		if err != nil {
+			t.Fatalf("%s: EncodeTo: %v", test.name, err)
+		}
+		if err := checkCompressedData(dst[:n], test.input); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+		if n != len(want) {
+			t.Errorf("%s: EncodeTo wrote %d bytes, Encode %d", test.name, n, len(want))
+		}
+		if _, err := cbrotli.EncodeTo(dst[:n-1], test.input, test.options); err != io.ErrShortBuffer {
+			t.Errorf("%s: EncodeTo into %d bytes: got %v, want io.ErrShortBuffer", test.name, n-1, err)
+		}
+	}
+
+	if _, err := cbrotli.EncodeTo(dst, input, cbrotli.WriterOptions{Quality: 12}); err == nil {
+		t.Error("EncodeTo with quality 12 succeeded")
+	}
+}
+
+// testStreamEncoders write the streams of testdata/streams, which the tests
+// of decode_test.go decode in all builds; see testStream.
+var testStreamEncoders = map[string]func() ([]byte, error){
+	"hello.br": func() ([]byte, error) {
+		return cbrotli.Encode(bytes.Repeat([]byte("hello world!"), 10000), cbrotli.WriterOptions{Quality: 5})
+	},
+	"hello-short.br": func() ([]byte, error) {
+		return cbrotli.Encode(bytes.Repeat([]byte("hello world!"), 100), cbrotli.WriterOptions{Quality: 5})
+	},
+	"digits.br": func() ([]byte, error) {
+		return cbrotli.Encode(bytes.Repeat([]byte("0123456789"), 1000), cbrotli.WriterOptions{Quality: 5})
+	},
+	"multistream-a.br": func() ([]byte, error) {
+		return cbrotli.Encode([]byte("hello "), cbrotli.WriterOptions{Quality: 5})
+	},
+	"multistream-b.br": func() ([]byte, error) {
+		return cbrotli.Encode([]byte("world"), cbrotli.WriterOptions{Quality: 5})
+	},
+	"seekable.br": func() ([]byte, error) {
+		return encodeSeekable(wordSoup(10, 100000), cbrotli.WriterOptions{Quality: 5, ChunkSize: 20000})
+	},
+	"seekable-plain.br": func() ([]byte, error) {
+		return cbrotli.Encode(wordSoup(10, 100000), cbrotli.WriterOptions{Quality: 5})
+	},
+	"seekable-empty.br": func() ([]byte, error) {
+		return encodeSeekable(nil, cbrotli.WriterOptions{Quality: 5})
+	},
+	"dictionary.br": func() ([]byte, error) {
+		dictionary := wordSoup(11, 100000)
+		return encodeWithDictionary(dictionary[5000:25000], dictionary)
+	},
+	"resolve.br": func() ([]byte, error) {
+		dictionary := wordSoup(23, 30000)
+		return encodeWithDictionary(dictionary[1000:21000], dictionary)
+	},
+	"resolve-plain.br": func() ([]byte, error) {
+		return cbrotli.Encode(wordSoup(23, 30000)[1000:21000], cbrotli.WriterOptions{Quality: 5})
+	},
+	"truncated-q0.br": func() ([]byte, error) {
+		return cbrotli.Encode(truncatedContent(), cbrotli.WriterOptions{Quality: 0})
+	},
+	"truncated-q5.br": func() ([]byte, error) {
+		return cbrotli.Encode(truncatedContent(), cbrotli.WriterOptions{Quality: 5})
+	},
+	"truncated-q11.br": func() ([]byte, error) {
+		return cbrotli.Encode(truncatedContent(), cbrotli.WriterOptions{Quality: 11, LGWin: 16})
+	},
+	"truncated-flushed.br": func() ([]byte, error) {
+		input := truncatedContent()
+		var buf bytes.Buffer
+		w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 5})
+		w.Write(input[:1000])
+		w.Flush()
+		w.Write(input[1000:])
+		err := w.Close()
+		return buf.Bytes(), err
+	},
+	"truncated-dictionary.br": func() ([]byte, error) {
+		return encodeWithDictionary(truncatedContent(), truncatedContent())
+	},
+	"source-errors.br": func() ([]byte, error) {
+		return cbrotli.Encode(wordSoup(142, 100000), cbrotli.WriterOptions{Quality: 5})
+	},
+	"reset-0.br": func() ([]byte, error) {
+		return encodeWithDictionary(wordSoup(72, 5000), wordSoup(71, 20000))
+	},
+	"reset-1.br": func() ([]byte, error) {
+		return encodeWithDictionary(wordSoup(71, 20000)[1000:9000], wordSoup(71, 20000))
+	},
+	"reset-2.br": func() ([]byte, error) {
+		return encodeWithDictionary(nil, wordSoup(71, 20000))
+	},
+}
+
+func encodeSeekable(input []byte, options cbrotli.WriterOptions) ([]byte, error) {
+	var out bytes.Buffer
+	w := cbrotli.NewSeekableWriter(&out, options)
+	if _, err := w.Write(input); err != nil {
+		w.Close()
+		return nil, err
+	}
+	err := w.Close()
+	return out.Bytes(), err
+}
+
+// encodeWithDictionary encodes input at quality 5 with a raw dictionary.
+func encodeWithDictionary(input, dictionary []byte) ([]byte, error) {
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	return cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+}
+
+func TestStreamsGolden(t *testing.T) {
+	files, err := fs.Glob(testStreams, "testdata/streams/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(testStreamEncoders) {
+		t.Errorf("testdata/streams has %d files, want %d", len(files), len(testStreamEncoders))
+	}
+	if cbrotli.Version() != cbrotli.BundledVersion {
+		t.Skipf("C-Brotli %s may compress differently from %s", cbrotli.Version(), cbrotli.BundledVersion)
+	}
+	for name, encode := range testStreamEncoders {
+		want, err := encode()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.Equal(testStream(t, name), want) {
+			t.Errorf("%s differs from the output of its encoder", name)
+		}
+	}
+}
@@ -3,6 +3,8 @@
 // Distributed under MIT license.
 // See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
 
+//go:build cgo && libbrotli_system
+
 package cbrotli
 
 // Inform golang build system that it should link brotli libraries.
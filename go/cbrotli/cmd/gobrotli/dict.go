@@ -0,0 +1,279 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+const dictUsage = `Usage: gobrotli dict train [OPTION]... SAMPLE...
+       gobrotli dict eval --dict FILE [OPTION]... SAMPLE...
+SAMPLE is a file, a directory (whose files are read recursively) or a glob.
+`
+
+// runDict executes the dict subcommand.
+func runDict(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, dictUsage)
+		return 1
+	}
+	var err error
+	switch args[0] {
+	case "train":
+		err = dictTrain(args[1:], stdout, stderr)
+	case "eval":
+		err = dictEval(args[1:], stdout, stderr)
+	case "-h", "--help", "help":
+		fmt.Fprint(stdout, dictUsage)
+		return 0
+	default:
+		err = fmt.Errorf("unknown dict command %q", args[0])
+	}
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// newFlagSet returns a FlagSet for the subcommand name, reporting to stderr.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet("gobrotli "+name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	return flags
+}
+
+// dictTrain generates a dictionary from samples. The samples are streamed
+// through a DictionaryBuilder, which keeps a bounded subset of them, so that
+// the corpus does not need to fit in memory.
+func dictTrain(args []string, stdout, stderr io.Writer) error {
+	flags := newFlagSet("dict train", stderr)
+	size := flags.String("size", "16K", "`size` of the dictionary, with an optional K or M suffix")
+	out := flags.String("out", "", "output `file`; - for the standard output")
+	format := flags.String("format", "raw", "dictionary `format`: raw or serialized")
+	maxSamples := flags.Int("max-samples", 0, "number of samples kept (0 means 10000)")
+	maxSampleSize := flags.String("max-sample-size", "64K", "number of leading bytes read of each sample")
+	minLength := flags.Int("min-length", 0, "length of the shortest dictionary string (0 means 8)")
+	verbose := flags.Bool("v", false, "report the samples read")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	targetSize, err := parseSize(*size)
+	if err != nil {
+		return fmt.Errorf("invalid --size: %v", err)
+	}
+	sampleSize, err := parseSize(*maxSampleSize)
+	if err != nil {
+		return fmt.Errorf("invalid --max-sample-size: %v", err)
+	}
+	if *out == "" {
+		return errors.New("--out is required")
+	}
+	if *format != "raw" && *format != "serialized" {
+		return fmt.Errorf("invalid --format %q: must be raw or serialized", *format)
+	}
+	files, err := sampleFiles(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	builder := cbrotli.NewDictionaryBuilder(cbrotli.DictionaryBuilderOptions{
+		MaxSamples:    *maxSamples,
+		MaxSampleSize: sampleSize,
+		MinLength:     *minLength,
+	})
+	buf := make([]byte, sampleSize)
+	for _, name := range files {
+		n, err := readPrefix(name, buf)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+		if err := builder.Add(buf[:n]); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	if *verbose {
+		fmt.Fprintf(stderr, "read %d samples from %d files\n", builder.SampleCount(), len(files))
+	}
+	dictionary, err := builder.Build(targetSize)
+	if err != nil {
+		return err
+	}
+	if *format == "serialized" {
+		if dictionary, err = cbrotli.BuildSerializedDictionary(dictionary, cbrotli.SerializedDictionaryOptions{}); err != nil {
+			return err
+		}
+	}
+	if *verbose {
+		fmt.Fprintf(stderr, "wrote %s dictionary of %s, id %s\n", *format, formatBytes(int64(len(dictionary))),
+			cbrotli.FormatDictionaryID(cbrotli.DictionaryID(dictionary)))
+	}
+	if *out == "-" {
+		_, err = stdout.Write(dictionary)
+		return err
+	}
+	return os.WriteFile(*out, dictionary, 0o644)
+}
+
+// dictEval reports the compression of held-out samples with a dictionary.
+func dictEval(args []string, stdout, stderr io.Writer) error {
+	flags := newFlagSet("dict eval", stderr)
+	dict := flags.String("dict", "", "dictionary `file`, raw or serialized")
+	quality := flags.Int("q", cbrotli.MaxQuality, "compression `quality` (0-11)")
+	lgwin := flags.Int("w", 0, "LZ77 window size `bits` (0, 10-24)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *dict == "" {
+		return errors.New("--dict is required")
+	}
+	dictionary, err := os.ReadFile(*dict)
+	if err != nil {
+		return err
+	}
+	if dictionary, err = rawDictionary(dictionary); err != nil {
+		return fmt.Errorf("%s: %v", *dict, err)
+	}
+	files, err := sampleFiles(flags.Args())
+	if err != nil {
+		return err
+	}
+	var samples [][]byte
+	for _, name := range files {
+		sample, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if len(sample) != 0 {
+			samples = append(samples, sample)
+		}
+	}
+	report, err := cbrotli.EstimateDictionaryGain(samples, dictionary,
+		cbrotli.WriterOptions{Quality: *quality, LGWin: *lgwin})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "samples:         %d\n", report.Samples)
+	fmt.Fprintf(stdout, "input:           %s\n", formatBytes(report.InputSize))
+	fmt.Fprintf(stdout, "compressed:      %s\n", formatBytes(report.PlainSize))
+	fmt.Fprintf(stdout, "with dictionary: %s\n", formatBytes(report.DictionarySize))
+	fmt.Fprintf(stdout, "gain:            %.2f%%\n", 100*report.Gain())
+	fmt.Fprintf(stdout, "ratio p50/p90/p99: %.3f/%.3f/%.3f\n", report.P50, report.P90, report.P99)
+	return nil
+}
+
+// rawDictionary returns the raw dictionary of a serialized dictionary, or
+// data itself if it is not serialized.
+func rawDictionary(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x91 || data[1] != 0 {
+		return data, nil
+	}
+	info, err := cbrotli.ParseSerializedDictionary(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Prefixes) == 0 {
+		return nil, errors.New("serialized dictionary has no raw dictionary")
+	}
+	p := info.Prefixes[0]
+	return data[p.Offset : p.Offset+p.Size], nil
+}
+
+// sampleFiles expands the SAMPLE arguments into a list of regular files, in
+// lexical order for each argument, without duplicates.
+func sampleFiles(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.New("no samples given")
+	}
+	var files []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			files = append(files, name)
+		}
+	}
+	for _, arg := range args {
+		paths := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %v", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files match %q", arg)
+			}
+			sort.Strings(matches)
+			paths = matches
+		}
+		for _, path := range paths {
+			err := filepath.WalkDir(path, func(name string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.Type().IsRegular() {
+					add(name)
+				} else if !d.IsDir() && name == path {
+					// A named file that is not a directory, e.g. a symlink.
+					add(name)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return files, nil
+}
+
+// readPrefix reads the leading bytes of the file name into buf.
+func readPrefix(name string, buf []byte) (int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	n, err := io.ReadFull(f, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return n, err
+}
+
+// parseSize parses a size in bytes, with an optional K (KiB) or M (MiB)
+// suffix.
+func parseSize(s string) (int, error) {
+	value, shift := s, 0
+	switch upper := strings.ToUpper(s); {
+	case strings.HasSuffix(upper, "KIB"), strings.HasSuffix(upper, "K"):
+		value, shift = strings.TrimRight(s, "KkIiBb"), 10
+	case strings.HasSuffix(upper, "MIB"), strings.HasSuffix(upper, "M"):
+		value, shift = strings.TrimRight(s, "MmIiBb"), 20
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 || n > (1<<30)>>shift {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return n << shift, nil
+}
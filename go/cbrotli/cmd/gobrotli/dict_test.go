@@ -0,0 +1,135 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// writeCorpus writes n JSON documents sharing their structure into dir.
+func writeCorpus(t *testing.T, dir string, n int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		doc := fmt.Sprintf(`{"id": %d, "type": "customer_record", "name": "user%d", `+
+			`"address": {"street": "%d Main Street", "city": "Springfield", "country": "United States"}, `+
+			`"preferences": {"newsletter": %t, "language": "en-US", "timezone": "America/Chicago"}}`,
+			i, i*7, i*13, i%2 == 0)
+		name := filepath.Join(dir, fmt.Sprintf("doc%03d.json", i))
+		if i%3 == 0 {
+			name = filepath.Join(dir, "sub", fmt.Sprintf("doc%03d.json", i))
+		}
+		if err := os.WriteFile(name, []byte(doc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDictTrain(t *testing.T) {
+	dir := t.TempDir()
+	corpus := filepath.Join(dir, "corpus")
+	writeCorpus(t, corpus, 60)
+
+	var dictionaries [][]byte
+	for i, args := range [][]string{
+		{corpus},
+		{filepath.Join(corpus, "*.json"), filepath.Join(corpus, "sub")},
+	} {
+		out := filepath.Join(dir, fmt.Sprintf("dict%d.bin", i))
+		var stdout, stderr bytes.Buffer
+		args = append([]string{"dict", "train", "--size", "1K", "--out", out}, args...)
+		if code := run(args, nil, &stdout, &stderr); code != 0 {
+			t.Fatalf("%q: exit code %d: %s", args, code, &stderr)
+		}
+		dictionary, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dictionary) == 0 || len(dictionary) > 1024 {
+			t.Errorf("%q: dictionary of %d bytes", args, len(dictionary))
+		}
+		dictionaries = append(dictionaries, dictionary)
+	}
+	// The same samples in the same order give the same dictionary.
+	again := filepath.Join(dir, "again.bin")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"dict", "train", "--size", "1K", "--out", again, corpus}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit code %d: %s", code, &stderr)
+	}
+	if got, _ := os.ReadFile(again); !bytes.Equal(got, dictionaries[0]) {
+		t.Error("training is not deterministic")
+	}
+	if !bytes.Contains(dictionaries[0], []byte("Springfield")) {
+		t.Errorf("dictionary lacks common strings: %q", dictionaries[0])
+	}
+
+	serialized := filepath.Join(dir, "dict.serialized")
+	if code := run([]string{"dict", "train", "--size", "1K", "--format", "serialized", "--out", serialized, corpus},
+		nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("serialized: exit code %d: %s", code, &stderr)
+	}
+	data, err := os.ReadFile(serialized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := cbrotli.ParseSerializedDictionary(data); err != nil || len(info.Prefixes) != 1 {
+		t.Errorf("serialized: %v, %v", info, err)
+	} else if raw, _ := rawDictionary(data); !bytes.Equal(raw, dictionaries[0]) {
+		t.Error("serialized dictionary does not hold the raw one")
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"dict", "train", "--out", again}, "no samples given"},
+		{[]string{"dict", "train", corpus}, "--out is required"},
+		{[]string{"dict", "train", "--size", "1X", "--out", again, corpus}, "invalid --size"},
+		{[]string{"dict", "train", "--format", "zstd", "--out", again, corpus}, "invalid --format"},
+		{[]string{"dict", "train", "--out", again, filepath.Join(dir, "*.txt")}, "no files match"},
+		{[]string{"dict", "fit"}, "unknown dict command"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(tc.args, nil, &stdout, &stderr); code != 1 || !strings.Contains(stderr.String(), tc.want) {
+			t.Errorf("%q: exit code %d: %q, want %q", tc.args, code, &stderr, tc.want)
+		}
+	}
+}
+
+func TestDictEval(t *testing.T) {
+	dir := t.TempDir()
+	train, held := filepath.Join(dir, "train"), filepath.Join(dir, "held")
+	writeCorpus(t, train, 60)
+	writeCorpus(t, held, 10)
+	dict := filepath.Join(dir, "dict.bin")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"dict", "train", "--size", "2K", "--format", "serialized", "--out", dict, train}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("train: exit code %d: %s", code, &stderr)
+	}
+	if code := run([]string{"dict", "eval", "--dict", dict, "-q", "5", held}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("eval: exit code %d: %s", code, &stderr)
+	}
+	out := stdout.String()
+	if !strings.Contains(out, "samples:         10\n") || !strings.Contains(out, "gain:            ") {
+		t.Fatalf("eval output:\n%s", out)
+	}
+	var gain float64
+	fmt.Sscanf(out[strings.Index(out, "gain:")+len("gain:"):], "%f", &gain)
+	if gain <= 10 {
+		t.Errorf("gain %.2f%%, want more than 10%%", gain)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// streamReport is the result of inspecting a file; it is printed as text or,
+// with --json, as JSON.
+type streamReport struct {
+	File             string        `json:"file"`
+	WindowBits       int           `json:"window_bits"`
+	LargeWindow      bool          `json:"large_window"`
+	CompressedSize   int64         `json:"compressed_size"`
+	DecompressedSize int64         `json:"decompressed_size"`
+	Blocks           []blockReport `json:"blocks"`
+	Error            string        `json:"error,omitempty"`
+	Verify           *verifyReport `json:"verify,omitempty"`
+}
+
+type blockReport struct {
+	Type               string `json:"type"`
+	Last               bool   `json:"last"`
+	CompressedOffset   int64  `json:"compressed_offset"`
+	StartBit           int    `json:"start_bit"`
+	CompressedBits     int64  `json:"compressed_bits"`
+	DecompressedOffset int64  `json:"decompressed_offset"`
+	Length             int64  `json:"length"`
+	// Metadata is the hex content of metadata blocks, up to --metadata
+	// bytes.
+	Metadata string `json:"metadata,omitempty"`
+}
+
+// verifyReport is the result of decoding the whole file; offsets locate the
+// first error.
+type verifyReport struct {
+	OK                 bool   `json:"ok"`
+	Error              string `json:"error,omitempty"`
+	CompressedOffset   int64  `json:"compressed_offset"`
+	DecompressedOffset int64  `json:"decompressed_offset"`
+}
+
+// runInspect executes the inspect subcommand.
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("inspect", stderr)
+	verify := flags.Bool("verify", false, "decode the whole stream and report the first error")
+	asJSON := flags.Bool("json", false, "print JSON, one object per file")
+	metadata := flags.Int("metadata", 256, "number of metadata `bytes` printed per block")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 1
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	ok := true
+	for _, name := range files {
+		report, err := inspectFile(name, stdin, *verify, *metadata)
+		if err != nil {
+			fmt.Fprintf(stderr, "failed to open input file [%s]: %v\n", name, err)
+			ok = false
+			continue
+		}
+		if report.Error != "" || report.Verify != nil && !report.Verify.OK {
+			ok = false
+		}
+		if *asJSON {
+			data, _ := json.Marshal(report)
+			fmt.Fprintf(stdout, "%s\n", data)
+		} else {
+			report.print(stdout)
+		}
+	}
+	if !ok {
+		return 1
+	}
+	return 0
+}
+
+// inspectFile describes the Brotli stream of the file name ("-" for stdin).
+// The meta-blocks are listed by cbrotli.InspectReader, up to the first one
+// that fails; with verify, the stream is also decoded, which locates the first
+// error.
+func inspectFile(name string, stdin io.Reader, verify bool, metadataLimit int) (*streamReport, error) {
+	var src io.ReaderAt
+	var size int64
+	if name == "-" {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		src, size = bytes.NewReader(data), int64(len(data))
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		src, size = f, info.Size()
+	}
+	report := &streamReport{File: name, CompressedSize: size, Blocks: []blockReport{}}
+
+	var header [2]byte
+	n, _ := src.ReadAt(header[:], 0)
+	bits, large, err := parseWindowBits(header[:n])
+	if err != nil {
+		report.Error = err.Error()
+		if verify {
+			report.Verify = &verifyReport{Error: report.Error}
+		}
+		return report, nil
+	}
+	report.WindowBits, report.LargeWindow = bits, large
+
+	err = cbrotli.InspectReader(io.NewSectionReader(src, 0, size), func(info cbrotli.BlockInfo) {
+		block := blockReport{
+			Type:               info.Type.String(),
+			Last:               info.Last,
+			CompressedOffset:   info.CompressedOffset,
+			StartBit:           info.StartBit,
+			CompressedBits:     info.CompressedBits,
+			DecompressedOffset: info.DecompressedOffset,
+			Length:             info.Length,
+		}
+		if info.Type == cbrotli.BlockMetadata {
+			if info.Length > 0 {
+				// The metadata is byte aligned and ends the meta-block.
+				end := (info.CompressedOffset*8 + int64(info.StartBit) + info.CompressedBits) / 8
+				data := make([]byte, min(info.Length, int64(metadataLimit)))
+				if n, _ := src.ReadAt(data, end-info.Length); n == len(data) {
+					block.Metadata = hex.EncodeToString(data)
+				}
+			}
+		} else {
+			report.DecompressedSize = info.DecompressedOffset + info.Length
+		}
+		report.Blocks = append(report.Blocks, block)
+	})
+	if err != nil {
+		report.Error = err.Error()
+	}
+	if verify {
+		report.Verify = verifyStream(io.NewSectionReader(src, 0, size))
+	}
+	return report, nil
+}
+
+// verifyStream decodes the stream of src and locates the first error.
+func verifyStream(src io.Reader) *verifyReport {
+	var consumed, produced int64
+	r := cbrotli.NewReaderWithOptions(src, cbrotli.ReaderOptions{
+		Progress: func(compressed, decompressed int64) { consumed, produced = compressed, decompressed },
+	})
+	defer r.Close()
+	_, err := io.Copy(io.Discard, r)
+	v := &verifyReport{OK: err == nil, CompressedOffset: consumed, DecompressedOffset: produced}
+	if err != nil {
+		v.Error = err.Error()
+	}
+	return v
+}
+
+// parseWindowBits decodes the WBITS field of a stream header (RFC 7932,
+// section 9.1, and the large window extension).
+func parseWindowBits(header []byte) (bits int, large bool, err error) {
+	errTruncated := errors.New("truncated stream header")
+	if len(header) == 0 {
+		return 0, false, errTruncated
+	}
+	h := uint(header[0])
+	if len(header) > 1 {
+		h |= uint(header[1]) << 8
+	}
+	switch {
+	case h&1 == 0:
+		return 16, false, nil
+	case h>>1&7 != 0:
+		return 17 + int(h>>1&7), false, nil
+	}
+	switch n := int(h >> 4 & 7); n {
+	case 0:
+		return 17, false, nil
+	case 1:
+		if len(header) < 2 {
+			return 0, false, errTruncated
+		}
+		if h>>7&1 != 0 {
+			return 0, false, errors.New("invalid window bits")
+		}
+		bits = int(h >> 8 & 63)
+		if bits < 10 || bits > 30 {
+			return 0, false, fmt.Errorf("invalid large window bits %d", bits)
+		}
+		return bits, true, nil
+	default:
+		return 8 + n, false, nil
+	}
+}
+
+func (r *streamReport) print(w io.Writer) {
+	fmt.Fprintf(w, "%s: window bits %d, large window %t\n", r.File, r.WindowBits, r.LargeWindow)
+	for i, b := range r.Blocks {
+		last := ""
+		if b.Last {
+			last = ", last"
+		}
+		fmt.Fprintf(w, "  block %d: %s%s at byte %d bit %d, %d bits", i, b.Type, last,
+			b.CompressedOffset, b.StartBit, b.CompressedBits)
+		if b.Type == cbrotli.BlockMetadata.String() {
+			fmt.Fprintf(w, ", %d bytes of metadata\n", b.Length)
+			if b.Metadata != "" {
+				fmt.Fprintf(w, "    %s\n", b.Metadata)
+			}
+			continue
+		}
+		fmt.Fprintf(w, ", %d bytes at %d\n", b.Length, b.DecompressedOffset)
+	}
+	fmt.Fprintf(w, "  compressed %s, decompressed %s%s\n", formatBytes(r.CompressedSize),
+		formatBytes(r.DecompressedSize), rates(r.DecompressedSize, r.CompressedSize, 0))
+	if r.Error != "" {
+		fmt.Fprintf(w, "  error: %s\n", r.Error)
+	}
+	if v := r.Verify; v != nil {
+		if v.OK {
+			fmt.Fprintln(w, "  verify: ok")
+		} else {
+			fmt.Fprintf(w, "  verify: failed at compressed byte %d, decompressed byte %d: %s\n",
+				v.CompressedOffset, v.DecompressedOffset, v.Error)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+func TestInspect(t *testing.T) {
+	dir := t.TempDir()
+	var content []byte
+	rng := rand.New(rand.NewSource(1))
+	words := strings.Fields("ukko nooa oli kunnon mies kun han meni saunaan laittoi laukun naulaan")
+	for len(content) < 500000 {
+		content = append(content, words[rng.Intn(len(words))]...)
+		content = append(content, ' ')
+	}
+
+	// A stream with a metadata meta-block holding the gzip name.
+	var gz, compressed bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Name = "ukko.txt"
+	zw.Write(content)
+	zw.Close()
+	if _, err := cbrotli.TranscodeGzipToBrotliWithOptions(&compressed, &gz, cbrotli.TranscodeOptions{
+		Writer:   cbrotli.WriterOptions{Quality: 5, LGWin: 20},
+		KeepName: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	meta := filepath.Join(dir, "meta.br")
+	if err := os.WriteFile(meta, compressed.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	truncated := filepath.Join(dir, "truncated.br")
+	if err := os.WriteFile(truncated, compressed.Bytes()[:compressed.Len()/2], 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"inspect", "--verify", "--json", meta}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("exit code %d: %s", code, &stderr)
+	}
+	var report streamReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.WindowBits != 20 || report.LargeWindow || report.CompressedSize != int64(compressed.Len()) ||
+		report.DecompressedSize != int64(len(content)) || report.Verify == nil || !report.Verify.OK {
+		t.Errorf("report %+v", report)
+	}
+	if len(report.Blocks) < 2 || report.Blocks[0].Type != "metadata" ||
+		report.Blocks[0].Metadata != hex.EncodeToString([]byte("gzip-name:ukko.txt")) {
+		t.Fatalf("blocks %+v", report.Blocks)
+	}
+	var length int64
+	for i, b := range report.Blocks {
+		if b.Type != "metadata" {
+			length += b.Length
+		}
+		if b.Last != (i == len(report.Blocks)-1) {
+			t.Errorf("block %d: last %t", i, b.Last)
+		}
+	}
+	if length != int64(len(content)) {
+		t.Errorf("blocks decode to %d bytes, want %d", length, len(content))
+	}
+
+	stdout.Reset()
+	if code := run([]string{"inspect", meta}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("text: exit code %d: %s", code, &stderr)
+	}
+	if out := stdout.String(); !strings.HasPrefix(out, meta+": window bits 20, large window false\n") ||
+		!strings.Contains(out, "block 0: metadata at byte 0 bit 4") ||
+		!strings.Contains(out, hex.EncodeToString([]byte("gzip-name:ukko.txt"))) {
+		t.Errorf("text output:\n%s", out)
+	}
+
+	stdout.Reset()
+	if code := run([]string{"inspect", "--verify", "--json", truncated}, nil, &stdout, &stderr); code != 1 {
+		t.Fatalf("truncated: exit code %d: %s", code, &stderr)
+	}
+	report = streamReport{}
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if v := report.Verify; v == nil || v.OK || !strings.Contains(v.Error, "truncated") ||
+		v.CompressedOffset != int64(compressed.Len()/2) || v.DecompressedOffset <= 0 || v.DecompressedOffset >= int64(len(content)) {
+		t.Errorf("truncated: verify %+v", report.Verify)
+	}
+	if len(report.Blocks) == 0 || report.Blocks[0].Type != "metadata" {
+		t.Errorf("truncated: blocks %+v", report.Blocks)
+	}
+
+	stderr.Reset()
+	if code := run([]string{"inspect", filepath.Join(dir, "missing.br")}, nil, &stdout, &stderr); code != 1 ||
+		!strings.Contains(stderr.String(), "failed to open input file") {
+		t.Errorf("missing: exit code %d: %q", code, &stderr)
+	}
+}
+
+func TestParseWindowBits(t *testing.T) {
+	for _, tc := range []struct {
+		header []byte
+		bits   int
+		large  bool
+		err    bool
+	}{
+		{[]byte{0x00}, 16, false, false},
+		{[]byte{0x0b}, 22, false, false},
+		{[]byte{0x0f}, 24, false, false},
+		{[]byte{0x01}, 17, false, false},
+		{[]byte{0x21}, 10, false, false},
+		{[]byte{0x71}, 15, false, false},
+		{[]byte{0x11, 0x1e}, 30, true, false},
+		{[]byte{0x11, 0x09}, 0, false, true},
+		{[]byte{0x11}, 0, false, true},
+		{nil, 0, false, true},
+	} {
+		bits, large, err := parseWindowBits(tc.header)
+		if bits != tc.bits || large != tc.large || (err != nil) != tc.err {
+			t.Errorf("%x: %d, %t, %v", tc.header, bits, large, err)
+		}
+	}
+}
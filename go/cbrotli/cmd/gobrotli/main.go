@@ -0,0 +1,522 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Command gobrotli compresses and decompresses files with Brotli, using
+// cbrotli, for environments where the brotli command is not available. Its
+// options, messages and exit codes follow those of the brotli command, so
+// that scripts can use either:
+//
+//	gobrotli [OPTION]... [FILE]...
+//	gobrotli compress [OPTION]... [FILE]...
+//	gobrotli decompress [OPTION]... [FILE]...
+//
+// With -r, the files of directories are compressed next to them by parallel
+// workers, skipping those with an up-to-date output:
+//
+//	gobrotli compress -r --jobs 8 --min-ratio 1.1 DIR...
+//
+// The dict subcommand trains and evaluates shared dictionaries:
+//
+//	gobrotli dict train --size 64K --out dict.bin SAMPLE...
+//	gobrotli dict eval --dict dict.bin SAMPLE...
+//
+// The inspect subcommand describes the meta-blocks of compressed files:
+//
+//	gobrotli inspect [--verify] [--json] [FILE]...
+//
+// Data is streamed, so that files of any size are processed in constant
+// memory. The exit code is 0 on success and 1 on any failure.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+const usage = `Usage: gobrotli [OPTION]... [FILE]...
+       gobrotli dict train|eval [OPTION]... SAMPLE...
+       gobrotli inspect [--verify] [--json] [FILE]...
+Options:
+  -#                          compression level (0-9)
+  -c, --stdout                write on standard output
+  -d, --decompress            decompress
+  -f, --force                 force output file overwrite
+  -h, --help                  display this help and exit
+  -j, --rm                    remove source file(s)
+  -k, --keep                  keep source file(s) (default)
+  -r, --recursive             compress the files of directories, in parallel
+  --jobs N                    number of files compressed at once with -r
+                              (default: number of CPUs)
+  --min-ratio R               with -r, delete the outputs of files that do
+                              not compress R times (e.g. 1.1) or better
+  -o FILE, --output=FILE      output file (only if 1 input file)
+  -q NUM, --quality=NUM       compression level (0-11)
+  -t, --test                  test compressed file integrity
+  -v, --verbose               verbose mode
+  -w NUM, --lgwin=NUM         set LZ77 window size (0, 10-24)
+                              window size = 2**NUM - 16
+                              0 lets compressor choose the optimal value
+  -S SUF, --suffix=SUF        output file suffix (default:'.br')
+  -Z, --best                  use best compression level (11) (default)
+Simple options could be coalesced, i.e. '-9kf' is equivalent to '-9 -k -f'.
+With no FILE, or when FILE is -, read standard input.
+All arguments after '--' are treated as files.
+`
+
+// options holds the parsed command line.
+type options struct {
+	decompress, test             bool
+	toStdout, force, rm, verbose bool
+	quality, lgwin               int
+	output, suffix               string
+	files                        []string
+	// Options of recursive compression.
+	recursive bool
+	jobs      int
+	minRatio  float64
+}
+
+// errHelp is returned by parseArgs for -h.
+var errHelp = errors.New("help requested")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "compress":
+			args = args[1:]
+		case "decompress":
+			args = append([]string{"-d"}, args[1:]...)
+		case "dict":
+			return runDict(args[1:], stdout, stderr)
+		case "inspect":
+			return runInspect(args[1:], stdin, stdout, stderr)
+		}
+	}
+	o, err := parseArgs(args)
+	if err == errHelp {
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		fmt.Fprint(stderr, usage)
+		return 1
+	}
+	c := &cli{options: o, stdin: stdin, stdout: stdout, stderr: stderr}
+	if o.recursive {
+		return c.compressTree()
+	}
+	ok := true
+	for _, path := range o.files {
+		if !c.process(path) {
+			ok = false
+		}
+	}
+	if !ok {
+		return 1
+	}
+	return 0
+}
+
+// parseArgs parses args like the brotli command does.
+func parseArgs(args []string) (*options, error) {
+	o := &options{quality: -1, lgwin: -1}
+	qualitySet := false
+	setQuality := func(value string) error {
+		if qualitySet {
+			return errors.New("quality already set")
+		}
+		q, err := strconv.Atoi(value)
+		if err != nil || q < cbrotli.MinQuality || q > cbrotli.MaxQuality {
+			return fmt.Errorf("error parsing quality value [%s]", value)
+		}
+		o.quality, qualitySet = q, true
+		return nil
+	}
+	setLGWin := func(value string) error {
+		if o.lgwin >= 0 {
+			return errors.New("lgwin parameter already set")
+		}
+		w, err := strconv.Atoi(value)
+		if err != nil || w != 0 && (w < 10 || w > 24) {
+			return fmt.Errorf("error parsing lgwin value [%s]", value)
+		}
+		o.lgwin = w
+		return nil
+	}
+	setValue := func(name, value string) error {
+		switch name {
+		case "q", "quality":
+			return setQuality(value)
+		case "w", "lgwin":
+			return setLGWin(value)
+		case "o", "output":
+			if o.output != "" {
+				return errors.New("write to standard output already set (-o)")
+			}
+			o.output = value
+		case "S", "suffix":
+			if o.suffix != "" {
+				return errors.New("suffix already set")
+			}
+			o.suffix = value
+		case "jobs":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("error parsing jobs value [%s]", value)
+			}
+			o.jobs = n
+		case "min-ratio":
+			r, err := strconv.ParseFloat(value, 64)
+			if err != nil || !(r > 0) {
+				return fmt.Errorf("error parsing min-ratio value [%s]", value)
+			}
+			o.minRatio = r
+		}
+		return nil
+	}
+	setFlag := func(name string) error {
+		switch name {
+		case "c", "stdout":
+			o.toStdout = true
+		case "d", "decompress":
+			o.decompress = true
+		case "f", "force":
+			o.force = true
+		case "h", "help":
+			return errHelp
+		case "j", "rm":
+			o.rm = true
+		case "k", "keep":
+			o.rm = false
+		case "r", "recursive":
+			o.recursive = true
+		case "t", "test":
+			o.test = true
+		case "v", "verbose":
+			o.verbose = true
+		case "Z", "best":
+			return setQuality(strconv.Itoa(cbrotli.MaxQuality))
+		default:
+			return fmt.Errorf("invalid argument -%s", name)
+		}
+		return nil
+	}
+	valued := map[string]bool{"q": true, "w": true, "o": true, "S": true,
+		"quality": true, "lgwin": true, "output": true, "suffix": true,
+		"jobs": true, "min-ratio": true}
+	// The options that the brotli command lacks also take their parameter
+	// as the next argument.
+	spaced := map[string]bool{"jobs": true, "min-ratio": true}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			o.files = append(o.files, args[i+1:]...)
+			i = len(args)
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := strings.Cut(arg[2:], "=")
+			if !valued[name] {
+				if hasValue {
+					return nil, fmt.Errorf("invalid parameter: [%s]", arg)
+				}
+				if err := setFlag(name); err != nil {
+					if err != errHelp {
+						err = fmt.Errorf("invalid parameter: [%s]", arg)
+					}
+					return nil, err
+				}
+				continue
+			}
+			if !hasValue && spaced[name] && i+1 < len(args) {
+				i++
+				value, hasValue = args[i], true
+			}
+			if !hasValue {
+				return nil, fmt.Errorf("must pass the parameter as --%s=value", name)
+			}
+			if err := setValue(name, value); err != nil {
+				return nil, err
+			}
+		case len(arg) > 1 && arg[0] == '-':
+			for j := 1; j < len(arg); j++ {
+				name := arg[j : j+1]
+				if c := arg[j]; c >= '0' && c <= '9' {
+					if err := setQuality(name); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				if !valued[name] {
+					if err := setFlag(name); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				// The parameter is the rest of the argument, or the next one.
+				value := arg[j+1:]
+				if value == "" {
+					if i+1 == len(args) {
+						return nil, fmt.Errorf("expected parameter for argument -%s", name)
+					}
+					i++
+					value = args[i]
+				}
+				if err := setValue(name, value); err != nil {
+					return nil, err
+				}
+				break
+			}
+		default:
+			o.files = append(o.files, arg)
+		}
+	}
+
+	if len(o.files) == 0 {
+		o.files = []string{"-"}
+	}
+	if o.output != "" && (o.toStdout || len(o.files) > 1) {
+		return nil, errors.New("-o can be used only with a single input file, and not with -c")
+	}
+	if o.recursive && (o.decompress || o.test || o.toStdout || o.output != "") {
+		return nil, errors.New("-r can be used only to compress to files")
+	}
+	if (o.jobs != 0 || o.minRatio != 0) && !o.recursive {
+		return nil, errors.New("--jobs and --min-ratio can be used only with -r")
+	}
+	if o.suffix == "" {
+		o.suffix = ".br"
+	}
+	if !qualitySet {
+		o.quality = cbrotli.MaxQuality
+	}
+	if o.lgwin < 0 {
+		o.lgwin = 0
+	}
+	return o, nil
+}
+
+// cli processes the files of a command line.
+type cli struct {
+	*options
+	stdin          io.Reader
+	stdout, stderr io.Writer
+}
+
+// process compresses, decompresses or tests the file path ("-" for the
+// standard input) and reports errors; it returns whether it succeeded.
+func (c *cli) process(path string) bool {
+	name := path
+	if path == "-" {
+		name = "con"
+	}
+	output, err := c.outputPath(path)
+	if err != nil {
+		fmt.Fprintln(c.stderr, err)
+		return false
+	}
+	start := time.Now()
+	in, out, err := c.transform(path, output)
+	if err != nil {
+		c.report(name, output, err)
+		return false
+	}
+	if c.verbose && !c.test {
+		verb := "Compressed"
+		if c.decompress {
+			verb = "Decompressed"
+		}
+		fmt.Fprintf(c.stderr, "%s [%s]: %s -> %s in %.2f sec%s\n", verb, name,
+			formatBytes(in), formatBytes(out), time.Since(start).Seconds(), rates(in, out, time.Since(start)))
+	}
+	return true
+}
+
+// outputPath returns the file to write for path, or "" for the standard
+// output (and for -t).
+func (c *cli) outputPath(path string) (string, error) {
+	switch {
+	case c.test || c.toStdout:
+		return "", nil
+	case c.output != "":
+		return c.output, nil
+	case path == "-":
+		return "", nil
+	case !c.decompress:
+		return path + c.suffix, nil
+	}
+	output, ok := strings.CutSuffix(path, c.suffix)
+	if !ok || output == "" {
+		return "", fmt.Errorf("input file [%s] suffix mismatch", path)
+	}
+	return output, nil
+}
+
+// transform processes path to output, and returns the numbers of bytes read
+// and written.
+func (c *cli) transform(path, output string) (in, out int64, err error) {
+	writerOptions := cbrotli.WriterOptions{Quality: c.quality, LGWin: c.lgwin}
+	if path != "-" && output != "" {
+		// Files go through a temporary file, which gets the attributes of
+		// the source and replaces output once complete.
+		fileOptions := cbrotli.FileOptions{Writer: writerOptions, Overwrite: c.force, RemoveSource: c.rm}
+		in = -1
+		if info, err := os.Stat(path); err == nil {
+			in = info.Size()
+		}
+		if c.decompress {
+			err = cbrotli.DecompressFileWithOptions(path, output, fileOptions)
+		} else {
+			err = cbrotli.CompressFileWithOptions(path, output, fileOptions)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		out = -1
+		if info, err := os.Stat(output); err == nil {
+			out = info.Size()
+		}
+		return in, out, nil
+	}
+
+	src := c.stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return 0, 0, err
+		}
+		defer f.Close()
+		src = f
+	}
+	dst := c.stdout
+	var f *os.File
+	if output != "" {
+		flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		if !c.force {
+			flags |= os.O_EXCL
+		}
+		if f, err = os.OpenFile(output, flags, 0o644); err != nil {
+			return 0, 0, err
+		}
+		dst = f
+	}
+	sr := &countingReader{r: src}
+	sw := &countingWriter{w: dst}
+	switch {
+	case c.test:
+		r := cbrotli.NewReader(sr)
+		_, err = io.Copy(io.Discard, r)
+		r.Close()
+	case c.decompress:
+		r := cbrotli.NewReader(sr)
+		_, err = io.Copy(sw, r)
+		r.Close()
+	default:
+		w := cbrotli.NewWriter(sw, writerOptions)
+		_, err = w.ReadFrom(sr)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if f != nil {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(output)
+		}
+	}
+	return sr.n, sw.n, err
+}
+
+// report prints the error of processing path like the brotli command.
+func (c *cli) report(name, output string, err error) {
+	var decoderErr cbrotli.DecoderError
+	var pathErr *fs.PathError
+	switch {
+	case errors.Is(err, cbrotli.ErrTruncated):
+		fmt.Fprintf(c.stderr, "corrupt input [%s]\n", name)
+		if c.verbose {
+			fmt.Fprintln(c.stderr, "reason: truncated input")
+		}
+	case errors.As(err, &decoderErr), errors.Is(err, cbrotli.ErrCorrupt):
+		fmt.Fprintf(c.stderr, "corrupt input [%s]\n", name)
+		if c.verbose {
+			fmt.Fprintf(c.stderr, "reason: %v\n", err)
+		}
+	case errors.As(err, &pathErr) && pathErr.Path == output:
+		fmt.Fprintf(c.stderr, "failed to open output file [%s]: %v\n", output, pathErr.Err)
+	case errors.As(err, &pathErr):
+		fmt.Fprintf(c.stderr, "failed to open input file [%s]: %v\n", name, pathErr.Err)
+	default:
+		fmt.Fprintf(c.stderr, "failed to process [%s]: %v\n", name, err)
+	}
+}
+
+// formatBytes formats a size as the brotli command does.
+func formatBytes(n int64) string {
+	switch {
+	case n < 0:
+		return "?"
+	case n < 1<<10:
+		return fmt.Sprintf("%d B", n)
+	case n < 1<<20:
+		return fmt.Sprintf("%.3f KiB", float64(n)/(1<<10))
+	case n < 1<<30:
+		return fmt.Sprintf("%.3f MiB", float64(n)/(1<<20))
+	}
+	return fmt.Sprintf("%.3f GiB", float64(n)/(1<<30))
+}
+
+// rates formats the compression ratio and the throughput of a transformation
+// of in to out bytes in d.
+func rates(in, out int64, d time.Duration) string {
+	if in <= 0 || out < 0 {
+		return ""
+	}
+	// The throughput is that of the uncompressed side.
+	size := max(in, out)
+	s := fmt.Sprintf(", ratio %.3f", float64(min(in, out))/float64(size))
+	if d > 0 {
+		s += fmt.Sprintf(", %.1f MiB/s", float64(size)/(1<<20)/d.Seconds())
+	}
+	return s
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
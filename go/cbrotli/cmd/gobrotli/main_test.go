@@ -0,0 +1,161 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testdata holds fixtures compressed by the brotli command.
+const testdata = "../../../../tests/testdata"
+
+// readFixture returns the content of the fixture name, skipping the test if
+// testdata is not available, as in Bazel sandboxes.
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(testdata, name))
+	if os.IsNotExist(err) {
+		t.Skip("fixtures not found")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	content := bytes.Repeat([]byte("The quick brown fox jumps over the lazy dog. "), 2000)
+	src := filepath.Join(dir, "fox.txt")
+	if err := os.WriteFile(src, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-9kv", src}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("compress: exit code %d: %s", code, &stderr)
+	}
+	if !strings.HasPrefix(stderr.String(), "Compressed ["+src+"]: 87.891 KiB -> ") ||
+		!strings.Contains(stderr.String(), ", ratio 0.0") {
+		t.Errorf("compress -v: %q", &stderr)
+	}
+	if _, err := os.Stat(src); err != nil {
+		t.Errorf("source not kept: %v", err)
+	}
+	stderr.Reset()
+	if code := run([]string{src}, nil, &stdout, &stderr); code != 1 || !strings.Contains(stderr.String(), "failed to open output file") {
+		t.Errorf("compress without -f: exit code %d: %q", code, &stderr)
+	}
+
+	stderr.Reset()
+	if code := run([]string{"decompress", "-c", src + ".br"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("decompress -c: exit code %d: %s", code, &stderr)
+	}
+	if !bytes.Equal(stdout.Bytes(), content) {
+		t.Errorf("decompress -c: %d bytes, want %d", stdout.Len(), len(content))
+	}
+	if code := run([]string{"-djf", src + ".br"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("decompress -j: exit code %d: %s", code, &stderr)
+	}
+	if got, err := os.ReadFile(src); err != nil || !bytes.Equal(got, content) {
+		t.Errorf("decompress -j: %d bytes, %v", len(got), err)
+	}
+	if _, err := os.Stat(src + ".br"); !os.IsNotExist(err) {
+		t.Errorf("source not removed: %v", err)
+	}
+
+	// Standard streams.
+	var compressed, decompressed bytes.Buffer
+	if code := run([]string{"compress", "-q", "5", "--lgwin=18"}, bytes.NewReader(content), &compressed, &stderr); code != 0 {
+		t.Fatalf("compress from stdin: exit code %d: %s", code, &stderr)
+	}
+	if code := run([]string{"-d", "-"}, &compressed, &decompressed, &stderr); code != 0 {
+		t.Fatalf("decompress from stdin: exit code %d: %s", code, &stderr)
+	}
+	if !bytes.Equal(decompressed.Bytes(), content) {
+		t.Errorf("stdin round trip: %d bytes, want %d", decompressed.Len(), len(content))
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	dir := t.TempDir()
+	truncated := filepath.Join(dir, "truncated.br")
+	data := readFixture(t, "ukkonooa.compressed")
+	if err := os.WriteFile(truncated, data[:len(data)/2], 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-x"}, "invalid argument -x"},
+		{[]string{"-q"}, "expected parameter for argument -q"},
+		{[]string{"-q", "12"}, "error parsing quality value [12]"},
+		{[]string{"-w", "9"}, "error parsing lgwin value [9]"},
+		{[]string{"--quality"}, "must pass the parameter as --quality=value"},
+		{[]string{"--bogus"}, "invalid parameter: [--bogus]"},
+		{[]string{"-o", "out", "a", "b"}, "-o can be used only with a single input file"},
+		{[]string{filepath.Join(dir, "missing")}, "failed to open input file"},
+		{[]string{"-d", filepath.Join(dir, "plain.txt")}, "suffix mismatch"},
+		{[]string{"-t", truncated}, "corrupt input [" + truncated + "]"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(tc.args, nil, &stdout, &stderr); code != 1 || !strings.Contains(stderr.String(), tc.want) {
+			t.Errorf("%q: exit code %d: %q, want %q", tc.args, code, &stderr, tc.want)
+		}
+	}
+}
+
+func TestRunFixtures(t *testing.T) {
+	for _, name := range []string{"empty", "ukkonooa"} {
+		want := readFixture(t, name)
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{"-dc", filepath.Join(testdata, name+".compressed")}, nil, &stdout, &stderr); code != 0 {
+			t.Fatalf("%s: exit code %d: %s", name, code, &stderr)
+		}
+		if !bytes.Equal(stdout.Bytes(), want) {
+			t.Errorf("%s: got %q, want %q", name, &stdout, want)
+		}
+	}
+}
+
+// TestBrotliInterop checks that the brotli command and gobrotli read the
+// output of one another.
+func TestBrotliInterop(t *testing.T) {
+	brotli, err := exec.LookPath("brotli")
+	if err != nil {
+		t.Skip("brotli command not found")
+	}
+	content := bytes.Repeat([]byte("ukko nooa "), 10000)
+	var compressed, stderr bytes.Buffer
+	if code := run([]string{"-q", "7"}, bytes.NewReader(content), &compressed, &stderr); code != 0 {
+		t.Fatalf("exit code %d: %s", code, &stderr)
+	}
+	cmd := exec.Command(brotli, "-dc")
+	cmd.Stdin = &compressed
+	got, err := cmd.Output()
+	if err != nil || !bytes.Equal(got, content) {
+		t.Errorf("brotli -dc: %d bytes, %v", len(got), err)
+	}
+
+	cmd = exec.Command(brotli, "-c")
+	cmd.Stdin = bytes.NewReader(content)
+	if got, err = cmd.Output(); err != nil {
+		t.Fatal(err)
+	}
+	var decompressed bytes.Buffer
+	if code := run([]string{"-d"}, bytes.NewReader(got), &decompressed, &stderr); code != 0 || !bytes.Equal(decompressed.Bytes(), content) {
+		t.Errorf("gobrotli -d: exit code %d: %d bytes: %s", code, decompressed.Len(), &stderr)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// treeSummary counts the outcomes of compressTree.
+type treeSummary struct {
+	files, skipped, belowRatio int
+	in, out                    int64 // sizes of the files compressed
+	failures                   []treeFailure
+}
+
+type treeFailure struct {
+	path string
+	err  error
+}
+
+// errBelowRatio reports an output removed for --min-ratio.
+var errBelowRatio = errors.New("below minimal ratio")
+
+// compressTree compresses the regular files of the directories (and the
+// files) given as arguments next to them, with the suffix, by a pool of
+// workers. Files whose output is at least as recent as them are skipped, as
+// are the files with the suffix. Failures are reported at the end, and do
+// not stop the other files.
+func (c *cli) compressTree() int {
+	jobs := c.jobs
+	if jobs == 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	paths := make(chan string)
+	var mu sync.Mutex
+	var summary treeSummary
+	fail := func(path string, err error) {
+		mu.Lock()
+		summary.failures = append(summary.failures, treeFailure{path, err})
+		mu.Unlock()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each worker reuses its Writer, and so its encoder, for all its
+			// files.
+			var w *cbrotli.Writer
+			for path := range paths {
+				in, out, skipped, err := c.compressTreeFile(&w, path)
+				mu.Lock()
+				switch {
+				case skipped:
+					summary.skipped++
+				case err == errBelowRatio:
+					summary.belowRatio++
+				case err != nil:
+					summary.failures = append(summary.failures, treeFailure{path, err})
+				default:
+					summary.files++
+					summary.in += in
+					summary.out += out
+				}
+				mu.Unlock()
+				if c.verbose && err == nil && !skipped {
+					fmt.Fprintf(c.stderr, "Compressed [%s]: %s -> %s\n", path, formatBytes(in), formatBytes(out))
+				}
+			}
+			if w != nil {
+				w.Close()
+			}
+		}()
+	}
+
+	start := time.Now()
+	for _, root := range c.files {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// Report unreadable directories, and walk the rest.
+				fail(path, err)
+				if d != nil && d.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if d.Type().IsRegular() && !strings.HasSuffix(path, c.suffix) {
+				paths <- path
+			}
+			return nil
+		})
+		if err != nil {
+			fail(root, err)
+		}
+	}
+	close(paths)
+	wg.Wait()
+
+	saved := formatBytes(summary.in - summary.out)
+	if summary.out > summary.in {
+		saved = "-" + formatBytes(summary.out-summary.in)
+	}
+	fmt.Fprintf(c.stderr, "compressed %d files: %s -> %s, saved %s in %.2f sec\n",
+		summary.files, formatBytes(summary.in), formatBytes(summary.out), saved, time.Since(start).Seconds())
+	fmt.Fprintf(c.stderr, "skipped %d up-to-date files, removed %d outputs below the minimal ratio\n",
+		summary.skipped, summary.belowRatio)
+	if len(summary.failures) == 0 {
+		return 0
+	}
+	fmt.Fprintf(c.stderr, "%d files failed:\n", len(summary.failures))
+	for _, f := range summary.failures {
+		fmt.Fprintf(c.stderr, "  [%s]: %v\n", f.path, f.err)
+	}
+	return 1
+}
+
+// compressTreeFile compresses path to path+suffix through a temporary file,
+// with *w, setting it on first use. The output gets the permissions and the
+// modification time of path, so that an up-to-date output is recognized.
+func (c *cli) compressTreeFile(w **cbrotli.Writer, path string) (in, out int64, skipped bool, err error) {
+	output := path + c.suffix
+	src, err := os.Open(path)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if oi, err := os.Stat(output); err == nil && oi.Mode().IsRegular() && oi.Size() > 0 &&
+		!oi.ModTime().Before(info.ModTime()) {
+		return 0, 0, true, nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(output), "."+filepath.Base(output)+".tmp")
+	if err != nil {
+		return 0, 0, false, err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	counter := &countingWriter{w: tmp}
+	options := cbrotli.WriterOptions{Quality: c.quality, LGWin: c.lgwin}
+	if *w == nil {
+		*w = cbrotli.NewWriter(counter, options)
+	} else if err := (*w).ResetOptions(counter, options); err != nil {
+		return 0, 0, false, err
+	}
+	if in, err = io.Copy(*w, src); err != nil {
+		(*w).Close()
+		*w = nil
+		return 0, 0, false, err
+	}
+	if err = (*w).Close(); err != nil {
+		*w = nil
+		return 0, 0, false, err
+	}
+	out = counter.n
+	if c.minRatio > 0 && float64(in) < c.minRatio*float64(out) {
+		// A stale output would not be up to date either.
+		if err := os.Remove(output); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return 0, 0, false, err
+		}
+		return 0, 0, false, errBelowRatio
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		return 0, 0, false, err
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, 0, false, err
+	}
+	if err = os.Chtimes(tmp.Name(), time.Time{}, info.ModTime()); err != nil {
+		return 0, 0, false, err
+	}
+	if err = os.Rename(tmp.Name(), output); err != nil {
+		return 0, 0, false, err
+	}
+	if c.rm {
+		if err = os.Remove(path); err != nil {
+			return in, out, false, err
+		}
+	}
+	return in, out, false, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+func TestCompressTree(t *testing.T) {
+	dir := t.TempDir()
+	text := bytes.Repeat([]byte("static asset "), 1000)
+	random := make([]byte, 10000)
+	rand.New(rand.NewSource(1)).Read(random)
+	files := map[string][]byte{
+		"index.html":          text,
+		"css/site.css":        text[:5000],
+		"js/app.js":           text[:8000],
+		"js/vendor/lib.js":    text[:3000],
+		"img/photo.jpg":       random,
+		"img/photo.jpg.br":    []byte("stale"),
+		"fonts/existing.woff": text,
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chmod(filepath.Join(dir, "js/app.js"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// The output of a file is in the way: it fails, but not the others.
+	if err := os.Mkdir(filepath.Join(dir, "fonts/existing.woff.br"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "img/photo.jpg.br"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"compress", "-r", "--jobs", "3", "--min-ratio=1.5", "-q", "5", dir}, nil, &stdout, &stderr)
+	out := stderr.String()
+	if code != 1 || !strings.Contains(out, "compressed 4 files: ") ||
+		!strings.Contains(out, "skipped 0 up-to-date files, removed 1 outputs below the minimal ratio") ||
+		!strings.Contains(out, "1 files failed:\n  ["+filepath.Join(dir, "fonts/existing.woff")+"]: ") {
+		t.Fatalf("exit code %d:\n%s", code, out)
+	}
+	for _, name := range []string{"index.html", "css/site.css", "js/app.js", "js/vendor/lib.js"} {
+		path := filepath.Join(dir, name)
+		compressed, err := os.ReadFile(path + ".br")
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if got, err := cbrotli.Decode(compressed); err != nil || !bytes.Equal(got, files[name]) {
+			t.Errorf("%s: decoded %d bytes, %v", name, len(got), err)
+		}
+		src, _ := os.Stat(path)
+		dst, _ := os.Stat(path + ".br")
+		if dst.Mode() != src.Mode() || !dst.ModTime().Equal(src.ModTime()) {
+			t.Errorf("%s: output mode %v, modtime %v; want %v, %v", name, dst.Mode(), dst.ModTime(), src.Mode(), src.ModTime())
+		}
+	}
+	// The random file did not compress: its output, and the stale one, are
+	// gone.
+	if _, err := os.Stat(filepath.Join(dir, "img/photo.jpg.br")); !os.IsNotExist(err) {
+		t.Errorf("output below the minimal ratio: %v", err)
+	}
+
+	// Up-to-date outputs are skipped; changed files are compressed again.
+	changed := filepath.Join(dir, "css/site.css")
+	if err := os.WriteFile(changed, text[:6000], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(changed, future, future); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(filepath.Join(dir, "fonts/existing.woff.br"))
+	stderr.Reset()
+	if code := run([]string{"-r", "-q", "5", "--min-ratio", "1.5", dir}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("second run: exit code %d:\n%s", code, &stderr)
+	}
+	if out := stderr.String(); !strings.Contains(out, "compressed 2 files: ") ||
+		!strings.Contains(out, "skipped 3 up-to-date files, removed 1 outputs") {
+		t.Errorf("second run:\n%s", out)
+	}
+	compressed, _ := os.ReadFile(changed + ".br")
+	if got, err := cbrotli.Decode(compressed); err != nil || !bytes.Equal(got, text[:6000]) {
+		t.Errorf("changed file: decoded %d bytes, %v", len(got), err)
+	}
+
+	for _, args := range [][]string{
+		{"-r", "-d", dir},
+		{"-r", "-c", dir},
+		{"--jobs=2", dir},
+		{"-r", "--jobs=0", dir},
+		{"-r", "--min-ratio=x", dir},
+	} {
+		if code := run(args, nil, &stdout, &stderr); code != 1 {
+			t.Errorf("%q: exit code %d", args, code)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+)
+
+// ConnOptions configures NewConn.
+type ConnOptions struct {
+	// Writer configures the compression of the outgoing stream.
+	Writer WriterOptions
+	// Reader configures the decompression of the incoming stream; it must
+	// match the Writer options of the peer (e.g. the dictionaries).
+	Reader ReaderOptions
+}
+
+// NewConn returns a net.Conn that sends a Brotli stream of the data written
+// to it through c, and decodes the Brotli stream received from c. Each Write
+// is flushed, so that request/response protocols work as with c itself.
+// Deadlines are those of c: a Read that times out can be retried with a new
+// deadline, but a Write that fails, including by timeout, breaks the
+// outgoing stream.
+//
+// The returned Conn has a CloseWrite method, which ends the outgoing stream,
+// and then half-closes c if c has a CloseWrite method (like *net.TCPConn).
+// Either way, Read of the peer Conn returns io.EOF once the stream ends,
+// without waiting for the end of c.
+//
+// Close ends the outgoing stream, unless CloseWrite did, and then closes c.
+// Ending the stream writes to c, so Close may block like Write, subject to
+// the write deadline.
+func NewConn(c net.Conn, options ConnOptions) net.Conn {
+	return &conn{
+		Conn: c,
+		w:    NewWriter(c, options.Writer),
+		r:    NewReaderWithOptions(c, options.Reader),
+	}
+}
+
+type conn struct {
+	net.Conn
+
+	wmu         sync.Mutex // serializes writes
+	w           *Writer
+	writeClosed bool
+
+	rmu sync.Mutex // serializes reads, and Close with them
+	r   *Reader
+
+	closeOnce sync.Once
+	closeErr  error
+	closed    bool // guarded by rmu
+}
+
+func (c *conn) Read(p []byte) (int, error) {
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+	if c.closed {
+		return 0, net.ErrClosed
+	}
+	if c.r.finished() {
+		// Reading c would wait for the peer to close it.
+		return 0, io.EOF
+	}
+	n, err := c.r.Read(p)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		// The Reader keeps errors of its source; this one is transient.
+		c.r.srcErr = nil
+	}
+	return n, err
+}
+
+func (c *conn) Write(p []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.writeClosed {
+		return 0, net.ErrClosed
+	}
+	n, err := c.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return n, c.w.Flush()
+}
+
+// CloseWrite ends the outgoing stream and half-closes the underlying Conn if
+// it supports it.
+func (c *conn) CloseWrite() error {
+	if err := c.endStream(); err != nil {
+		return err
+	}
+	if cw, ok := c.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return nil
+}
+
+// endStream closes the Writer once.
+func (c *conn) endStream() error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.writeClosed {
+		return nil
+	}
+	c.writeClosed = true
+	return c.w.Close()
+}
+
+func (c *conn) Close() error {
+	c.closeOnce.Do(func() {
+		err := c.endStream()
+		// Closing c first ends a blocked Read, before its Reader is closed.
+		if closeErr := c.Conn.Close(); err == nil {
+			err = closeErr
+		}
+		c.rmu.Lock()
+		c.closed = true
+		c.r.Close()
+		c.rmu.Unlock()
+		c.closeErr = err
+	})
+	return c.closeErr
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package connectbrotli adds Brotli compression to connect-go handlers and
+// clients, using cbrotli:
+//
+//	compression, err := connectbrotli.WithCompression(5)
+//	...
+//	path, handler := pingv1connect.NewPingServiceHandler(svc, compression)
+//
+// Clients use WithAcceptCompression or WithSendCompression likewise.
+//
+// It is a separate module, so that cbrotli does not depend on connect-go.
+package connectbrotli
+
+import (
+	"errors"
+	"io"
+	"runtime"
+
+	"connectrpc.com/connect"
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// Name is the name of the compression, as negotiated by connect.
+const Name = "br"
+
+// WithCompression returns the option that makes a handler accept requests
+// compressed with Brotli and compress responses with it, at quality, for
+// clients that accept it. It fails if quality is out of range.
+func WithCompression(quality int) (connect.HandlerOption, error) {
+	decompressor, compressor, err := pools(quality)
+	if err != nil {
+		return nil, err
+	}
+	return connect.WithCompression(Name, decompressor, compressor), nil
+}
+
+// WithAcceptCompression returns the option that makes a client accept
+// responses compressed with Brotli, and able to compress requests with it at
+// quality once selected with connect.WithSendCompression. It fails if quality
+// is out of range.
+func WithAcceptCompression(quality int) (connect.ClientOption, error) {
+	decompressor, compressor, err := pools(quality)
+	if err != nil {
+		return nil, err
+	}
+	return connect.WithAcceptCompression(Name, decompressor, compressor), nil
+}
+
+// WithSendCompression is WithAcceptCompression that also makes the client
+// compress requests with Brotli.
+func WithSendCompression(quality int) (connect.ClientOption, error) {
+	accept, err := WithAcceptCompression(quality)
+	if err != nil {
+		return nil, err
+	}
+	return connect.WithClientOptions(accept, connect.WithSendCompression(Name)), nil
+}
+
+// pools returns the constructors connect pools decompressors and compressors
+// with, or the error of invalid options.
+func pools(quality int) (func() connect.Decompressor, func() connect.Compressor, error) {
+	options := cbrotli.WriterOptions{Quality: quality}
+	if _, err := cbrotli.Encode(nil, options); err != nil {
+		return nil, nil, err
+	}
+	return func() connect.Decompressor { return newDecompressor() },
+		func() connect.Compressor { return &compressor{options: options} }, nil
+}
+
+// compressor implements connect.Compressor. connect closes it after each
+// message, then calls Reset before it is used again, twice when it goes
+// through the pool (once with io.Discard), so that the Writer is reset when
+// it is written to.
+type compressor struct {
+	options cbrotli.WriterOptions
+	w       *cbrotli.Writer
+	dst     io.Writer
+	pending bool // the Writer must be reset to dst
+	err     error
+}
+
+func (c *compressor) Reset(dst io.Writer) {
+	c.dst = dst
+	c.pending = true
+}
+
+func (c *compressor) start() error {
+	if !c.pending {
+		return c.err
+	}
+	c.pending = false
+	switch {
+	case c.w == nil:
+		c.w = cbrotli.NewWriter(c.dst, c.options)
+		c.err = nil
+	default:
+		// A closed Writer can be reset.
+		c.err = c.w.ResetOptions(c.dst, c.options)
+	}
+	return c.err
+}
+
+func (c *compressor) Write(p []byte) (int, error) {
+	if err := c.start(); err != nil {
+		return 0, err
+	}
+	return c.w.Write(p)
+}
+
+// Close completes the stream, even for an empty message; the native encoder
+// is freed until the next message.
+func (c *compressor) Close() error {
+	if err := c.start(); err != nil {
+		return err
+	}
+	return c.w.Close()
+}
+
+// decompressor implements connect.Decompressor. connect closes it after each
+// message and resets it before it is used again, so Close keeps the Reader;
+// its decoder is freed when the pool drops the decompressor.
+type decompressor struct {
+	r   *cbrotli.Reader
+	src countingReader
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func newDecompressor() *decompressor {
+	d := &decompressor{src: countingReader{r: eofReader{}}}
+	d.r = cbrotli.NewReader(&d.src)
+	runtime.SetFinalizer(d, func(d *decompressor) { d.r.Close() })
+	return d
+}
+
+// eofReader is the source of decompressors that have not been reset.
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) { return 0, io.EOF }
+
+func (d *decompressor) Reset(src io.Reader) error {
+	d.src = countingReader{r: src}
+	return d.r.Reset(&d.src)
+}
+
+// Read decodes the message. An empty payload is an empty message; connect
+// reports other errors with connect.CodeInvalidArgument, and messages larger
+// than the limit set with connect.WithReadMaxBytes with
+// connect.CodeResourceExhausted.
+func (d *decompressor) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	if errors.Is(err, cbrotli.ErrTruncated) && d.src.n == 0 {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (d *decompressor) Close() error { return nil }
@@ -0,0 +1,143 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package connectbrotli_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/brotli/go/cbrotli/connectbrotli"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const echoProcedure = "/connectbrotli.test.EchoService/Echo"
+
+// withCompression and the client options fail the test on an error.
+func withCompression(t *testing.T) connect.HandlerOption {
+	option, err := connectbrotli.WithCompression(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return option
+}
+
+func withSendCompression(t *testing.T) connect.ClientOption {
+	option, err := connectbrotli.WithSendCompression(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return option
+}
+
+func withAcceptCompression(t *testing.T) connect.ClientOption {
+	option, err := connectbrotli.WithAcceptCompression(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return option
+}
+
+func newEchoServer(t *testing.T, options ...connect.HandlerOption) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(echoProcedure, connect.NewUnaryHandler(echoProcedure,
+		func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			resp := connect.NewResponse(wrapperspb.String(req.Msg.Value))
+			// The gRPC protocols name the encoding of messages in Grpc-Encoding.
+			encoding := req.Header().Get("Content-Encoding")
+			if encoding == "" {
+				encoding = req.Header().Get("Grpc-Encoding")
+			}
+			resp.Header().Set("Request-Encoding", encoding)
+			return resp, nil
+		}, options...))
+	// The gRPC protocol requires HTTP/2.
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRoundTrip(t *testing.T) {
+	server := newEchoServer(t, withCompression(t))
+	for _, protocol := range []connect.ClientOption{connect.WithGRPC(), connect.WithGRPCWeb(), connect.WithClientOptions()} {
+		client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+			server.Client(), server.URL+echoProcedure, withSendCompression(t), protocol)
+		// Several messages reuse pooled compressors and decompressors.
+		for _, value := range []string{strings.Repeat("brotli ", 10000), "", "short", strings.Repeat("again ", 5000)} {
+			resp, err := client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(value)))
+			if err != nil {
+				t.Fatalf("CallUnary(%d bytes): %v", len(value), err)
+			}
+			if resp.Msg.Value != value {
+				t.Errorf("CallUnary(%d bytes): got %d bytes", len(value), len(resp.Msg.Value))
+			}
+			// Requests with content are sent compressed.
+			if encoding := resp.Header().Get("Request-Encoding"); value != "" && encoding != "br" {
+				t.Errorf("CallUnary(%d bytes): request encoding %q", len(value), encoding)
+			}
+		}
+	}
+
+	// Clients that only accept "br" get compressed responses.
+	var encoding string
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := server.Client().Transport.RoundTrip(req)
+		if err == nil {
+			encoding = resp.Header.Get("Content-Encoding")
+		}
+		return resp, err
+	})}
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		httpClient, server.URL+echoProcedure, withAcceptCompression(t))
+	value := strings.Repeat("response ", 1000)
+	resp, err := client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(value)))
+	if err != nil || resp.Msg.Value != value {
+		t.Fatalf("CallUnary: %v", err)
+	}
+	if encoding != "br" {
+		t.Errorf("response Content-Encoding %q", encoding)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestErrors(t *testing.T) {
+	server := newEchoServer(t, withCompression(t), connect.WithReadMaxBytes(1000))
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		server.Client(), server.URL+echoProcedure, withSendCompression(t))
+	// Compressed well below the limit, but larger once decoded.
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(strings.Repeat("x", 10000))))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Errorf("oversized message: %v, want %v", err, connect.CodeResourceExhausted)
+	}
+
+	// Corrupt payloads are invalid arguments.
+	req, _ := http.NewRequest("POST", server.URL+echoProcedure, strings.NewReader("not brotli"))
+	req.Header.Set("Content-Type", "application/proto")
+	req.Header.Set("Content-Encoding", "br")
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("corrupt payload: status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	if _, err := connectbrotli.WithCompression(12); err == nil {
+		t.Error("WithCompression accepted an invalid quality")
+	}
+	if _, err := connectbrotli.WithSendCompression(-1); err == nil {
+		t.Error("WithSendCompression accepted an invalid quality")
+	}
+}
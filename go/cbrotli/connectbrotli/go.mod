@@ -0,0 +1,13 @@
+module github.com/google/brotli/go/cbrotli/connectbrotli
+
+go 1.21
+
+require (
+	connectrpc.com/connect v1.16.2
+	github.com/google/brotli/go/cbrotli v0.1.0
+	google.golang.org/protobuf v1.34.1
+)
+
+// Builds in this repository use the cbrotli next to it; replace has no
+// effect on importers, which get the version required above.
+replace github.com/google/brotli/go/cbrotli => ../
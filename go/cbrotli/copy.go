@@ -0,0 +1,108 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// CompressCopy compresses src until io.EOF and writes the Brotli stream to
+// dst, like io.Copy through a Writer; written is the number of compressed
+// bytes written to dst. ctx is checked before each read of src, so that a
+// canceled copy stops between chunks with ctx.Err(); a read or write blocked
+// by then is not interrupted.
+//
+// The Writer is released on every path. On error the stream is left
+// unterminated, so that dst does not hold a valid stream of a part of src.
+func CompressCopy(ctx context.Context, dst io.Writer, src io.Reader, options WriterOptions) (written int64, err error) {
+	_, written, err = EncodeCopy(ctx, dst, src, options)
+	return written, err
+}
+
+// EncodeCopy is like CompressCopy, but also returns the number of bytes read
+// from src. The Writer comes from a pool, to which it returns once closed.
+func EncodeCopy(ctx context.Context, dst io.Writer, src io.Reader, options WriterOptions) (read, written int64, err error) {
+	out := &copyOutput{w: dst}
+	w := getCopyWriter(out, options)
+	in := &contextReader{ctx: ctx, r: src}
+	defer func() {
+		if err != nil {
+			out.discard = true
+		}
+		closeErr := w.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if closeErr == nil {
+			copyWriterPool.Put(w)
+		}
+		read, written = in.n, out.n
+	}()
+	_, err = w.ReadFrom(in)
+	return 0, 0, err
+}
+
+// copyWriterPool keeps the Writers of EncodeCopy.
+var copyWriterPool sync.Pool // *Writer
+
+// getCopyWriter returns a Writer to dst from copyWriterPool.
+func getCopyWriter(dst io.Writer, options WriterOptions) *Writer {
+	if w, ok := copyWriterPool.Get().(*Writer); ok {
+		count(&metrics.poolHits, 1)
+		// Like NewWriter, the Writer reports invalid options.
+		w.ResetOptions(dst, options)
+		return w
+	}
+	count(&metrics.poolMisses, 1)
+	return NewWriter(dst, options)
+}
+
+// DecompressCopy decodes the Brotli stream of src and writes the content to
+// dst, like io.Copy from a Reader; written is the number of decoded bytes
+// written to dst. dst reads the content itself if it implements
+// io.ReaderFrom. ctx is checked before each read of the content, as by
+// CompressCopy. The Reader is released on every path.
+func DecompressCopy(ctx context.Context, dst io.Writer, src io.Reader, options ReaderOptions) (written int64, err error) {
+	r := NewReaderWithOptions(src, options)
+	defer r.Close()
+	return io.Copy(dst, &contextReader{ctx: ctx, r: r})
+}
+
+// contextReader counts the bytes read from r, and fails with the error of ctx
+// once it is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+	n   int64
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// copyOutput counts the bytes written to w, and drops them once discard is
+// set.
+type copyOutput struct {
+	w       io.Writer
+	n       int64
+	discard bool
+}
+
+func (c *copyOutput) Write(p []byte) (int, error) {
+	if c.discard {
+		return len(p), nil
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Compression Dictionary Transport (RFC 9842): a "dcb" response body is a
+// header made of dcbMagic and the DictionaryID of the dictionary, followed by
+// a Brotli stream compressed with that raw dictionary.
+const (
+	dcbMagic      = "\xffDCB"
+	dcbHeaderSize = len(dcbMagic) + 32
+)
+
+var errDCBHeader = errors.New("cbrotli: invalid dcb header")
+
+// NewDictionaryHandler returns a handler that compresses the responses of h
+// according to the request headers:
+//
+//   - with Content-Encoding "dcb", if the client accepts it and advertises
+//     (in Available-Dictionary) the ID of one of dictionaries;
+//   - with Content-Encoding "br", if the client accepts it;
+//   - not at all otherwise.
+//
+// Responses that already have a Content-Encoding are passed through. The Vary
+// header lists Accept-Encoding and Available-Dictionary in all cases, as
+// caches must not mix the variants.
+//
+// The dictionaries are raw ones; they must not be closed while the handler is
+// in use.
+func NewDictionaryHandler(h http.Handler, options WriterOptions, dictionaries ...*PreparedDictionary) http.Handler {
+	byID := make(map[[32]byte]*PreparedDictionary, len(dictionaries))
+	for _, d := range dictionaries {
+		byID[d.ID()] = d
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Add("Vary", "Accept-Encoding, Available-Dictionary")
+		accept := req.Header.Get("Accept-Encoding")
+		var dictionary *PreparedDictionary
+		if acceptsEncoding(accept, "dcb") {
+			if id, err := ParseDictionaryID(req.Header.Get("Available-Dictionary")); err == nil {
+				dictionary = byID[id]
+			}
+		}
+		if dictionary == nil && !acceptsEncoding(accept, "br") {
+			h.ServeHTTP(rw, req)
+			return
+		}
+		cw := &compressingResponseWriter{
+			ResponseWriter: rw,
+			options:        options,
+			dictionary:     dictionary,
+		}
+		defer cw.close()
+		h.ServeHTTP(cw, req)
+	})
+}
+
+// compressingResponseWriter decides on the first write whether the response
+// is compressed: responses without a body or with their own Content-Encoding
+// are not.
+type compressingResponseWriter struct {
+	http.ResponseWriter
+	options     WriterOptions
+	dictionary  *PreparedDictionary // nil for plain "br"
+	wroteHeader bool
+	w           *Writer // nil if the response is not compressed
+}
+
+func (cw *compressingResponseWriter) WriteHeader(status int) {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+	header := cw.Header()
+	if header.Get("Content-Encoding") == "" && bodyAllowed(status) {
+		options := cw.options
+		// The client decodes with the advertised dictionary or none.
+		options.SelectDictionary = nil
+		header.Del("Content-Length")
+		if cw.dictionary != nil {
+			header.Set("Content-Encoding", "dcb")
+			options.Dictionary = cw.dictionary
+		} else {
+			header.Set("Content-Encoding", "br")
+		}
+		cw.w = NewWriter(cw.ResponseWriter, options)
+	}
+	cw.ResponseWriter.WriteHeader(status)
+	if cw.w != nil && cw.dictionary != nil {
+		id := cw.dictionary.ID()
+		cw.ResponseWriter.Write(append([]byte(dcbMagic), id[:]...))
+	}
+}
+
+func (cw *compressingResponseWriter) Write(p []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.w == nil {
+		return cw.ResponseWriter.Write(p)
+	}
+	return cw.w.Write(p)
+}
+
+// Flush implements http.Flusher; the encoder is flushed first, so that the
+// client can decode all data written so far.
+func (cw *compressingResponseWriter) Flush() {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.w != nil {
+		cw.w.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped ResponseWriter, for http.ResponseController.
+func (cw *compressingResponseWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func (cw *compressingResponseWriter) close() {
+	if cw.w != nil {
+		cw.w.Close()
+	}
+}
+
+func bodyAllowed(status int) bool {
+	return status >= 200 && status != http.StatusNoContent && status != http.StatusNotModified
+}
+
+// acceptsEncoding reports whether an Accept-Encoding header value lists coding
+// with a non-zero weight.
+func acceptsEncoding(header, coding string) bool {
+	for _, item := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(item, ";")
+		name = strings.TrimSpace(name)
+		if !strings.EqualFold(name, coding) && name != "*" {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// DictionaryTransport is an http.RoundTripper that requests Brotli-compressed
+// responses, advertising a dictionary for Compression Dictionary Transport
+// when one is available, and decodes them transparently. Decoded responses
+// have no Content-Encoding and Content-Length, and Uncompressed set.
+//
+// Requests that set Accept-Encoding themselves are passed through unchanged,
+// and so are responses without a body (to HEAD requests, 204 and 304).
+type DictionaryTransport struct {
+	// Base performs the requests; nil means http.DefaultTransport.
+	Base http.RoundTripper
+	// Dictionary returns the raw dictionary to advertise for a request (e.g.
+	// one received earlier with Use-As-Dictionary), or nil; nil Dictionary
+	// never advertises one. The dictionary must not be closed while responses
+	// decoded with it are read.
+	Dictionary func(req *http.Request) *DecoderDictionary
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DictionaryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Header.Get("Accept-Encoding") != "" {
+		return base.RoundTrip(req)
+	}
+	var dictionary *DecoderDictionary
+	if t.Dictionary != nil {
+		dictionary = t.Dictionary(req)
+	}
+	req = req.Clone(req.Context())
+	if dictionary != nil {
+		req.Header.Set("Accept-Encoding", "dcb, br")
+		req.Header.Set("Available-Dictionary", FormatDictionaryID(dictionary.ID()))
+	} else {
+		req.Header.Set("Accept-Encoding", "br")
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Method == http.MethodHead || !bodyAllowed(resp.StatusCode) {
+		// The coding applies to a body that is not sent.
+		return resp, nil
+	}
+	var r *Reader
+	switch encoding := resp.Header.Get("Content-Encoding"); {
+	case encoding == "br":
+		r = NewReader(resp.Body)
+	case encoding == "dcb" && dictionary != nil:
+		header := make([]byte, dcbHeaderSize)
+		if _, err := io.ReadFull(resp.Body, header); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		id := dictionary.ID()
+		if !bytes.Equal(header, append([]byte(dcbMagic), id[:]...)) {
+			resp.Body.Close()
+			return nil, errDCBHeader
+		}
+		r = NewReaderWithDecoderDictionary(resp.Body, dictionary)
+	default:
+		return resp, nil
+	}
+	resp.Body = &decodingBody{r: r, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// decodingBody closes both the Reader and the response body it decodes.
+type decodingBody struct {
+	r    *Reader
+	body io.ReadCloser
+}
+
+func (b *decodingBody) Read(p []byte) (int, error) { return b.r.Read(p) }
+
+func (b *decodingBody) Close() error {
+	b.r.Close()
+	return b.body.Close()
+}
@@ -0,0 +1,423 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// ReaderOptions configures Reader.
+type ReaderOptions struct {
+	// RawDictionary is a raw (LZ77 prefix) shared dictionary.
+	RawDictionary []byte
+	// Dictionary is a raw or serialized shared dictionary shared by many
+	// Readers; it takes precedence over RawDictionary.
+	Dictionary *DecoderDictionary
+	// Dictionaries are attached after Dictionary or RawDictionary, in order;
+	// they must match those given to NewWriterWithDictionaries. Their data
+	// must not be modified until the Reader is closed.
+	Dictionaries []Dictionary
+	// ResolveDictionary, if not nil, returns the dictionary for the id set by
+	// Reader.SetDictionaryID (e.g. read from an application-level header). It
+	// is called at most once, by the first Read, and not at all if no id is
+	// set; its error is returned by Read. The dictionary replaces Dictionary
+	// and RawDictionary, and is released on Close.
+	ResolveDictionary func(id string) (*DecoderDictionary, error)
+	// Multistream makes the Reader decode a sequence of concatenated Brotli
+	// streams (e.g. produced by JoinStreams) as a single one; otherwise data
+	// after the end of the first stream is an error.
+	Multistream bool
+	// Progress, if not nil, is called by Read with the number of compressed
+	// bytes consumed by the decoder and of decompressed bytes produced so far.
+	// It is called before decoding, once the counters have advanced by
+	// progressInterval bytes since the previous call, and when Read returns an
+	// error (e.g. io.EOF), so the last call reports the final counters. A panic
+	// in Progress propagates to the caller of Read; as it happens before any
+	// output is produced, or along with an error, the Reader stays usable.
+	Progress func(compressedConsumed, decompressedProduced int64)
+	// CompressedSize is the expected size of the compressed stream, if known;
+	// it is only used by Reader.Fraction.
+	CompressedSize int64
+	// OnBlockBoundary, if not nil, is called by Read for each meta-block of
+	// the input, in order, once the decoder has passed its end:
+	// compressedOffset and decompressedOffset are the offsets of the first
+	// compressed byte holding no bit of the meta-block (the byte holding its
+	// last bit may also start the next one) and of the first decompressed byte
+	// after it, counted like the values passed to Progress, over all streams
+	// in Multistream mode. Like Progress, it is called before decoding and
+	// when Read returns an error, so a meta-block is reported by the Read that
+	// follows the one returning its last byte at the latest, and all of them
+	// by the Read returning io.EOF.
+	//
+	// The C decoder does not expose meta-blocks, so the Reader decodes the
+	// input a second time in Go, in a goroutine, to find them; this is much
+	// slower than decoding in C. Serialized dictionaries are not supported:
+	// with them, Read fails. If the Go decoder fails, e.g. on corrupt input,
+	// later meta-blocks are not reported.
+	OnBlockBoundary func(compressedOffset, decompressedOffset int64, meta BlockInfo)
+	// MaxDecodedSize, if positive, limits the decoded content (of all
+	// streams in Multistream mode): Read returns its first MaxDecodedSize
+	// bytes, and then fails with ErrDecodedTooLarge if there are more;
+	// the error is sticky until Reset. This protects against decompression
+	// bombs.
+	MaxDecodedSize int64
+	// ExpectedSize, if positive, is the exact size of the decoded content (of
+	// all streams in Multistream mode), e.g. recorded in object metadata: if
+	// the stream ends having produced a different amount, Read fails with a
+	// SizeMismatchError instead of io.EOF, and it fails as soon as the
+	// content would exceed ExpectedSize, after returning its first
+	// ExpectedSize bytes. The error is sticky until Reset. If MaxDecodedSize
+	// is also set, the smaller limit applies: content exceeding ExpectedSize
+	// fails with a SizeMismatchError, unless it exceeds a smaller
+	// MaxDecodedSize first, which fails with ErrDecodedTooLarge.
+	ExpectedSize int64
+	// IgnoreTrailingZeros makes the Reader accept zero bytes after the end of
+	// the stream (of the last one in Multistream mode), such as the padding
+	// of storage systems that round objects up to a block size: if all the
+	// input left up to the end of src is zero, the stream ends cleanly. Other
+	// trailing data is still an error.
+	IgnoreTrailingZeros bool
+	// MaxTrailingZeros limits the zero bytes accepted by IgnoreTrailingZeros;
+	// longer padding is an error. If not positive, the limit is 1MiB.
+	MaxTrailingZeros int64
+	// FillBuffer makes Read decode until p is full, reading src as needed,
+	// rather than return the content decoded from the input at hand, unless
+	// the stream ends or an error occurs; consumers with large buffers then
+	// make fewer calls. Streaming consumers that want each part of the
+	// content as soon as it is decodable should leave it unset.
+	FillBuffer bool
+	// PrefetchBuffers, if positive, makes the Reader read src in a background
+	// goroutine, up to PrefetchBuffers reads ahead of the decoder, so that
+	// reading a slow source (e.g. a network stream) overlaps with decoding.
+	// The data and the errors of src are delivered as without prefetching.
+	// The goroutine is started by the first Read and stops after an error of
+	// src (or io.EOF), or on Close or Reset; a read blocked by then is not
+	// interrupted, and its result is dropped.
+	PrefetchBuffers int
+	// PrefetchBufferSize is the size of each buffer of PrefetchBuffers, i.e.
+	// the size of the reads of src; 0 means 32KiB.
+	PrefetchBufferSize int
+	// TeeCompressed, if not nil, receives the compressed input, e.g. to
+	// forward it unchanged while inspecting the content: each read of src is
+	// written to it in full before it is decoded, so that it always holds
+	// exactly the input read from src, i.e. the input consumed by the decoder
+	// and the input buffered for it, which Close discards. If it fails, Read
+	// returns its error, wrapped, from then on; the data it failed on is not
+	// decoded.
+	TeeCompressed io.Writer
+}
+
+var errBlockBoundaryDictionary = errors.New("cbrotli: ReaderOptions.OnBlockBoundary does not support serialized dictionaries")
+
+// progressInterval is the minimal advance of the counters between calls to
+// ReaderOptions.Progress and WriterOptions.Progress.
+const progressInterval = 64 * 1024
+
+// readBufSize is a "good" buffer size that avoids excessive round-trips
+// between C and Go but doesn't waste too much memory on buffering.
+// It is arbitrarily chosen to be equal to the constant used in io.Copy.
+const readBufSize = 32 * 1024
+
+// maxEmptyReads is the number of consecutive reads returning no data and no
+// error after which src is failed with io.ErrNoProgress, as by bufio.Reader.
+const maxEmptyReads = 100
+
+// defaultMaxTrailingZeros is the limit of ReaderOptions.MaxTrailingZeros if
+// it is not set.
+const defaultMaxTrailingZeros = 1 << 20
+
+// NewReader initializes new Reader instance.
+// Close MUST be called to free resources.
+func NewReader(src io.Reader) *Reader {
+	return NewReaderWithRawDictionary(src, nil)
+}
+
+// NewReaderWithRawDictionary initializes new Reader instance with shared dictionary.
+// Close MUST be called to free resources.
+func NewReaderWithRawDictionary(src io.Reader, dictionary []byte) *Reader {
+	return NewReaderWithOptions(src, ReaderOptions{RawDictionary: dictionary})
+}
+
+// NewReaderWithDecoderDictionary initializes new Reader instance with shared
+// dictionary. The dictionary must not be closed before the Reader is.
+// Close MUST be called to free resources.
+func NewReaderWithDecoderDictionary(src io.Reader, dictionary *DecoderDictionary) *Reader {
+	return NewReaderWithOptions(src, ReaderOptions{Dictionary: dictionary})
+}
+
+// SetDictionaryID sets the id of the dictionary the stream is compressed with,
+// to be resolved with ReaderOptions.ResolveDictionary. It must be called before
+// the first Read.
+func (r *Reader) SetDictionaryID(id string) error {
+	if r.state == nil {
+		return errReaderClosed
+	}
+	if r.started {
+		return errReaderStarted
+	}
+	if r.options.ResolveDictionary == nil {
+		return errors.New("cbrotli: ReaderOptions.ResolveDictionary is not set")
+	}
+	r.id = id
+	return nil
+}
+
+// validate checks the sizes of the dictionaries given by content.
+func (options *ReaderOptions) validate() error {
+	if options.Dictionary == nil && options.RawDictionary != nil {
+		if err := checkDictionarySize(len(options.RawDictionary), DtRaw); err != nil {
+			return err
+		}
+	}
+	if options.PrefetchBuffers < 0 {
+		return fmt.Errorf("cbrotli: negative prefetch buffer count %d", options.PrefetchBuffers)
+	}
+	if options.PrefetchBufferSize < 0 {
+		return fmt.Errorf("cbrotli: negative prefetch buffer size %d", options.PrefetchBufferSize)
+	}
+	for i, d := range options.Dictionaries {
+		if err := checkDictionarySize(len(d.Data), d.Type); err != nil {
+			return fmt.Errorf("cbrotli: dictionary %d: %w", i, err)
+		}
+	}
+	existing := 0
+	if d := options.Dictionary; d != nil {
+		existing = d.prefixes
+	} else if options.RawDictionary != nil {
+		existing = 1
+	}
+	return checkDictionaryCount(existing, options.Dictionaries)
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	if r.options.Progress != nil && r.state != nil {
+		r.reportProgress(false)
+	}
+	if r.blocks != nil {
+		r.reportBlocks()
+	}
+	n, err = r.read(p)
+	for r.options.FillBuffer && err == nil && n > 0 && n < len(p) {
+		m, readErr := r.read(p[n:])
+		if m == 0 && readErr == nil {
+			// src returned nothing; do not spin on it.
+			break
+		}
+		n += m
+		if readErr != io.ErrShortBuffer {
+			err = readErr
+		}
+	}
+	if expected := r.options.ExpectedSize; err == io.EOF && expected > 0 && r.produced != expected {
+		r.err = SizeMismatchError{Expected: expected, Actual: r.produced}
+		err = r.err
+	}
+	if err != nil && r.state != nil {
+		if !r.failed && err != io.EOF && err != io.ErrShortBuffer {
+			r.failed = true
+			r.countError(err)
+		}
+		if r.options.Progress != nil {
+			r.reportProgress(true)
+		}
+		if r.blocks != nil {
+			r.reportBlocks()
+		}
+	}
+	return n, err
+}
+
+// teeInput writes p, just read from src, to options.TeeCompressed; writes that
+// take a part of p are retried with the rest. Its failure is recorded in
+// r.srcErr.
+func (r *Reader) teeInput(p []byte) error {
+	for len(p) > 0 {
+		m, err := r.options.TeeCompressed.Write(p)
+		if err == nil && (m <= 0 || m > len(p)) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			r.srcErr = fmt.Errorf("cbrotli: writing TeeCompressed: %w", err)
+			return r.srcErr
+		}
+		p = p[m:]
+	}
+	return nil
+}
+
+// outputLimit returns the decoded size past which Read fails, for
+// MaxDecodedSize and ExpectedSize, or 0 if there is no limit.
+func (r *Reader) outputLimit() int64 {
+	limit := r.options.MaxDecodedSize
+	if expected := r.options.ExpectedSize; expected > 0 && (limit <= 0 || expected <= limit) {
+		limit = expected
+	}
+	return limit
+}
+
+// excessError returns the error of content exceeding outputLimit.
+func (r *Reader) excessError() error {
+	if expected := r.options.ExpectedSize; expected > 0 && expected == r.outputLimit() {
+		return SizeMismatchError{Expected: expected, Actual: r.produced}
+	}
+	return ErrDecodedTooLarge
+}
+
+// reportBlocks calls options.OnBlockBoundary for the meta-blocks that the
+// decoder has passed in both the compressed and the decompressed data.
+func (r *Reader) reportBlocks() {
+	t := r.blocks
+	for len(t.blocks) != 0 {
+		info := t.blocks[0]
+		compressed, decompressed := info.end()
+		if decompressed > r.produced {
+			break
+		}
+		t.blocks = t.blocks[1:]
+		r.options.OnBlockBoundary(compressed, decompressed, info)
+	}
+	if r.blocksFailed {
+		return
+	}
+	select {
+	case <-t.done:
+		if t.err != nil {
+			r.blocksFailed = true
+			logEvent(slog.LevelDebug, "cbrotli: finding meta-blocks failed",
+				"error", t.err, "offset", r.consumed)
+		}
+	default:
+	}
+}
+
+// countError counts the first failure of the Reader in metrics.
+func (r *Reader) countError(err error) {
+	if !metricsEnabled.Load() {
+		return
+	}
+	var decoderErr DecoderError
+	switch {
+	case err == ErrTruncated:
+		metrics.truncatedErrors.Add(1)
+	case errors.As(err, &decoderErr):
+		metrics.decoderErrors.Add(1)
+	case err == r.srcErr:
+		metrics.sourceErrors.Add(1)
+	default:
+		metrics.otherReaderErrors.Add(1)
+	}
+}
+
+// reportProgress calls options.Progress if the counters have advanced enough,
+// or at all if final is set. It is only called when the Reader state is
+// consistent.
+func (r *Reader) reportProgress(final bool) {
+	current := [2]int64{r.consumed, r.produced}
+	if current == r.reported {
+		return
+	}
+	if !final && current[0]-r.reported[0] < progressInterval && current[1]-r.reported[1] < progressInterval {
+		return
+	}
+	r.reported = current
+	r.options.Progress(current[0], current[1])
+}
+
+// Fraction returns the fraction of ReaderOptions.CompressedSize consumed by
+// the decoder so far, or -1 if the size is not set.
+func (r *Reader) Fraction() float64 {
+	if r.options.CompressedSize <= 0 {
+		return -1
+	}
+	return float64(r.consumed) / float64(r.options.CompressedSize)
+}
+
+// skipTrailingZeros looks at the input after the end of the stream, from r.in
+// to the end of the data returned by next, for ReaderOptions.
+// IgnoreTrailingZeros. It reports whether it is zero padding; if not, r.in
+// holds the input read so far again.
+func (r *Reader) skipTrailingZeros(next func() ([]byte, error)) (bool, error) {
+	limit := r.options.MaxTrailingZeros
+	if limit <= 0 {
+		limit = defaultMaxTrailingZeros
+	}
+	var zeros int64
+	for empty := 0; ; {
+		if !allZero(r.in) || zeros+int64(len(r.in)) > limit {
+			if zeros > 0 {
+				r.in = append(make([]byte, zeros), r.in...)
+			}
+			return false, nil
+		}
+		zeros += int64(len(r.in))
+		in, err := next()
+		r.in = in
+		switch {
+		case len(in) != 0:
+			empty = 0
+		case err == io.EOF:
+			return true, nil
+		case err != nil:
+			return false, err
+		case empty == maxEmptyReads:
+			return false, fmt.Errorf("cbrotli: reading source: %w", io.ErrNoProgress)
+		default:
+			empty++
+		}
+	}
+}
+
+// allZero reports whether all bytes of p are zero.
+func allZero(p []byte) bool {
+	for _, b := range p {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Decode decodes Brotli encoded data.
+func Decode(encodedData []byte) ([]byte, error) {
+	return DecodeWithRawDictionary(encodedData, nil)
+}
+
+// DecodeReader decodes the Brotli stream read from src until io.EOF. Errors
+// of src are wrapped like those returned by Reader.
+func DecodeReader(src io.Reader) ([]byte, error) {
+	r := NewReader(src)
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// DecodeReaderWithOptions decodes the Brotli stream read from src until
+// io.EOF, like a Reader created with options.
+func DecodeReaderWithOptions(src io.Reader, options ReaderOptions) ([]byte, error) {
+	r := NewReaderWithOptions(src, options)
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// DecodeWithOptions decodes Brotli encoded data like a Reader created with
+// options.
+func DecodeWithOptions(encodedData []byte, options ReaderOptions) ([]byte, error) {
+	r := NewReaderWithOptions(bytes.NewReader(encodedData), options)
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// DecodeWithDictionaries decodes Brotli encoded data with several shared
+// dictionaries, given in the same order as to NewWriterWithDictionaries or
+// EncodeWithDictionaries.
+func DecodeWithDictionaries(encodedData []byte, dictionaries []Dictionary) ([]byte, error) {
+	r := NewReaderWithOptions(bytes.NewReader(encodedData), ReaderOptions{Dictionaries: dictionaries})
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,1687 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
+
+	"github.com/google/brotli/go/cbrotli"
+	"github.com/google/brotli/go/cbrotli/internal/conformance"
+)
+
+// The tests of this file need no encoder: they run in builds without cgo too.
+
+var decodeFixtures = []struct {
+	encoded, decoded string
+}{
+	{";", ""},
+	{"!8\x00\x04Hello, Brotli!\n\x03", "Hello, Brotli!\n"},
+	{"\xa1\xf8\x18\xc0/\x0e8\x06\xc4\xf5\xd1[8@\x1a(\xa7;", string(bytes.Repeat([]byte("abcdefgh"), 100))},
+}
+
+// fixtureDictionary is the raw dictionary of dictionaryFixture.
+const (
+	fixtureDictionary = "the dictionary is here"
+	dictionaryFixture = "\xa1h\x01\xc0/\x05\xb2S\xc4PS\a\x94\x00#"
+)
+
+// testStreams holds streams for the tests of this file that need more than
+// decodeFixtures; testStreamEncoders writes them, see cbrotli_test.go.
+//
+//go:embed testdata/streams
+var testStreams embed.FS
+
+// testStream returns the stream of testdata/streams named name.
+func testStream(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := testStreams.ReadFile("testdata/streams/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestConformance runs the tests shared with the other backends, such as
+// dynbrotli.
+func TestConformance(t *testing.T) {
+	streams, err := fs.Sub(testStreams, "testdata/streams")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conformance.Run(t, conformance.Backend{
+		Decode:                  cbrotli.Decode,
+		DecodeWithRawDictionary: cbrotli.DecodeWithRawDictionary,
+		NewReader:               func(src io.Reader) io.ReadCloser { return cbrotli.NewReader(src) },
+		Encode: func(content []byte, quality, lgwin int) ([]byte, error) {
+			return cbrotli.Encode(content, cbrotli.WriterOptions{Quality: quality, LGWin: lgwin})
+		},
+		NewWriter: func(dst io.Writer, quality, lgwin int) conformance.Writer {
+			return cbrotli.NewWriter(dst, cbrotli.WriterOptions{Quality: quality, LGWin: lgwin})
+		},
+	}, streams)
+}
+
+// readAll decodes data with a Reader reading it byte by byte.
+func readAll(data []byte, options cbrotli.ReaderOptions) ([]byte, error) {
+	r := cbrotli.NewReaderWithOptions(iotest.OneByteReader(bytes.NewReader(data)), options)
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func TestDecodeFixtures(t *testing.T) {
+	for i, f := range decodeFixtures {
+		if got, err := cbrotli.Decode([]byte(f.encoded)); err != nil || string(got) != f.decoded {
+			t.Errorf("fixture %d: Decode: %q, %v", i, got, err)
+		}
+		if got, err := readAll([]byte(f.encoded), cbrotli.ReaderOptions{}); err != nil || string(got) != f.decoded {
+			t.Errorf("fixture %d: Reader: %q, %v", i, got, err)
+		}
+		for n := 0; n < len(f.encoded); n++ {
+			if _, err := cbrotli.Decode([]byte(f.encoded[:n])); !errors.Is(err, cbrotli.ErrTruncated) {
+				t.Errorf("fixture %d: Decode of %d bytes: %v", i, n, err)
+			}
+			if _, err := readAll([]byte(f.encoded[:n]), cbrotli.ReaderOptions{}); !errors.Is(err, cbrotli.ErrTruncated) {
+				t.Errorf("fixture %d: Reader of %d bytes: %v", i, n, err)
+			}
+		}
+	}
+}
+
+func TestDecodeCorruptFixture(t *testing.T) {
+	corrupt := []byte(decodeFixtures[2].encoded)
+	corrupt[0], corrupt[1] = 0xff, 0xff
+	for name, decode := range map[string]func([]byte) ([]byte, error){
+		"Decode": cbrotli.Decode,
+		"Reader": func(data []byte) ([]byte, error) { return readAll(data, cbrotli.ReaderOptions{}) },
+	} {
+		_, err := decode(corrupt)
+		var de cbrotli.DecoderError
+		if !errors.Is(err, cbrotli.ErrCorrupt) || errors.Is(err, cbrotli.ErrTruncated) || !errors.As(err, &de) || de.Code >= 0 {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestDecodeDictionaryFixture(t *testing.T) {
+	want := fixtureDictionary + "; " + fixtureDictionary
+	if got, err := cbrotli.DecodeWithRawDictionary([]byte(dictionaryFixture), []byte(fixtureDictionary)); err != nil || string(got) != want {
+		t.Errorf("DecodeWithRawDictionary: %q, %v", got, err)
+	}
+	d, err := cbrotli.NewDecoderDictionary([]byte(fixtureDictionary))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := readAll([]byte(dictionaryFixture), cbrotli.ReaderOptions{Dictionary: d}); err != nil || string(got) != want {
+		t.Errorf("Reader: %q, %v", got, err)
+	}
+	if err := d.Close(); err != nil {
+		t.Error(err)
+	}
+	// Without the dictionary, the references beyond the window denote words of
+	// the built-in dictionary.
+	if got, err := cbrotli.Decode([]byte(dictionaryFixture)); err != nil || string(got) == want {
+		t.Errorf("Decode without the dictionary: %q, %v", got, err)
+	}
+}
+
+func TestDecodeMultipleFixtures(t *testing.T) {
+	joined := []byte(decodeFixtures[1].encoded + decodeFixtures[0].encoded + decodeFixtures[1].encoded)
+	want := decodeFixtures[1].decoded + decodeFixtures[1].decoded
+	if got, err := readAll(joined, cbrotli.ReaderOptions{Multistream: true}); err != nil || string(got) != want {
+		t.Errorf("Multistream: %q, %v", got, err)
+	}
+	if _, err := readAll(joined, cbrotli.ReaderOptions{}); err == nil {
+		t.Error("Reader accepted input after the end of the stream")
+	}
+	if _, err := cbrotli.Decode(joined); err == nil {
+		t.Error("Decode accepted input after the end of the stream")
+	}
+	truncated := joined[:len(joined)-1]
+	if _, err := readAll(truncated, cbrotli.ReaderOptions{Multistream: true}); !errors.Is(err, cbrotli.ErrTruncated) {
+		t.Errorf("truncated second stream: %v", err)
+	}
+}
+
+// framedGoldenFile is written by framedGolden, see cbrotli_test.go.
+//
+//go:embed testdata/frame/golden-v1.brc
+var framedGoldenFile []byte
+
+func TestVerifyFileFixture(t *testing.T) {
+	if err := cbrotli.VerifyFile(bytes.NewReader(framedGoldenFile)); err != nil {
+		t.Error(err)
+	}
+	file := bytes.Clone(framedGoldenFile)
+	file[len(file)/2] ^= 0x10
+	var fe *cbrotli.FrameError
+	if err := cbrotli.VerifyFile(bytes.NewReader(file)); !errors.As(err, &fe) || fe.Chunk < 0 {
+		t.Errorf("corrupt file: %v", err)
+	}
+}
+
+func TestDecodeTrailingZeros(t *testing.T) {
+	f := decodeFixtures[2]
+	padded := func(zeros int, tail string) []byte {
+		return append(append([]byte(f.encoded), make([]byte, zeros)...), tail...)
+	}
+	ignore := cbrotli.ReaderOptions{IgnoreTrailingZeros: true}
+	for _, c := range []struct {
+		name    string
+		data    []byte
+		options cbrotli.ReaderOptions
+		ok      bool
+	}{
+		{"none", padded(0, ""), ignore, true},
+		{"block", padded(4096-len(f.encoded), ""), ignore, true},
+		// The padding spans several refills of the buffer of the Reader.
+		{"long", padded(100000, ""), ignore, true},
+		{"not ignored", padded(10, ""), cbrotli.ReaderOptions{}, false},
+		{"non-zero", padded(100000, "\x01"), ignore, false},
+		{"at limit", padded(50000, ""), cbrotli.ReaderOptions{IgnoreTrailingZeros: true, MaxTrailingZeros: 50000}, true},
+		{"over limit", padded(50001, ""), cbrotli.ReaderOptions{IgnoreTrailingZeros: true, MaxTrailingZeros: 50000}, false},
+		{"default limit", padded(1<<20+1, ""), ignore, false},
+		{"multistream", append(padded(0, f.encoded), make([]byte, 70000)...), cbrotli.ReaderOptions{IgnoreTrailingZeros: true, Multistream: true}, true},
+	} {
+		want := f.decoded
+		if c.name == "multistream" {
+			want += f.decoded
+		}
+		got, err := cbrotli.DecodeWithOptions(c.data, c.options)
+		if c.ok && (err != nil || string(got) != want) {
+			t.Errorf("%s: DecodeWithOptions: %d bytes, %v", c.name, len(got), err)
+		}
+		if !c.ok && (err == nil || !strings.Contains(err.Error(), "excessive input")) {
+			t.Errorf("%s: DecodeWithOptions: %v, want excessive input", c.name, err)
+		}
+		if len(c.data) > 200000 {
+			continue
+		}
+		got, err = readAll(c.data, c.options)
+		if c.ok && (err != nil || string(got) != want) {
+			t.Errorf("%s: Reader: %d bytes, %v", c.name, len(got), err)
+		}
+		if !c.ok && (err == nil || !strings.Contains(err.Error(), "excessive input")) {
+			t.Errorf("%s: Reader: %v, want excessive input", c.name, err)
+		}
+	}
+
+	// Zeros followed by data are the next stream in Multistream mode.
+	data := append(padded(3, ""), f.encoded...)
+	if _, err := cbrotli.DecodeWithOptions(data, cbrotli.ReaderOptions{IgnoreTrailingZeros: true, Multistream: true}); err == nil {
+		t.Errorf("Multistream with zeros before a stream: no error")
+	}
+}
+
+// repeatReader returns b forever.
+type repeatReader byte
+
+func (b repeatReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(b)
+	}
+	return len(p), nil
+}
+
+func TestDecodePrefetch(t *testing.T) {
+	prefetch := cbrotli.ReaderOptions{PrefetchBuffers: 2, PrefetchBufferSize: 3}
+	for i, f := range decodeFixtures {
+		if got, err := readAll([]byte(f.encoded), prefetch); err != nil || string(got) != f.decoded {
+			t.Errorf("fixture %d: %q, %v", i, got, err)
+		}
+	}
+	joined := []byte(decodeFixtures[1].encoded + decodeFixtures[2].encoded)
+	options := cbrotli.ReaderOptions{Multistream: true, PrefetchBuffers: 4}
+	if got, err := readAll(joined, options); err != nil || string(got) != decodeFixtures[1].decoded+decodeFixtures[2].decoded {
+		t.Errorf("Multistream: %q, %v", got, err)
+	}
+
+	// The errors of src are delivered as without prefetching.
+	errSrc := errors.New("source failure")
+	decodeFailing := func(options cbrotli.ReaderOptions) ([]byte, error) {
+		src := io.MultiReader(bytes.NewReader(joined[:len(joined)-5]), iotest.ErrReader(errSrc))
+		r := cbrotli.NewReaderWithOptions(iotest.HalfReader(src), options)
+		defer r.Close()
+		return io.ReadAll(r)
+	}
+	want, wantErr := decodeFailing(cbrotli.ReaderOptions{Multistream: true})
+	for _, options := range []cbrotli.ReaderOptions{options, {Multistream: true, PrefetchBuffers: 1, PrefetchBufferSize: 7}} {
+		got, err := decodeFailing(options)
+		if !errors.Is(err, errSrc) || err.Error() != wantErr.Error() || !bytes.Equal(got, want) {
+			t.Errorf("%d buffers of %d bytes: %d bytes, %v; want %d bytes, %v",
+				options.PrefetchBuffers, options.PrefetchBufferSize, len(got), err, len(want), wantErr)
+		}
+	}
+
+	// The goroutine stops on Close, here after a decoding error, and at the
+	// end of src.
+	before := runtime.NumGoroutine()
+	r := cbrotli.NewReaderWithOptions(repeatReader(0xff), options)
+	if _, err := io.ReadAll(r); !errors.Is(err, cbrotli.ErrCorrupt) {
+		t.Errorf("corrupt source: %v", err)
+	}
+	r.Close()
+	if _, err := readAll(joined, options); err != nil {
+		t.Error(err)
+	}
+	for i := 0; runtime.NumGoroutine() > before; i++ {
+		if i == 100 {
+			t.Fatalf("%d goroutines after Close, %d before", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := cbrotli.DecodeWithOptions(joined, cbrotli.ReaderOptions{PrefetchBuffers: -1}); err == nil {
+		t.Error("negative PrefetchBuffers: no error")
+	}
+}
+
+func TestDecodeFillBuffer(t *testing.T) {
+	f := decodeFixtures[2]
+	joined := []byte(f.encoded + f.encoded)
+	for _, fill := range []bool{false, true} {
+		r := cbrotli.NewReaderWithOptions(iotest.OneByteReader(bytes.NewReader(joined)),
+			cbrotli.ReaderOptions{Multistream: true, FillBuffer: fill})
+		var got []byte
+		buf := make([]byte, 300)
+		short := 0
+		for {
+			n, err := r.Read(buf)
+			got = append(got, buf[:n]...)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("FillBuffer %v: %v", fill, err)
+			}
+			if n < len(buf) {
+				short++
+			}
+		}
+		r.Close()
+		if string(got) != f.decoded+f.decoded {
+			t.Errorf("FillBuffer %v: %d bytes decoded", fill, len(got))
+		}
+		// The content ends with a short read at most.
+		if fill && short > 1 || !fill && short == 0 {
+			t.Errorf("FillBuffer %v: %d short reads", fill, short)
+		}
+	}
+
+	// Errors are returned with the content decoded before them.
+	truncated := joined[:len(joined)-3]
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader(truncated), cbrotli.ReaderOptions{Multistream: true, FillBuffer: true})
+	defer r.Close()
+	want, wantErr := readAll(truncated, cbrotli.ReaderOptions{Multistream: true})
+	got, err := io.ReadAll(r)
+	if !errors.Is(err, cbrotli.ErrTruncated) || err != wantErr || !bytes.Equal(got, want) {
+		t.Errorf("truncated: %d bytes, %v; want %d bytes, %v", len(got), err, len(want), wantErr)
+	}
+}
+
+func TestDecodeExpectedSize(t *testing.T) {
+	f := decodeFixtures[2]
+	size := int64(len(f.decoded))
+	check := func(name string, got []byte, err error, wantErr error, wantLen int64) {
+		t.Helper()
+		if wantErr == nil {
+			if err != nil || string(got) != f.decoded {
+				t.Errorf("%s: %d bytes, %v; want the content", name, len(got), err)
+			}
+			return
+		}
+		if err != wantErr || string(got) != f.decoded[:wantLen] {
+			t.Errorf("%s: %d bytes, %v; want %d bytes, %v", name, len(got), err, wantLen, wantErr)
+		}
+	}
+	for _, test := range []struct {
+		name            string
+		expected, limit int64
+		wantErr         error
+		wantLen         int64
+	}{
+		{"exact", size, 0, nil, 0},
+		{"short", size + 1, 0, cbrotli.SizeMismatchError{Expected: size + 1, Actual: size}, size},
+		// Decoding stops at the first byte too many.
+		{"long", size - 10, 0, cbrotli.SizeMismatchError{Expected: size - 10, Actual: size - 9}, size - 10},
+		// The smaller limit applies; ExpectedSize wins a tie.
+		{"exact with limit", size, size, nil, 0},
+		{"long under limit", 100, 200, cbrotli.SizeMismatchError{Expected: 100, Actual: 101}, 100},
+		{"long at limit", 100, 100, cbrotli.SizeMismatchError{Expected: 100, Actual: 101}, 100},
+		{"long over limit", 200, 100, cbrotli.ErrDecodedTooLarge, 100},
+		{"short over limit", size + 1, 100, cbrotli.ErrDecodedTooLarge, 100},
+	} {
+		options := cbrotli.ReaderOptions{ExpectedSize: test.expected, MaxDecodedSize: test.limit}
+		got, err := readAll([]byte(f.encoded), options)
+		check(test.name, got, err, test.wantErr, test.wantLen)
+		got, err = cbrotli.DecodeWithOptions([]byte(f.encoded), options)
+		check(test.name+": DecodeWithOptions", got, err, test.wantErr, test.wantLen)
+		got, err = cbrotli.DecodeReaderWithOptions(bytes.NewReader([]byte(f.encoded)), options)
+		check(test.name+": DecodeReaderWithOptions", got, err, test.wantErr, test.wantLen)
+		if _, ok := test.wantErr.(cbrotli.SizeMismatchError); ok && !errors.Is(err, cbrotli.ErrSizeMismatch) {
+			t.Errorf("%s: %v does not match ErrSizeMismatch", test.name, err)
+		}
+	}
+
+	// The total of all streams is checked in Multistream mode, and the error
+	// is sticky.
+	joined := []byte(f.encoded + f.encoded)
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader(joined), cbrotli.ReaderOptions{Multistream: true, ExpectedSize: size})
+	defer r.Close()
+	got, err := io.ReadAll(r)
+	want := cbrotli.SizeMismatchError{Expected: size, Actual: size + 1}
+	if err != want || string(got) != f.decoded {
+		t.Errorf("Multistream: %d bytes, %v; want %v", len(got), err, want)
+	}
+	if _, err := r.Read(make([]byte, 10)); err != want {
+		t.Errorf("Read after the mismatch: got %v, want %v", err, want)
+	}
+	if err := r.Reset(bytes.NewReader(joined[:len(f.encoded)])); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if got, err := io.ReadAll(r); err != nil || string(got) != f.decoded {
+		t.Errorf("after Reset: %d bytes, %v", len(got), err)
+	}
+}
+
+// trickleWriter accepts a byte per call, without reporting short writes, or
+// fails with err after limit bytes if err is set.
+type trickleWriter struct {
+	buf   bytes.Buffer
+	limit int
+	err   error
+}
+
+func (w *trickleWriter) Write(p []byte) (int, error) {
+	if w.err != nil && w.buf.Len() >= w.limit {
+		return 0, w.err
+	}
+	return w.buf.Write(p[:1])
+}
+
+func TestDecodeTeeCompressed(t *testing.T) {
+	f := decodeFixtures[2]
+	joined := []byte(decodeFixtures[1].encoded + f.encoded)
+	want := decodeFixtures[1].decoded + f.decoded
+	for _, options := range []cbrotli.ReaderOptions{
+		{Multistream: true},
+		{Multistream: true, PrefetchBuffers: 2, PrefetchBufferSize: 5},
+		{Multistream: true, FillBuffer: true},
+	} {
+		tee := &trickleWriter{}
+		options.TeeCompressed = tee
+		if got, err := readAll(joined, options); err != nil || string(got) != want {
+			t.Errorf("%+v: %d bytes, %v", options, len(got), err)
+		}
+		if sha256.Sum256(tee.buf.Bytes()) != sha256.Sum256(joined) {
+			t.Errorf("%+v: tee got %d bytes, want the %d bytes of input", options, tee.buf.Len(), len(joined))
+		}
+	}
+
+	// Input read ahead of the decoder is in the tee when the Reader is closed
+	// early.
+	var tee bytes.Buffer
+	src := bytes.NewReader(joined)
+	r := cbrotli.NewReaderWithOptions(src, cbrotli.ReaderOptions{Multistream: true, TeeCompressed: &tee})
+	if _, err := r.Read(make([]byte, 1)); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	r.Close()
+	if read := joined[:len(joined)-src.Len()]; sha256.Sum256(tee.Bytes()) != sha256.Sum256(read) {
+		t.Errorf("closed early: tee got %d bytes, want the %d bytes read", tee.Len(), len(read))
+	}
+
+	// A failure of the tee stops decoding.
+	errTee := errors.New("tee failed")
+	failing := &trickleWriter{limit: 10, err: errTee}
+	got, err := readAll([]byte(f.encoded), cbrotli.ReaderOptions{TeeCompressed: failing})
+	if !errors.Is(err, errTee) || err == errTee || len(got) >= len(f.decoded) {
+		t.Errorf("failing tee: %d bytes, %v; want a wrapped %v", len(got), err, errTee)
+	}
+	if failing.buf.String() != f.encoded[:10] {
+		t.Errorf("failing tee got %q, want %q", failing.buf.String(), f.encoded[:10])
+	}
+}
+
+func checkCompressedData(compressedData, wantOriginalData []byte) error {
+	uncompressed, err := cbrotli.Decode(compressedData)
+	if err != nil {
+		return fmt.Errorf("brotli decompress failed: %v", err)
+	}
+	if !bytes.Equal(uncompressed, wantOriginalData) {
+		if len(wantOriginalData) != len(uncompressed) {
+			return fmt.Errorf(""+
+				"Data doesn't uncompress to the original value.\n"+
+				"Length of original: %v\n"+
+				"Length of uncompressed: %v",
+				len(wantOriginalData), len(uncompressed))
+		}
+		for i := range wantOriginalData {
+			if wantOriginalData[i] != uncompressed[i] {
+				return fmt.Errorf(""+
+					"Data doesn't uncompress to the original value.\n"+
+					"Original at %v is %v\n"+
+					"Uncompressed at %v is %v",
+					i, wantOriginalData[i], i, uncompressed[i])
+			}
+		}
+	}
+	return nil
+}
+
+func TestReader(t *testing.T) {
+	content := bytes.Repeat([]byte("hello world!"), 10000)
+	encoded := testStream(t, "hello.br")
+	r := cbrotli.NewReader(bytes.NewReader(encoded))
+	var decodedOutput bytes.Buffer
+	n, err := io.Copy(&decodedOutput, r)
+	if err != nil {
+		t.Fatalf("Copy(): n=%v, err=%v", n, err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close(): %v", err)
+	}
+	if got := decodedOutput.Bytes(); !bytes.Equal(got, content) {
+		t.Errorf(""+
+			"Reader output:\n"+
+			"%q\n"+
+			"want:\n"+
+			"<%d bytes>",
+			got, len(content))
+	}
+	buf := make([]byte, 4)
+	if _, err := r.Read(buf); err == nil {
+		t.Errorf("Read-after-Close shoule have returned error")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	content := bytes.Repeat([]byte("hello world!"), 10000)
+	encoded := testStream(t, "hello.br")
+	decoded, err := cbrotli.Decode(encoded)
+	if err != nil {
+		t.Errorf("Decode: %v", err)
+	}
+	if !bytes.Equal(decoded, content) {
+		t.Errorf(""+
+			"Decode content:\n"+
+			"%q\n"+
+			"want:\n"+
+			"<%d bytes>",
+			decoded, len(content))
+	}
+}
+
+func TestDecodeFuzz(t *testing.T) {
+	// Test that the decoder terminates with corrupted input.
+	content := bytes.Repeat([]byte("hello world!"), 100)
+	src := rand.NewSource(0)
+	encoded := testStream(t, "hello-short.br")
+	if err := checkCompressedData(encoded, content); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		enc := append([]byte{}, encoded...)
+		for j := 0; j < 5; j++ {
+			enc[int(src.Int63())%len(enc)] = byte(src.Int63() % 256)
+		}
+		cbrotli.Decode(enc)
+	}
+}
+
+func TestDecodeTrailingData(t *testing.T) {
+	content := bytes.Repeat([]byte("hello world!"), 100)
+	encoded := testStream(t, "hello-short.br")
+	if err := checkCompressedData(encoded, content); err != nil {
+		t.Fatal(err)
+	}
+	_, err := cbrotli.Decode(append(encoded, 0))
+	if err == nil {
+		t.Errorf("Expected 'excessive input' error")
+	}
+}
+
+func TestEncodeInvalidOptions(t *testing.T) {
+	for _, options := range []cbrotli.WriterOptions{
+		{Quality: 12},
+		{Quality: -1},
+		{Quality: 5, LGWin: 9},
+		{Quality: 5, LGWin: 25},
+	} {
+		if _, err := cbrotli.Encode([]byte("hello"), options); err == nil {
+			t.Errorf("Encode(_, %+v) succeeded, want error", options)
+		}
+		e := cbrotli.NewWriter(io.Discard, options)
+		if _, err := e.Write([]byte("hello")); err == nil {
+			t.Errorf("Write with options %+v succeeded, want error", options)
+		}
+		e.Close()
+	}
+}
+
+// wordSoup returns text made of random words, which compresses noticeably
+// better at higher qualities.
+func wordSoup(seed int64, size int) []byte {
+	return conformance.WordSoup(seed, size)
+}
+
+func batchInputs(n int) [][]byte {
+	inputs := make([][]byte, n)
+	for i := range inputs {
+		inputs[i] = wordSoup(int64(i), i*37%3000)
+	}
+	return inputs
+}
+
+func TestCompressBatchContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	outputs, err := cbrotli.CompressBatchContext(ctx, batchInputs(10), cbrotli.WriterOptions{Quality: 5}, 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	for i, output := range outputs {
+		if output != nil {
+			t.Errorf("item %d was processed after cancellation", i)
+		}
+	}
+}
+
+func TestReaderMultistream(t *testing.T) {
+	a := testStream(t, "multistream-a.br")
+	b := testStream(t, "multistream-b.br")
+	joined := bytes.Join([][]byte{a, b, a}, nil)
+	// Byte-by-byte reads exercise stream boundaries that fall between reads.
+	r := cbrotli.NewReaderWithOptions(iotest.OneByteReader(bytes.NewReader(joined)), cbrotli.ReaderOptions{Multistream: true})
+	got, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || string(got) != "hello worldhello " {
+		t.Errorf("got %q, %v", got, err)
+	}
+	// Truncated second stream.
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(joined[:len(a)+1]), cbrotli.ReaderOptions{Multistream: true})
+	if _, err := io.ReadAll(r); err != cbrotli.ErrTruncated {
+		t.Errorf("truncated stream: got %v, want %v", err, cbrotli.ErrTruncated)
+	}
+	r.Close()
+}
+
+func TestSeekableReaderInvalidIndex(t *testing.T) {
+	input := wordSoup(10, 100000)
+	file := testStream(t, "seekable.br")
+	plain := testStream(t, "seekable-plain.br")
+	if err := checkCompressedData(plain, input); err != nil {
+		t.Fatal(err)
+	}
+	corrupted := bytes.Clone(file)
+	corrupted[len(corrupted)-14] ^= 0xff // frame count
+	for name, stream := range map[string][]byte{
+		"plain":     plain,
+		"truncated": file[:len(file)-3],
+		"corrupted": corrupted,
+		"empty":     nil,
+	} {
+		_, err := cbrotli.NewSeekableReader(bytes.NewReader(stream), int64(len(stream)))
+		var indexErr *cbrotli.IndexError
+		if !errors.As(err, &indexErr) {
+			t.Errorf("%s: got %v, want *IndexError", name, err)
+		}
+	}
+
+	// Empty content has an empty index.
+	file = testStream(t, "seekable-empty.br")
+	r, err := cbrotli.NewSeekableReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("NewSeekableReader: %v", err)
+	}
+	if n, err := r.Read(make([]byte, 10)); n != 0 || err != io.EOF || r.Size() != 0 {
+		t.Errorf("empty content: Read()=%d, %v; Size()=%d", n, err, r.Size())
+	}
+}
+
+func TestDecoderDictionary(t *testing.T) {
+	dictionary := wordSoup(11, 100000)
+	input := bytes.Clone(dictionary[5000:25000])
+	encoded := testStream(t, "dictionary.br")
+	dd, err := cbrotli.NewDecoderDictionary(dictionary)
+	if err != nil {
+		t.Fatalf("NewDecoderDictionary: %v", err)
+	}
+	// The dictionary is copied.
+	dictionary[5000] ^= 1
+
+	readers := make([]*cbrotli.Reader, 4)
+	var wg sync.WaitGroup
+	for i := range readers {
+		readers[i] = cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd)
+		wg.Add(1)
+		go func(r *cbrotli.Reader) {
+			defer wg.Done()
+			decoded, err := io.ReadAll(r)
+			if err != nil || !bytes.Equal(decoded, input) {
+				t.Errorf("decoded %d bytes, %v", len(decoded), err)
+			}
+		}(readers[i])
+	}
+	wg.Wait()
+	if err := dd.Close(); err == nil {
+		t.Error("Close succeeded while Readers are open")
+	}
+	for _, r := range readers {
+		r.Close()
+	}
+	if err := dd.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if err := dd.Close(); err == nil {
+		t.Error("second Close succeeded")
+	}
+	// A dictionary closed by a racing Close is reported by Read.
+	for _, r := range []*cbrotli.Reader{
+		cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), dd),
+		cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{Dictionary: dd}),
+		cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+			ResolveDictionary: func(string) (*cbrotli.DecoderDictionary, error) { return dd, nil },
+		}),
+	} {
+		r.SetDictionaryID("closed")
+		if _, err := r.Read(make([]byte, 10)); err == nil {
+			t.Error("Read succeeded with a closed dictionary")
+		}
+		if err := r.Close(); err != nil {
+			t.Errorf("Close of a Reader with a closed dictionary: %v", err)
+		}
+	}
+	if _, err := cbrotli.NewDecoderDictionary(nil); err == nil {
+		t.Error("NewDecoderDictionary accepted empty dictionary")
+	}
+}
+
+// apiResponse returns a JSON document typical for some API.
+func apiResponse(src *rand.Rand) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `{"status":"ok","request_id":"%08x","data":{"items":[`, src.Uint32())
+	for i, n := 0, 1+src.Intn(5); i < n; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `{"id":%d,"type":"product","attributes":{"title":"Item %d","price_cents":%d,`+
+			`"currency":"EUR","availability":"in_stock","categories":["home","garden"]},`+
+			`"links":{"self":"https://api.example.com/v2/products/%d"}}`,
+			src.Intn(1e6), src.Intn(1000), src.Intn(1e5), src.Intn(1e6))
+	}
+	buf.WriteString(`]},"meta":{"api_version":"2.14.1","deprecation_notice":null,"pagination":{"page":1,"per_page":20}}}`)
+	return buf.Bytes()
+}
+
+func TestDictionaryBuilder(t *testing.T) {
+	build := func() (*cbrotli.DictionaryBuilder, []byte, []byte) {
+		b := cbrotli.NewDictionaryBuilder(cbrotli.DictionaryBuilderOptions{MaxSamples: 200})
+		src := rand.New(rand.NewSource(14))
+		for i := 0; i < 2000; i++ {
+			if err := b.Add(apiResponse(src)); err != nil {
+				t.Fatalf("Add: %v", err)
+			}
+		}
+		small, err := b.Build(1024)
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		large, err := b.Build(8192)
+		if err != nil {
+			t.Fatalf("Build: %v", err)
+		}
+		return b, small, large
+	}
+	b, small, large := build()
+	if b.SampleCount() != 2000 {
+		t.Errorf("SampleCount()=%d, want 2000", b.SampleCount())
+	}
+	if len(small) == 0 || len(small) > 1024 || len(large) <= len(small) || len(large) > 8192 {
+		t.Errorf("dictionary sizes %d and %d", len(small), len(large))
+	}
+	if again, _ := b.Build(1024); !bytes.Equal(again, small) {
+		t.Error("repeated Build produced a different dictionary")
+	}
+	_, small2, large2 := build()
+	if !bytes.Equal(small, small2) || !bytes.Equal(large, large2) {
+		t.Error("dictionaries differ for the same input")
+	}
+	if err := b.Add(nil); err == nil {
+		t.Error("Add accepted empty sample")
+	}
+}
+
+func TestBuildSerializedDictionaryInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		raw     []byte
+		options cbrotli.SerializedDictionaryOptions
+	}{
+		{"empty", nil, cbrotli.SerializedDictionaryOptions{}},
+		{"short word", []byte("x"), cbrotli.SerializedDictionaryOptions{Words: [][]byte{[]byte("abc")}}},
+		{"long word", []byte("x"), cbrotli.SerializedDictionaryOptions{Words: [][]byte{bytes.Repeat([]byte("a"), 32)}}},
+	} {
+		if _, err := cbrotli.BuildSerializedDictionary(tc.raw, tc.options); err == nil {
+			t.Errorf("%s: BuildSerializedDictionary succeeded", tc.name)
+		}
+	}
+	if _, err := cbrotli.NewSerializedDecoderDictionary([]byte{0x91, 0, 5, 'x'}); err == nil {
+		t.Error("NewSerializedDecoderDictionary accepted truncated data")
+	}
+}
+
+func TestWriterWithDictionariesInvalid(t *testing.T) {
+	w := cbrotli.NewWriterWithDictionaries(io.Discard, cbrotli.WriterOptions{Quality: 5},
+		[]cbrotli.Dictionary{{Data: wordSoup(18, 1000)}, {Data: nil}})
+	if _, err := w.Write([]byte("data")); err == nil {
+		t.Error("Write succeeded with an empty dictionary")
+	}
+	if err := w.Close(); err == nil {
+		t.Error("Close succeeded with an empty dictionary")
+	}
+}
+
+func TestDictionaryID(t *testing.T) {
+	for _, tc := range []struct {
+		dictionary, formatted string
+	}{
+		{"Hello World", ":pZGm1Av0IEBKARczz7exkNYsZb8LzaMrV7J32a2fFG4=:"}, // RFC 9842 example
+		{"", ":47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=:"},
+		{"abc", ":ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=:"},
+	} {
+		id := cbrotli.DictionaryID([]byte(tc.dictionary))
+		if got := cbrotli.FormatDictionaryID(id); got != tc.formatted {
+			t.Errorf("FormatDictionaryID(%q)=%s, want %s", tc.dictionary, got, tc.formatted)
+		}
+		parsed, err := cbrotli.ParseDictionaryID(" " + tc.formatted)
+		if err != nil || parsed != id {
+			t.Errorf("ParseDictionaryID(%s)=%x, %v, want %x", tc.formatted, parsed, err, id)
+		}
+	}
+	for _, s := range []string{"", ":", "pZGm1Av0IEBKARczz7exkNYsZb8LzaMrV7J32a2fFG4=", ":pZGm1Av0:", ":not base64!:"} {
+		if _, err := cbrotli.ParseDictionaryID(s); err == nil {
+			t.Errorf("ParseDictionaryID(%q) succeeded", s)
+		}
+	}
+
+	dictionary := wordSoup(20, 1000)
+	want := cbrotli.DictionaryID(dictionary)
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	if pd.ID() != want {
+		t.Errorf("PreparedDictionary.ID()=%x, want %x", pd.ID(), want)
+	}
+	dd, err := cbrotli.NewDecoderDictionary(dictionary)
+	if err != nil {
+		t.Fatalf("NewDecoderDictionary: %v", err)
+	}
+	defer dd.Close()
+	if dd.ID() != want {
+		t.Errorf("DecoderDictionary.ID()=%x, want %x", dd.ID(), want)
+	}
+}
+
+func TestReaderResolveDictionary(t *testing.T) {
+	dictionary := wordSoup(23, 30000)
+	input := bytes.Clone(dictionary[1000:21000])
+	encoded := testStream(t, "resolve.br")
+	plain := testStream(t, "resolve-plain.br")
+	dd, err := cbrotli.NewDecoderDictionary(dictionary)
+	if err != nil {
+		t.Fatalf("NewDecoderDictionary: %v", err)
+	}
+	defer dd.Close()
+	errUnknown := errors.New("unknown dictionary")
+	var calls int
+	options := cbrotli.ReaderOptions{ResolveDictionary: func(id string) (*cbrotli.DecoderDictionary, error) {
+		calls++
+		if id != "html-v1" {
+			return nil, errUnknown
+		}
+		return dd, nil
+	}}
+
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), options)
+	if err := r.SetDictionaryID("html-v1"); err != nil {
+		t.Fatalf("SetDictionaryID: %v", err)
+	}
+	decoded, err := io.ReadAll(iotest.OneByteReader(r))
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("decoded %d bytes, %v", len(decoded), err)
+	}
+	if calls != 1 {
+		t.Errorf("resolver called %d times, want 1", calls)
+	}
+	if err := r.SetDictionaryID("other"); err == nil {
+		t.Error("SetDictionaryID succeeded after Read")
+	}
+	r.Close()
+
+	calls = 0
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(plain), options)
+	decoded, err = io.ReadAll(r)
+	r.Close()
+	if err != nil || !bytes.Equal(decoded, input) || calls != 0 {
+		t.Errorf("without id: decoded %d bytes, %v; %d resolver calls", len(decoded), err, calls)
+	}
+
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), options)
+	r.SetDictionaryID("missing")
+	if _, err := io.ReadAll(r); !errors.Is(err, errUnknown) {
+		t.Errorf("Read: %v, want %v", err, errUnknown)
+	}
+	r.Close()
+	if err := dd.Close(); err != nil {
+		t.Errorf("DecoderDictionary not released: %v", err)
+	}
+}
+
+func TestParseSerializedDictionary(t *testing.T) {
+	fixture := contextDictionary()
+	info, err := cbrotli.ParseSerializedDictionary(fixture)
+	if err != nil {
+		t.Fatalf("ParseSerializedDictionary: %v", err)
+	}
+	want := cbrotli.DictionaryInfo{
+		Size:           len(fixture),
+		Prefixes:       []cbrotli.PrefixInfo{{Offset: 3, Size: 5}},
+		WordLists:      1,
+		TransformLists: 1,
+		Dictionaries:   2,
+		ContextBased:   true,
+	}
+	if info.String() != want.String() || !info.CustomWords() || !info.CustomTransforms() {
+		t.Errorf("ParseSerializedDictionary: %v, want %v", info, want)
+	}
+	if got := info.String(); got != "v0 size=132 prefixes=[3+5] word_lists=1 transform_lists=1 dictionaries=2 context=true" {
+		t.Errorf("String: %s", got)
+	}
+
+	raw := wordSoup(16, 1000)
+	built, err := cbrotli.BuildSerializedDictionary(raw, cbrotli.SerializedDictionaryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err = cbrotli.ParseSerializedDictionary(append(built, "trailing"...))
+	if err != nil || info.Size != len(built) || len(info.Prefixes) != 1 || info.CustomTransforms() ||
+		!bytes.Equal(built[info.Prefixes[0].Offset:][:info.Prefixes[0].Size], raw) {
+		t.Errorf("ParseSerializedDictionary(built): %v, %v", info, err)
+	}
+	built, err = cbrotli.BuildSerializedDictionary(nil, cbrotli.SerializedDictionaryOptions{
+		Words: [][]byte{[]byte("word"), []byte("longer words")}, IdentityTransformOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := cbrotli.ParseSerializedDictionary(built); err != nil || len(info.Prefixes) != 0 ||
+		info.WordLists != 1 || info.TransformLists != 1 || info.Dictionaries != 1 {
+		t.Errorf("ParseSerializedDictionary(words): %v, %v", info, err)
+	}
+
+	// Every strict prefix is truncated.
+	for n := 0; n < len(fixture); n++ {
+		if _, err := cbrotli.ParseSerializedDictionary(fixture[:n]); !errors.Is(err, cbrotli.ErrTruncatedDictionary) {
+			t.Errorf("ParseSerializedDictionary of %d bytes: %v", n, err)
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		pos  int
+		b    byte
+	}{
+		{"magic", 0, 0x92},
+		{"version", 1, 1},
+		{"size bits", 10, 16},
+		{"word lists", 8, 65},
+		{"stringlet index", 52, 2},
+		{"transform type", 53, 23},
+		{"identity parameters", 58, 1},
+		{"no dictionaries", 62, 0},
+		{"word list index", 63, 2},
+		{"context enabled", 67, 2},
+		{"context map", 68, 2},
+	} {
+		malformed := bytes.Clone(fixture)
+		malformed[tc.pos] = tc.b
+		if _, err := cbrotli.ParseSerializedDictionary(malformed); !errors.Is(err, cbrotli.ErrMalformedDictionary) {
+			t.Errorf("%s: %v", tc.name, err)
+		}
+	}
+}
+
+// truncatedContent is the content of the truncated-*.br streams.
+func truncatedContent() []byte {
+	return append(wordSoup(141, 3000), bytes.Repeat([]byte{'z'}, 1000)...)
+}
+
+func TestTruncatedAndCorrupt(t *testing.T) {
+	input := truncatedContent()
+	var encoded [][]byte
+	// Streams at qualities 0, 5 and 11, and one flushed in the middle, which
+	// has meta-block boundaries inside.
+	for _, name := range []string{"truncated-q0.br", "truncated-q5.br", "truncated-q11.br", "truncated-flushed.br"} {
+		e := testStream(t, name)
+		if err := checkCompressedData(e, input); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		encoded = append(encoded, e)
+	}
+
+	decoders := map[string]func([]byte) ([]byte, error){
+		"Decode": cbrotli.Decode,
+		"Reader": func(data []byte) ([]byte, error) {
+			r := cbrotli.NewReader(iotest.OneByteReader(bytes.NewReader(data)))
+			defer r.Close()
+			return io.ReadAll(r)
+		},
+	}
+	for i, e := range encoded {
+		for name, decode := range decoders {
+			for n := 0; n < len(e); n++ {
+				_, err := decode(e[:n])
+				if !errors.Is(err, cbrotli.ErrTruncated) || !errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, cbrotli.ErrCorrupt) {
+					t.Fatalf("stream %d, %s of %d/%d bytes: %v", i, name, n, len(e), err)
+				}
+			}
+		}
+	}
+
+	// Corruption is reported with the decoder error code.
+	corrupt := bytes.Clone(encoded[1])
+	corrupt[0] = 0xff
+	corrupt[1] = 0xff
+	for name, decode := range decoders {
+		_, err := decode(corrupt)
+		var de cbrotli.DecoderError
+		if !errors.Is(err, cbrotli.ErrCorrupt) || errors.Is(err, cbrotli.ErrTruncated) || !errors.As(err, &de) || de.Code >= 0 {
+			t.Errorf("%s of a corrupt stream: %v", name, err)
+		}
+	}
+	// So is a reference to a missing dictionary.
+	withDictionary := testStream(t, "truncated-dictionary.br")
+	if decoded, err := cbrotli.DecodeWithRawDictionary(withDictionary, input); err != nil || !bytes.Equal(decoded, input) {
+		t.Fatalf("Decode with the dictionary: %v", err)
+	}
+	if _, err := cbrotli.Decode(withDictionary); !errors.Is(err, cbrotli.ErrCorrupt) {
+		t.Errorf("Decode without the dictionary: %v", err)
+	}
+	if errors.Is(cbrotli.DecoderError{Code: -21}, cbrotli.ErrCorrupt) {
+		t.Errorf("allocation failure is corruption")
+	}
+}
+
+// failingReader returns data, then err along with the last bytes if together
+// is set, or alone otherwise.
+type failingReader struct {
+	data     []byte
+	err      error
+	together bool
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	n := copy(p, f.data)
+	f.data = f.data[n:]
+	if len(f.data) == 0 && (f.together || n == 0) {
+		return n, f.err
+	}
+	return n, nil
+}
+
+func TestReaderSourceErrors(t *testing.T) {
+	input := wordSoup(142, 100000)
+	encoded := testStream(t, "source-errors.br")
+	sentinel := errors.New("sentinel")
+	for _, tc := range []struct {
+		name string
+		err  error
+		cut  int
+	}{
+		{"sentinel", sentinel, len(encoded) / 2},
+		{"deadline", context.DeadlineExceeded, 10},
+		{"after the stream", sentinel, len(encoded)},
+	} {
+		for _, together := range []bool{false, true} {
+			src := &failingReader{data: encoded[:tc.cut], err: tc.err, together: together}
+			r := cbrotli.NewReader(src)
+			decoded, err := io.ReadAll(r)
+			if !errors.Is(err, tc.err) || errors.Is(err, cbrotli.ErrTruncated) || !strings.Contains(err.Error(), "reading source") {
+				t.Errorf("%s (together: %t): %v", tc.name, together, err)
+			}
+			if tc.cut == len(encoded) && !bytes.Equal(decoded, input) {
+				t.Errorf("%s (together: %t): decoded %d bytes, want %d", tc.name, together, len(decoded), len(input))
+			}
+			// The error is sticky.
+			if _, again := r.Read(make([]byte, 10)); again != err {
+				t.Errorf("%s (together: %t): second Read: %v, want %v", tc.name, together, again, err)
+			}
+			r.Close()
+
+			src = &failingReader{data: encoded[:tc.cut], err: tc.err, together: together}
+			if _, err := cbrotli.DecodeReader(src); !errors.Is(err, tc.err) {
+				t.Errorf("DecodeReader, %s (together: %t): %v", tc.name, together, err)
+			}
+		}
+	}
+	if decoded, err := cbrotli.DecodeReader(bytes.NewReader(encoded)); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("DecodeReader: %d bytes, %v", len(decoded), err)
+	}
+}
+
+func TestNegotiateContentEncoding(t *testing.T) {
+	offered := []string{"br", "gzip"}
+	for _, tc := range []struct {
+		header []string // nil for none
+		want   string
+	}{
+		{nil, "identity"},
+		{[]string{""}, "identity"},
+		{[]string{"br"}, "br"},
+		{[]string{"gzip"}, "gzip"},
+		{[]string{"gzip, br"}, "br"},
+		{[]string{"BR"}, "br"},
+		{[]string{"x-gzip"}, "gzip"},
+		{[]string{"gzip;q=0.8, br;q=1.0, *;q=0.1"}, "br"},
+		{[]string{"gzip;q=1.0, br;q=0.8"}, "gzip"},
+		{[]string{"gzip;q=0.5, br;q=0.500"}, "br"},
+		{[]string{"br;q=0"}, "identity"},
+		{[]string{"br;q=0.000, gzip;q=0.001"}, "gzip"},
+		{[]string{"*"}, "br"},
+		{[]string{"*;q=0.5, br;q=0"}, "gzip"},
+		{[]string{"compress, deflate"}, "identity"},
+		// RFC 9110, section 12.5.3 examples.
+		{[]string{"compress, gzip"}, "gzip"},
+		{[]string{"*"}, "br"},
+		{[]string{"compress;q=0.5, gzip;q=1.0"}, "gzip"},
+		{[]string{"gzip;q=1.0, identity; q=0.5, *;q=0"}, "gzip"},
+		// Identity refused.
+		{[]string{"identity;q=0"}, ""},
+		{[]string{"*;q=0"}, ""},
+		{[]string{"*;q=0, identity;q=0.1"}, "identity"},
+		{[]string{"deflate, identity;q=0"}, ""},
+		// Several header lines and empty list elements.
+		{[]string{"deflate", "br;q=0.1"}, "br"},
+		{[]string{", , br,"}, "br"},
+		{[]string{"br ;\tq=0.9 , gzip; q=0.3"}, "br"},
+		{[]string{"br;level=5;q=0.7, gzip;q=0.6"}, "br"},
+		{[]string{"br, br;q=0"}, "br"},
+		// Malformed values fail safe to identity.
+		{[]string{"br;q=2"}, "identity"},
+		{[]string{"br;q=1.5"}, "identity"},
+		{[]string{"br;q=0.1234"}, "identity"},
+		{[]string{"br;q="}, "identity"},
+		{[]string{"br;q=.5"}, "identity"},
+		{[]string{"br;q"}, "identity"},
+		{[]string{"br;q = 0.5"}, "identity"},
+		{[]string{"b r"}, "identity"},
+		{[]string{"br;q=0.5, \"gzip\""}, "identity"},
+		{[]string{"*;q=0, br;q=x"}, "identity"},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		if tc.header != nil {
+			req.Header["Accept-Encoding"] = tc.header
+		}
+		if got := cbrotli.NegotiateContentEncoding(req, offered); got != tc.want {
+			t.Errorf("%q: got %q, want %q", tc.header, got, tc.want)
+		}
+	}
+
+	// The server preference breaks ties; identity competes when offered.
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip, br, identity")
+	if got := cbrotli.NegotiateContentEncoding(req, []string{"gzip", "br"}); got != "gzip" {
+		t.Errorf("tie: got %q", got)
+	}
+	req.Header.Set("Accept-Encoding", "br;q=0.5, identity")
+	if got := cbrotli.NegotiateContentEncoding(req, []string{"br", "identity"}); got != "identity" {
+		t.Errorf("preferred identity: got %q", got)
+	}
+	if got := cbrotli.NegotiateContentEncoding(req, nil); got != "identity" {
+		t.Errorf("nothing offered: got %q", got)
+	}
+
+	for header, want := range map[string]bool{
+		"gzip;q=1, br;q=0.5": true,
+		"*":                  true,
+		"br;q=0, *":          false,
+		"gzip":               false,
+		"br;q=x":             false,
+	} {
+		req.Header.Set("Accept-Encoding", header)
+		if got := cbrotli.WantsBrotli(req); got != want {
+			t.Errorf("%q: WantsBrotli=%v", header, got)
+		}
+	}
+}
+
+func TestReaderReset(t *testing.T) {
+	dictionary := wordSoup(71, 20000)
+	inputs := [][]byte{wordSoup(72, 5000), dictionary[1000:9000], nil}
+	r := cbrotli.NewReaderWithRawDictionary(bytes.NewReader(nil), dictionary)
+	defer r.Close()
+	for i, input := range inputs {
+		encoded := testStream(t, fmt.Sprintf("reset-%d.br", i))
+		if err := r.Reset(bytes.NewReader(encoded)); err != nil {
+			t.Fatalf("Reset: %v", err)
+		}
+		decoded, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(decoded, input) {
+			t.Errorf("input %d: got %d bytes, %v", i, len(decoded), err)
+		}
+	}
+	// A Reset in the middle of a failed stream recovers.
+	if err := r.Reset(strings.NewReader("garbage")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(r); err == nil {
+		t.Error("garbage decoded")
+	}
+	encoded := testStream(t, "reset-1.br")
+	r.Reset(bytes.NewReader(encoded))
+	if decoded, err := io.ReadAll(r); err != nil || !bytes.Equal(decoded, inputs[1]) {
+		t.Errorf("after failure: got %d bytes, %v", len(decoded), err)
+	}
+
+	// Invalid options stay invalid.
+	bad := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{RawDictionary: []byte{}})
+	defer bad.Close()
+	bad.Reset(bytes.NewReader(encoded))
+	if _, err := io.ReadAll(bad); !errors.Is(err, cbrotli.ErrDictionaryEmpty) {
+		t.Errorf("invalid options after Reset: %v", err)
+	}
+	r.Close()
+	if err := r.Reset(bytes.NewReader(encoded)); err == nil {
+		t.Error("Reset of a closed Reader succeeded")
+	}
+}
+
+//go:embed testdata/fs
+var testTree embed.FS
+
+func TestFS(t *testing.T) {
+	tree, err := fs.Sub(testTree, "testdata/fs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	only := strings.Repeat("only compressed\n", 100)
+	for _, tc := range []struct {
+		options cbrotli.FSOptions
+		files   map[string]string
+		sizes   map[string]int64
+	}{
+		{
+			cbrotli.FSOptions{},
+			map[string]string{
+				"mixed/plain.txt":     "plain file\n",
+				"mixed/both.txt":      "identity variant\n",
+				"mixed/only.txt":      only,
+				"compressed/a.txt":    "a\n",
+				"compressed/b.html":   "<!DOCTYPE html>\n<title>b</title>\n",
+				"mixed/both.txt.br":   "",
+				"compressed/a.txt.br": "",
+			},
+			map[string]int64{"mixed/only.txt": int64(len(only)), "compressed/a.txt": -1, "mixed/both.txt": 17},
+		},
+		{
+			cbrotli.FSOptions{PreferCompressed: true},
+			map[string]string{
+				"mixed/plain.txt": "plain file\n",
+				"mixed/both.txt":  "compressed variant\n",
+				"mixed/only.txt":  only,
+			},
+			map[string]int64{"mixed/both.txt": -1},
+		},
+	} {
+		fsys := cbrotli.FSWithOptions(tree, tc.options)
+		for name, want := range tc.files {
+			got, err := fs.ReadFile(fsys, name)
+			if strings.HasSuffix(name, ".br") {
+				// The compressed files themselves can be opened.
+				raw, _ := fs.ReadFile(tree, name)
+				want = string(raw)
+			}
+			if err != nil || string(got) != want {
+				t.Errorf("%+v: ReadFile(%s) = %q, %v; want %q", tc.options, name, got, err, want)
+			}
+		}
+		for name, want := range tc.sizes {
+			info, err := fs.Stat(fsys, name)
+			if err != nil || info.Size() != want || info.Name() != path.Base(name) {
+				t.Errorf("%+v: Stat(%s) = %v, %v; want size %d", tc.options, name, info, err, want)
+			}
+		}
+		if _, err := fsys.Open("mixed/missing.txt"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("%+v: Open of a missing file: %v", tc.options, err)
+		}
+		if _, err := fsys.Open("../fs/mixed/plain.txt"); !errors.Is(err, fs.ErrInvalid) {
+			t.Errorf("%+v: Open of an invalid path: %v", tc.options, err)
+		}
+		for dir, want := range map[string]string{
+			"mixed":      "both.txt only.txt plain.txt",
+			"compressed": "a.txt b.html",
+		} {
+			entries, err := fs.ReadDir(fsys, dir)
+			var names []string
+			for _, e := range entries {
+				names = append(names, e.Name())
+			}
+			if err != nil || strings.Join(names, " ") != want {
+				t.Errorf("%+v: ReadDir(%s) = %q, %v; want %q", tc.options, dir, names, err, want)
+			}
+		}
+		if err := fstest.TestFS(fsys, "mixed/plain.txt", "mixed/both.txt", "mixed/only.txt", "compressed/a.txt", "compressed/b.html"); err != nil {
+			t.Errorf("%+v: %v", tc.options, err)
+		}
+	}
+
+	// http.FileServer serves decoded files with a size hint.
+	server := httptest.NewServer(http.FileServer(http.FS(cbrotli.FS(tree))))
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/mixed/only.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || string(body) != only || resp.ContentLength != int64(len(only)) {
+		t.Errorf("FileServer: %d bytes, Content-Length %d, %v", len(body), resp.ContentLength, err)
+	}
+}
+
+func TestReaderMaxDecodedSize(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	encoded := testStream(t, "digits.br")
+	for _, limit := range []int64{1, 999, 9999} {
+		r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{MaxDecodedSize: limit})
+		got, err := io.ReadAll(r)
+		if err != cbrotli.ErrDecodedTooLarge || !bytes.Equal(got, content[:limit]) {
+			t.Errorf("limit %d: got %d bytes, %v", limit, len(got), err)
+		}
+		if _, err := r.Read(make([]byte, 10)); err != cbrotli.ErrDecodedTooLarge {
+			t.Errorf("limit %d: error is not sticky: %v", limit, err)
+		}
+		r.Close()
+	}
+	r := cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{MaxDecodedSize: int64(len(content))})
+	defer r.Close()
+	if got, err := io.ReadAll(r); err != nil || !bytes.Equal(got, content) {
+		t.Errorf("limit at the size: got %d bytes, %v", len(got), err)
+	}
+}
+
+// parseFramed checks the structure and the checksums of a framed file and
+// returns the payloads of its chunks.
+func parseFramed(file []byte) ([][]byte, error) {
+	if len(file) < 8 || string(file[:4]) != "\xceBRC" || file[4] != 1 {
+		return nil, errors.New("bad header")
+	}
+	castagnoli := crc32.MakeTable(crc32.Castagnoli)
+	var chunks [][]byte
+	var stream []byte
+	for rest := file[8:]; ; {
+		if len(rest) < 4 {
+			return nil, errors.New("truncated")
+		}
+		n := binary.LittleEndian.Uint32(rest)
+		if n == 0 {
+			if len(rest) != 16 {
+				return nil, fmt.Errorf("end of %d bytes", len(rest))
+			}
+			content, err := cbrotli.Decode(stream)
+			if err != nil {
+				return nil, err
+			}
+			if size := binary.LittleEndian.Uint64(rest[4:]); size != uint64(len(content)) {
+				return nil, fmt.Errorf("size %d, decoded %d", size, len(content))
+			}
+			if digest := binary.LittleEndian.Uint32(rest[12:]); digest != crc32.Checksum(content, castagnoli) {
+				return nil, errors.New("digest mismatch")
+			}
+			return chunks, nil
+		}
+		if uint64(len(rest)) < 8+uint64(n) {
+			return nil, errors.New("truncated chunk")
+		}
+		payload := rest[8 : 8+n]
+		if binary.LittleEndian.Uint32(rest[4:]) != crc32.Checksum(payload, castagnoli) {
+			return nil, fmt.Errorf("chunk %d: checksum mismatch", len(chunks))
+		}
+		chunks = append(chunks, payload)
+		stream = append(stream, payload...)
+		rest = rest[8+n:]
+	}
+}
+
+func TestFrameReader(t *testing.T) {
+	content := wordSoup(182, 100000)
+	for _, skip := range []bool{false, true} {
+		r := cbrotli.NewFrameReaderWithOptions(iotest.OneByteReader(bytes.NewReader(framedGoldenFile)),
+			cbrotli.FrameReaderOptions{SkipVerification: skip})
+		got, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(got, content) {
+			t.Errorf("SkipVerification %v: %d bytes, %v", skip, len(got), err)
+		}
+		if err := r.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := cbrotli.VerifyFile(bytes.NewReader(framedGoldenFile)); err != nil {
+		t.Errorf("VerifyFile: %v", err)
+	}
+
+	for name, c := range map[string]struct {
+		file  []byte
+		chunk int
+	}{
+		"empty":          {nil, -1},
+		"plain Brotli":   {[]byte{0x3b}, -1},
+		"no end":         {framedGoldenFile[:len(framedGoldenFile)-16], 7},
+		"truncated end":  {framedGoldenFile[:len(framedGoldenFile)-1], -1},
+		"trailing bytes": {append(bytes.Clone(framedGoldenFile), 0), -1},
+	} {
+		var fe *cbrotli.FrameError
+		if err := cbrotli.VerifyFile(bytes.NewReader(c.file)); !errors.As(err, &fe) || fe.Chunk != c.chunk {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+// framedRecords returns the offsets of the records of a framed file: those of
+// its chunks, then that of its end.
+func framedRecords(file []byte) []int64 {
+	var records []int64
+	for off := int64(8); ; {
+		records = append(records, off)
+		n := int64(binary.LittleEndian.Uint32(file[off:]))
+		if n == 0 {
+			return records
+		}
+		off += 8 + n
+	}
+}
+
+func TestFrameReaderCorruption(t *testing.T) {
+	content := wordSoup(182, 100000)
+	records := framedRecords(framedGoldenFile)
+	// Flip bits in all fields, and all over the payloads.
+	positions := []int64{0, 3, 4, 5, 7}
+	for _, off := range records {
+		positions = append(positions, off, off+3, off+4, off+7, off+8)
+	}
+	end := records[len(records)-1]
+	positions = append(positions, end+4, end+11, end+12, end+15)
+	for pos := int64(9); pos < end; pos += 97 {
+		positions = append(positions, pos)
+	}
+	for _, pos := range positions {
+		file := bytes.Clone(framedGoldenFile)
+		file[pos] ^= 1 << (pos % 8)
+		chunk := -1
+		for i, off := range records[:len(records)-1] {
+			if pos >= off {
+				chunk = i
+			}
+		}
+		switch {
+		case pos >= end+4:
+			chunk = -1
+		case pos >= end:
+			// A length that is not zero makes the end look like a chunk.
+			chunk = len(records) - 1
+		}
+
+		r := cbrotli.NewFrameReader(bytes.NewReader(file))
+		got, err := io.ReadAll(r)
+		r.Close()
+		var fe *cbrotli.FrameError
+		if !errors.As(err, &fe) {
+			t.Errorf("bit %d: %v", pos*8+pos%8, err)
+			continue
+		}
+		if fe.Chunk != chunk || pos < fe.Offset || pos >= fe.Offset+fe.Length {
+			t.Errorf("bit %d in chunk %d: %v", pos*8+pos%8, chunk, err)
+		}
+		// Only the content of verified chunks is released.
+		if !bytes.HasPrefix(content, got) {
+			t.Errorf("bit %d: the content read differs", pos*8+pos%8)
+		}
+		if verr := cbrotli.VerifyFile(bytes.NewReader(file)); verr == nil || verr.Error() != err.Error() {
+			t.Errorf("bit %d: VerifyFile: %v, FrameReader: %v", pos*8+pos%8, verr, err)
+		}
+	}
+}
+
+func TestFrameReaderCorruptLength(t *testing.T) {
+	// The first chunk claims 1GiB, of which the file holds a few KiB.
+	file := bytes.Clone(framedGoldenFile)
+	binary.LittleEndian.PutUint32(file[8:], 1<<30)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	r := cbrotli.NewFrameReader(bytes.NewReader(file))
+	_, err := io.ReadAll(r)
+	r.Close()
+	runtime.ReadMemStats(&after)
+	var fe *cbrotli.FrameError
+	if !errors.As(err, &fe) || fe.Chunk != 0 || fe.Length != 8+1<<30 || fe.Reason != "truncated" {
+		t.Errorf("got %v", err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 16<<20 {
+		t.Errorf("allocated %d bytes", allocated)
+	}
+}
+
+// inspectGoldenContent is the content of the streams of testdata/inspect:
+// text, which makes compressed meta-blocks, and random bytes, which the
+// encoder stores in uncompressed ones at most qualities.
+func inspectGoldenContent() []byte {
+	content := wordSoup(184, 30000)
+	random := make([]byte, 20000)
+	rand.New(rand.NewSource(184)).Read(random)
+	return append(append(content, random...), wordSoup(185, 10000)...)
+}
+
+// testdata/inspect holds streams of inspectGoldenContent at several qualities,
+// with Flush calls in flushed.br, and their meta-blocks in blocks.json, as
+// reported by ReaderOptions.OnBlockBoundary.
+//
+//go:embed testdata/inspect
+var inspectGolden embed.FS
+
+func inspectGoldenBlocks(t *testing.T) map[string][]cbrotli.BlockInfo {
+	t.Helper()
+	data, err := inspectGolden.ReadFile("testdata/inspect/blocks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var golden map[string][]cbrotli.BlockInfo
+	if err := json.Unmarshal(data, &golden); err != nil {
+		t.Fatal(err)
+	}
+	return golden
+}
+
+func TestInspectStreamGolden(t *testing.T) {
+	content := inspectGoldenContent()
+	for name, want := range inspectGoldenBlocks(t) {
+		stream, err := inspectGolden.ReadFile("testdata/inspect/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := checkCompressedData(stream, content); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		blocks, err := cbrotli.InspectStream(stream)
+		if err != nil || !slices.Equal(blocks, want) {
+			t.Errorf("%s: %+v, %v", name, blocks, err)
+		}
+		var streamed []cbrotli.BlockInfo
+		err = cbrotli.InspectReader(iotest.OneByteReader(bytes.NewReader(stream)), func(info cbrotli.BlockInfo) {
+			streamed = append(streamed, info)
+		})
+		if err != nil || !slices.Equal(streamed, want) {
+			t.Errorf("%s: InspectReader: %+v, %v", name, streamed, err)
+		}
+		// The decoder agrees.
+		var decoded []cbrotli.BlockInfo
+		r := cbrotli.NewReaderWithOptions(bytes.NewReader(stream), cbrotli.ReaderOptions{
+			OnBlockBoundary: func(_, _ int64, info cbrotli.BlockInfo) { decoded = append(decoded, info) },
+		})
+		io.Copy(io.Discard, r)
+		r.Close()
+		if !slices.Equal(decoded, want) {
+			t.Errorf("%s: OnBlockBoundary: %+v", name, decoded)
+		}
+	}
+}
+
+func TestInspectStreamErrors(t *testing.T) {
+	want := inspectGoldenBlocks(t)["flushed.br"]
+	stream, err := inspectGolden.ReadFile("testdata/inspect/flushed.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Truncated streams report the meta-blocks completed before the cut.
+	for _, cut := range []int{0, 1, len(stream) / 3, len(stream) / 2, len(stream) - 1} {
+		blocks, err := cbrotli.InspectStream(stream[:cut])
+		complete := 0
+		for complete < len(want) && want[complete].CompressedOffset*8+int64(want[complete].StartBit)+want[complete].CompressedBits <= int64(cut)*8 {
+			complete++
+		}
+		if err != cbrotli.ErrTruncated || !slices.Equal(blocks, want[:complete]) {
+			t.Errorf("cut at %d: %d blocks, %v", cut, len(blocks), err)
+		}
+	}
+	// The reserved bit of the metadata meta-block that follows the first
+	// Flush: ISLAST and MNIBBLES come before it.
+	if want[1].Type != cbrotli.BlockMetadata {
+		t.Fatalf("block 1 is %v", want[1].Type)
+	}
+	corrupt := bytes.Clone(stream)
+	bit := want[1].CompressedOffset*8 + int64(want[1].StartBit) + 3
+	corrupt[bit/8] ^= 1 << (bit % 8)
+	blocks, err := cbrotli.InspectStream(corrupt)
+	var ie *cbrotli.InspectError
+	if !errors.As(err, &ie) || ie.Bit != bit+1 || !slices.Equal(blocks, want[:1]) {
+		t.Errorf("reserved bit: %+v, %v", blocks, err)
+	}
+	blocks, err = cbrotli.InspectStream(append(bytes.Clone(stream), 0))
+	if !errors.As(err, &ie) || ie.Bit != int64(len(stream))*8 || !slices.Equal(blocks, want) {
+		t.Errorf("trailing byte: %d blocks, %v", len(blocks), err)
+	}
+	errRead := errors.New("read failed")
+	err = cbrotli.InspectReader(io.MultiReader(bytes.NewReader(stream[:100]), iotest.ErrReader(errRead)), func(cbrotli.BlockInfo) {})
+	if err != errRead {
+		t.Errorf("read error: %v", err)
+	}
+}
+
+// bitWriter writes bits least significant first, for streams that the encoder
+// does not produce.
+type bitWriter struct {
+	data  []byte
+	nbits int
+}
+
+func (w *bitWriter) bits(n int, v uint64) {
+	for i := 0; i < n; i++ {
+		if w.nbits%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		w.data[len(w.data)-1] |= byte(v>>i&1) << (w.nbits % 8)
+		w.nbits++
+	}
+}
+
+func (w *bitWriter) align() { w.bits(-w.nbits&7, 0) }
+
+func TestInspectStreamLargeWindow(t *testing.T) {
+	var w bitWriter
+	// WBITS of a large window stream: 1, 000, 001, 0, then 30 in 6 bits.
+	w.bits(7, 0b0010001)
+	w.bits(1, 0)
+	w.bits(6, 30)
+	// An uncompressed meta-block of 5 bytes: ISLAST, MNIBBLES, MLEN-1,
+	// ISUNCOMPRESSED.
+	w.bits(1, 0)
+	w.bits(2, 0)
+	w.bits(16, 4)
+	w.bits(1, 1)
+	w.align()
+	w.data = append(w.data, "hello"...)
+	w.nbits += 5 * 8
+	// ISLAST, ISLASTEMPTY.
+	w.bits(2, 3)
+	w.align()
+
+	want := []cbrotli.BlockInfo{
+		// 20 bits of header and 6 of padding before the data.
+		{Type: cbrotli.BlockUncompressed, StartBit: 6, CompressedOffset: 1, CompressedBits: 26 + 5*8, Length: 5},
+		{Type: cbrotli.BlockEmpty, Last: true, CompressedOffset: 10, CompressedBits: 2, DecompressedOffset: 5},
+	}
+	blocks, err := cbrotli.InspectStream(w.data)
+	if err != nil || !slices.Equal(blocks, want) {
+		t.Errorf("%+v, %v", blocks, err)
+	}
+}
+
+func TestEstimateDecoderMemoryRange(t *testing.T) {
+	for _, c := range []struct{ windowBits, clamped int }{
+		{-1, 10}, {0, 10}, {9, 10}, {31, 30}, {64, 30}, {1000, 30},
+	} {
+		got, want := cbrotli.EstimateDecoderMemory(c.windowBits), cbrotli.EstimateDecoderMemory(c.clamped)
+		if got != want {
+			t.Errorf("EstimateDecoderMemory(%d) = %d, want %d", c.windowBits, got, want)
+		}
+	}
+	small, large := cbrotli.EstimateDecoderMemory(10), cbrotli.EstimateDecoderMemory(30)
+	if small <= 0 || large < small+(1<<30)-(1<<10) {
+		t.Errorf("EstimateDecoderMemory(10) = %d, EstimateDecoderMemory(30) = %d", small, large)
+	}
+}
+
+// contextDictionary returns a serialized dictionary using most features of
+// the format: a raw dictionary, a word list, a transform list with
+// parameters, two static dictionaries and a context map.
+func contextDictionary() []byte {
+	d := []byte{0x91, 0, 5}
+	d = append(d, "hello"...)
+	// One word list: two words of length 4.
+	d = append(d, 1, 1)
+	d = append(d, make([]byte, 27)...)
+	d = append(d, "abcdefgh"...)
+	// One transform list: stringlets " " and "", then an identity transform
+	// and a shift of the first letter by 5 followed by " ".
+	d = append(d, 1, 3, 0, 1, ' ', 0)
+	d = append(d, 2, 1, 0, 1, 1, 21, 0)
+	d = append(d, 0, 0, 5, 0)
+	// Two dictionaries: the custom lists and the built-in ones, selected
+	// by a context map.
+	d = append(d, 2, 0, 0, 1, 1, 1)
+	for i := 0; i < 64; i++ {
+		d = append(d, byte(i%2))
+	}
+	return d
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli/internal/decoder"
+)
+
+// The pure-Go decoder of builds without cgo must agree with C-Brotli.
+
+func TestDecoderTables(t *testing.T) {
+	for code, name := range decoderErrorNames {
+		if got, want := (DecoderError{Code: code}).Error(), "cbrotli: "+name; got != want {
+			t.Errorf("code %d: %q, want %q", code, got, want)
+		}
+	}
+	if !bytes.Equal(decoder.ContextLookupTable(), contextLookupTable()) {
+		t.Error("context lookup tables differ")
+	}
+	c := builtinDictionary()
+	data, offsets, sizeBits := decoder.Dictionary()
+	if !bytes.Equal(data, c.data) || decoder.NumTransforms() != c.numTransforms {
+		t.Fatal("built-in dictionaries differ")
+	}
+	for i := range sizeBits {
+		if uint8(sizeBits[i]) != c.sizeBits[i] || (c.sizeBits[i] != 0 && uint32(offsets[i]) != c.offsets[i]) {
+			t.Errorf("words of length %d differ", i)
+		}
+	}
+	word := []byte("time")
+	dst, want := make([]byte, maxTransformedWordLength), make([]byte, maxTransformedWordLength)
+	for idx := 0; idx < c.numTransforms; idx++ {
+		if n, m := decoder.Transform(dst, word, idx), c.transform(want, word, idx); !bytes.Equal(dst[:n], want[:m]) {
+			t.Errorf("transform %d: %q, want %q", idx, dst[:n], want[:m])
+		}
+	}
+}
+
+func TestDecoderTruncated(t *testing.T) {
+	content := textLikeData(20000)
+	encoded, err := Encode(content, WriterOptions{Quality: 5, LGWin: 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n <= len(encoded); n++ {
+		d := decoder.New(bytes.NewReader(encoded[:n]))
+		p := make([]byte, len(content)+1)
+		written, code := d.Decode(p)
+		switch {
+		case n == len(encoded):
+			if code != decoder.Done || !bytes.Equal(p[:written], content) {
+				t.Errorf("complete stream: %d bytes, code %d", written, code)
+			}
+		case code >= 0:
+			t.Errorf("%d bytes: code %d", n, code)
+		case code != decoder.TruncatedInput && code != decoder.ReadAfterEnd && !d.Overrun():
+			t.Errorf("%d bytes: code %d within the input", n, code)
+		}
+		d.Close()
+	}
+}
@@ -0,0 +1,646 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// DictionaryID returns the identifier of a dictionary used by Compression
+// Dictionary Transport (RFC 9842): the SHA-256 hash of its bytes.
+func DictionaryID(dictionary []byte) [32]byte {
+	return sha256.Sum256(dictionary)
+}
+
+// FormatDictionaryID returns id as a Structured Field byte sequence (RFC 8941),
+// i.e. base64 between colons, as in Available-Dictionary headers.
+func FormatDictionaryID(id [32]byte) string {
+	return ":" + base64.StdEncoding.EncodeToString(id[:]) + ":"
+}
+
+// ParseDictionaryID parses an identifier formatted by FormatDictionaryID;
+// surrounding spaces are ignored.
+func ParseDictionaryID(s string) ([32]byte, error) {
+	var id [32]byte
+	s = strings.Trim(s, " \t")
+	if len(s) < 2 || s[0] != ':' || s[len(s)-1] != ':' {
+		return id, fmt.Errorf("cbrotli: dictionary ID %q is not a byte sequence", s)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s[1 : len(s)-1])
+	if err != nil {
+		return id, fmt.Errorf("cbrotli: dictionary ID %q: %v", s, err)
+	}
+	if len(decoded) != len(id) {
+		return id, fmt.Errorf("cbrotli: dictionary ID %q has %d bytes, want %d", s, len(decoded), len(id))
+	}
+	copy(id[:], decoded)
+	return id, nil
+}
+
+// Dictionary limits of C-Brotli.
+const (
+	// MaxRawDictionarySize is the size of the largest raw dictionary: 2GiB
+	// on 64-bit platforms, 128MiB on 32-bit ones.
+	MaxRawDictionarySize = 1 << (23 + (32<<(^uint(0)>>63))/8)
+	// MaxSerializedPrefixSize is the size of the largest raw dictionary
+	// contained in a serialized dictionary.
+	MaxSerializedPrefixSize = 1<<30 - 1
+	// MaxDictionaries is the number of raw dictionaries, including those
+	// contained in serialized ones, that can be attached to an encoder or a
+	// decoder.
+	MaxDictionaries = 15
+)
+
+var (
+	// ErrDictionaryEmpty is returned for dictionaries of zero length.
+	ErrDictionaryEmpty = errors.New("cbrotli: empty dictionary")
+	// ErrDictionaryTooLarge is returned (wrapped, with the limit) for
+	// dictionaries over MaxRawDictionarySize or MaxSerializedPrefixSize.
+	ErrDictionaryTooLarge = errors.New("cbrotli: dictionary too large")
+	// ErrTooManyDictionaries is returned (wrapped) when more than
+	// MaxDictionaries raw dictionaries are attached.
+	ErrTooManyDictionaries = errors.New("cbrotli: too many dictionaries")
+)
+
+// checkDictionarySize validates the size of a dictionary before it is passed
+// to C-Brotli; serialized dictionaries are validated by C-Brotli itself.
+func checkDictionarySize(size int, dictionaryType DictionaryType) error {
+	if size == 0 {
+		return ErrDictionaryEmpty
+	}
+	if err := checkLength(int64(size)); err != nil {
+		return err
+	}
+	if dictionaryType == DtRaw && size > MaxRawDictionarySize {
+		return fmt.Errorf("%w: %d bytes, the limit is %d", ErrDictionaryTooLarge,
+			size, MaxRawDictionarySize)
+	}
+	return nil
+}
+
+// prefixCount returns the number of raw dictionaries in a dictionary, as
+// counted towards MaxDictionaries; a serialized dictionary may have none.
+func prefixCount(data []byte, dictionaryType DictionaryType) int {
+	if dictionaryType != DtSerialized {
+		return 1
+	}
+	if len(data) < 3 {
+		return 0
+	}
+	if size, n := binary.Uvarint(data[2:]); n > 0 && size == 0 {
+		return 0
+	}
+	return 1
+}
+
+// checkDictionaryCount validates the number of raw dictionaries attached at
+// once; existing is the number attached by other means.
+func checkDictionaryCount(existing int, dictionaries []Dictionary) error {
+	n := existing
+	for _, d := range dictionaries {
+		n += prefixCount(d.Data, d.Type)
+	}
+	if n > MaxDictionaries {
+		return fmt.Errorf("%w: %d, the limit is %d", ErrTooManyDictionaries, n, MaxDictionaries)
+	}
+	return nil
+}
+
+func checkSerializedPrefixSize(size int) error {
+	if size > MaxSerializedPrefixSize {
+		return fmt.Errorf("%w: raw dictionary of %d bytes, the limit is %d",
+			ErrDictionaryTooLarge, size, MaxSerializedPrefixSize)
+	}
+	return nil
+}
+
+// Limits of the serialized shared dictionary format, see
+// c/common/shared_dictionary.c.
+const (
+	serializedMagic0 = 0x91
+	serializedMagic1 = 0x00
+
+	minDictionaryWordLength = 4
+	maxDictionaryWordLength = 31
+	maxDictionarySizeBits   = 15
+)
+
+// SerializedDictionaryOptions configures BuildSerializedDictionary.
+type SerializedDictionaryOptions struct {
+	// Words, if not nil, replaces the built-in static dictionary with a
+	// custom word list. Words must be 4 to 31 bytes long; there can be at
+	// most 32768 words of each length.
+	Words [][]byte
+	// IdentityTransformOnly makes the custom word list usable only verbatim;
+	// by default words are referenced with the 121 built-in transforms (case
+	// changes, prefixes and suffixes) like the built-in static dictionary.
+	// It has no effect without Words.
+	IdentityTransformOnly bool
+}
+
+var errEmptySerializedDictionary = errors.New("cbrotli: serialized dictionary has neither raw content nor words")
+
+// BuildSerializedDictionary wraps raw (an LZ77 prefix dictionary, as accepted
+// by NewPreparedDictionary with DtRaw) into the serialized shared dictionary
+// format, optionally with a custom static dictionary. The result is accepted
+// by NewPreparedDictionary with DtSerialized and NewSerializedDecoderDictionary.
+//
+// Serialized dictionaries are supported only if C-Brotli is compiled with
+// BROTLI_EXPERIMENTAL defined; otherwise they can be built, but not used.
+// Without cgo, decoders support only those without custom words or transforms.
+func BuildSerializedDictionary(raw []byte, options SerializedDictionaryOptions) ([]byte, error) {
+	if err := checkSerializedPrefixSize(len(raw)); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 && len(options.Words) == 0 {
+		return nil, errEmptySerializedDictionary
+	}
+	out := []byte{serializedMagic0, serializedMagic1}
+	out = binary.AppendUvarint(out, uint64(len(raw)))
+	out = append(out, raw...)
+	if len(options.Words) == 0 {
+		// No word and transform lists: the built-in ones are used.
+		return append(out, 0, 0), nil
+	}
+
+	// NUM_WORD_LISTS and the word list.
+	out = append(out, 1)
+	byLength, err := groupWords(options.Words)
+	if err != nil {
+		return nil, err
+	}
+	if out, err = appendWordList(out, &byLength); err != nil {
+		return nil, err
+	}
+
+	// NUM_TRANSFORM_LISTS; without lists, the built-in transforms are used.
+	if options.IdentityTransformOnly {
+		out = append(out, 1)
+		// PREFIX_SUFFIX_LENGTH and the terminating empty stringlet, then one
+		// transform: empty prefix, identity, empty suffix.
+		out = binary.LittleEndian.AppendUint16(out, 1)
+		out = append(out, 0)
+		out = append(out, 1, 0, 0, 0)
+	} else {
+		out = append(out, 0)
+	}
+
+	// NUM_DICTIONARIES, the (words, transforms) pair and CONTEXT_ENABLED.
+	out = append(out, 1, 0, 0, 0)
+	return out, nil
+}
+
+// wordsByLength groups the words of a word list by length.
+type wordsByLength [maxDictionaryWordLength + 1][][]byte
+
+func groupWords(words [][]byte) (wordsByLength, error) {
+	var byLength wordsByLength
+	for i, word := range words {
+		if len(word) < minDictionaryWordLength || len(word) > maxDictionaryWordLength {
+			return byLength, fmt.Errorf("cbrotli: word %d is %d bytes long, want %d to %d",
+				i, len(word), minDictionaryWordLength, maxDictionaryWordLength)
+		}
+		byLength[len(word)] = append(byLength[len(word)], word)
+	}
+	return byLength, nil
+}
+
+// appendWordList appends the SIZE_BITS_BY_LENGTH table and the words, sorted by
+// length; the number of words of each length is padded to a power of two by
+// repeating the last one.
+func appendWordList(out []byte, byLength *wordsByLength) ([]byte, error) {
+	var sizeBits [maxDictionaryWordLength + 1]int
+	for length, group := range byLength {
+		if len(group) == 0 {
+			continue
+		}
+		if len(group) > 1<<maxDictionarySizeBits {
+			return nil, fmt.Errorf("cbrotli: %d words of length %d exceed the limit of %d",
+				len(group), length, 1<<maxDictionarySizeBits)
+		}
+		sizeBits[length] = bits.Len(uint(len(group) - 1))
+		if sizeBits[length] == 0 {
+			// A single word still needs a non-zero size: 0 bits means none.
+			sizeBits[length] = 1
+		}
+	}
+	for length := minDictionaryWordLength; length <= maxDictionaryWordLength; length++ {
+		out = append(out, byte(sizeBits[length]))
+	}
+	for length := minDictionaryWordLength; length <= maxDictionaryWordLength; length++ {
+		group := byLength[length]
+		if len(group) == 0 {
+			continue
+		}
+		for i := 0; i < 1<<uint(sizeBits[length]); i++ {
+			out = append(out, group[min(i, len(group)-1)]...)
+		}
+	}
+	return out, nil
+}
+
+var (
+	// ErrTruncatedDictionary is returned (wrapped) by ParseSerializedDictionary
+	// for data that ends before the dictionary does.
+	ErrTruncatedDictionary = errors.New("cbrotli: truncated serialized dictionary")
+	// ErrMalformedDictionary is returned (wrapped) by ParseSerializedDictionary
+	// for data that is not a valid serialized dictionary.
+	ErrMalformedDictionary = errors.New("cbrotli: malformed serialized dictionary")
+)
+
+// More limits of the serialized shared dictionary format.
+const (
+	numDictionaryContexts = 64
+	numTransformTypes     = 23
+	transformShiftFirst   = 21
+	transformShiftAll     = 22
+	maxStringlets         = 256
+)
+
+// PrefixInfo describes a raw dictionary contained in a serialized one.
+type PrefixInfo struct {
+	// Offset and Size locate the raw dictionary in the serialized data.
+	Offset, Size int
+}
+
+// DictionaryInfo is the result of ParseSerializedDictionary.
+type DictionaryInfo struct {
+	// Version is the format version, the byte following the magic byte;
+	// only version 0 exists.
+	Version int
+	// Size is the number of bytes of the serialized dictionary; C-Brotli
+	// ignores the data that follows.
+	Size int
+	// Prefixes are the raw (LZ77) dictionaries; there is at most one.
+	Prefixes []PrefixInfo
+	// WordLists and TransformLists are the numbers of custom word and
+	// transform lists.
+	WordLists, TransformLists int
+	// Dictionaries is the number of static dictionaries, i.e. (word list,
+	// transform list) pairs selected by the context; 1 means the built-in
+	// static dictionary when there are no custom lists.
+	Dictionaries int
+	// ContextBased reports whether the static dictionary is selected by the
+	// context of each word.
+	ContextBased bool
+}
+
+// CustomWords reports whether the dictionary contains custom word lists.
+func (i DictionaryInfo) CustomWords() bool { return i.WordLists != 0 }
+
+// CustomTransforms reports whether the dictionary contains custom transforms.
+func (i DictionaryInfo) CustomTransforms() bool { return i.TransformLists != 0 }
+
+// String returns a one-line summary of the dictionary, e.g.
+//
+//	v0 size=1033 prefixes=[3+1000] word_lists=1 transform_lists=0 dictionaries=1 context=false
+func (i DictionaryInfo) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "v%d size=%d prefixes=[", i.Version, i.Size)
+	for j, p := range i.Prefixes {
+		if j != 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%d+%d", p.Offset, p.Size)
+	}
+	fmt.Fprintf(&b, "] word_lists=%d transform_lists=%d dictionaries=%d context=%t",
+		i.WordLists, i.TransformLists, i.Dictionaries, i.ContextBased)
+	return b.String()
+}
+
+// ParseSerializedDictionary validates a serialized shared dictionary, as built
+// by BuildSerializedDictionary or the C tooling, and describes its contents.
+// It applies the same checks as C-Brotli, but does not need C-Brotli to be
+// compiled with BROTLI_EXPERIMENTAL. Errors wrap ErrTruncatedDictionary or
+// ErrMalformedDictionary and give the offset of the problem.
+func ParseSerializedDictionary(data []byte) (DictionaryInfo, error) {
+	p := &serializedParser{data: data}
+	var info DictionaryInfo
+	if len(data) < 2 {
+		return info, p.truncated("magic")
+	}
+	if data[0] != serializedMagic0 {
+		return info, p.malformed("magic byte 0x%02x", data[0])
+	}
+	if data[1] != serializedMagic1 {
+		return info, p.malformed("version %d", data[1])
+	}
+	p.pos = 2
+
+	// LZ77_DICTIONARY_LENGTH and the raw dictionary.
+	size, err := p.varint32("LZ77_DICTIONARY_LENGTH")
+	if err != nil {
+		return info, err
+	}
+	if size != 0 {
+		if size > MaxSerializedPrefixSize {
+			return info, p.malformed("raw dictionary of %d bytes over the limit of %d",
+				size, MaxSerializedPrefixSize)
+		}
+		if err := p.skip(int(size), "raw dictionary"); err != nil {
+			return info, err
+		}
+		info.Prefixes = []PrefixInfo{{Offset: p.pos - int(size), Size: int(size)}}
+	}
+
+	n, err := p.count("NUM_WORD_LISTS", numDictionaryContexts)
+	if err != nil {
+		return info, err
+	}
+	info.WordLists = n
+	for i := 0; i < info.WordLists; i++ {
+		if err := p.wordList(); err != nil {
+			return info, err
+		}
+	}
+	if n, err = p.count("NUM_TRANSFORM_LISTS", numDictionaryContexts); err != nil {
+		return info, err
+	}
+	info.TransformLists = n
+	for i := 0; i < info.TransformLists; i++ {
+		if err := p.transformList(); err != nil {
+			return info, err
+		}
+	}
+
+	info.Dictionaries = 1
+	if info.WordLists != 0 || info.TransformLists != 0 {
+		if info.Dictionaries, err = p.count("NUM_DICTIONARIES", numDictionaryContexts); err != nil {
+			return info, err
+		}
+		if info.Dictionaries == 0 {
+			return info, p.malformed("no dictionaries")
+		}
+		for i := 0; i < info.Dictionaries; i++ {
+			// An index equal to the number of lists selects the built-in one.
+			if _, err := p.count("word list index", info.WordLists); err != nil {
+				return info, err
+			}
+			if _, err := p.count("transform list index", info.TransformLists); err != nil {
+				return info, err
+			}
+		}
+		enabled, err := p.count("CONTEXT_ENABLED", 1)
+		if err != nil {
+			return info, err
+		}
+		info.ContextBased = enabled == 1
+		if info.ContextBased {
+			for i := 0; i < numDictionaryContexts; i++ {
+				if _, err := p.count("context map", info.Dictionaries-1); err != nil {
+					return info, err
+				}
+			}
+		}
+	}
+	info.Version = int(data[1])
+	info.Size = p.pos
+	return info, nil
+}
+
+// serializedParser reads the fields of a serialized dictionary, mirroring
+// ParseDictionary in c/common/shared_dictionary.c.
+type serializedParser struct {
+	data []byte
+	pos  int
+}
+
+func (p *serializedParser) truncated(field string) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrTruncatedDictionary, field, p.pos)
+}
+
+func (p *serializedParser) malformed(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrMalformedDictionary, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *serializedParser) byte(field string) (byte, error) {
+	if p.pos >= len(p.data) {
+		return 0, p.truncated(field)
+	}
+	p.pos++
+	return p.data[p.pos-1], nil
+}
+
+// count reads a byte that must not exceed limit.
+func (p *serializedParser) count(field string, limit int) (int, error) {
+	b, err := p.byte(field)
+	if err != nil {
+		return 0, err
+	}
+	if int(b) > limit {
+		p.pos--
+		return 0, p.malformed("%s %d over the limit of %d", field, b, limit)
+	}
+	return int(b), nil
+}
+
+func (p *serializedParser) skip(n int, field string) error {
+	if n > len(p.data)-p.pos {
+		return p.truncated(field)
+	}
+	p.pos += n
+	return nil
+}
+
+// varint32 reads a little-endian base-128 varint of at most 32 bits.
+func (p *serializedParser) varint32(field string) (uint32, error) {
+	var v uint32
+	for shift := 0; ; shift += 7 {
+		b, err := p.byte(field)
+		if err != nil {
+			return 0, err
+		}
+		if shift == 28 && b > 15 {
+			return 0, p.malformed("%s over 32 bits", field)
+		}
+		v |= uint32(b&127) << shift
+		if b < 128 {
+			return v, nil
+		}
+	}
+}
+
+// wordList skips SIZE_BITS_BY_LENGTH and the words.
+func (p *serializedParser) wordList() error {
+	start := p.pos
+	if err := p.skip(maxDictionaryWordLength-minDictionaryWordLength+1, "SIZE_BITS_BY_LENGTH"); err != nil {
+		return err
+	}
+	size := 0
+	for length := minDictionaryWordLength; length <= maxDictionaryWordLength; length++ {
+		sizeBits := int(p.data[start+length-minDictionaryWordLength])
+		if sizeBits > maxDictionarySizeBits {
+			p.pos = start + length - minDictionaryWordLength
+			return p.malformed("SIZE_BITS_BY_LENGTH %d over the limit of %d", sizeBits, maxDictionarySizeBits)
+		}
+		if sizeBits != 0 {
+			size += length << sizeBits
+		}
+	}
+	return p.skip(size, "words")
+}
+
+// transformList skips the prefix/suffix stringlets and the transforms.
+func (p *serializedParser) transformList() error {
+	if p.pos+2 > len(p.data) {
+		return p.truncated("PREFIX_SUFFIX_LENGTH")
+	}
+	length := int(binary.LittleEndian.Uint16(p.data[p.pos:]))
+	p.pos += 2
+	if length == 0 {
+		return p.malformed("empty prefix/suffix table")
+	}
+	// The table is followed at least by NUM_TRANSFORMS.
+	if length >= len(p.data)-p.pos {
+		return p.truncated("prefix/suffix table")
+	}
+	table, stringlets := p.data[p.pos:p.pos+length], 0
+	for offset := 0; ; {
+		n := int(table[offset])
+		stringlets++
+		offset++
+		if n == 0 {
+			if offset != length {
+				return p.malformed("prefix/suffix table ends %d bytes early", length-offset)
+			}
+			break
+		}
+		if stringlets >= maxStringlets {
+			return p.malformed("more than %d prefixes and suffixes", maxStringlets)
+		}
+		offset += n
+		if offset >= length {
+			return p.malformed("prefix/suffix table is not terminated")
+		}
+	}
+	p.pos += length
+
+	n := int(p.data[p.pos])
+	p.pos++
+	transforms := p.pos
+	if err := p.skip(3*n, "transforms"); err != nil {
+		return err
+	}
+	hasParams := false
+	for i := 0; i < n; i++ {
+		prefix, kind, suffix := p.data[transforms+3*i], p.data[transforms+3*i+1], p.data[transforms+3*i+2]
+		if int(prefix) >= stringlets || int(suffix) >= stringlets || kind >= numTransformTypes {
+			p.pos = transforms + 3*i
+			return p.malformed("transform %d is invalid", i)
+		}
+		hasParams = hasParams || kind == transformShiftFirst || kind == transformShiftAll
+	}
+	if !hasParams {
+		return nil
+	}
+	params := p.pos
+	if err := p.skip(2*n, "transform parameters"); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		kind := p.data[transforms+3*i+1]
+		if kind != transformShiftFirst && kind != transformShiftAll &&
+			(p.data[params+2*i] != 0 || p.data[params+2*i+1] != 0) {
+			p.pos = params + 2*i
+			return p.malformed("transform %d has parameters", i)
+		}
+	}
+	return nil
+}
+
+// staticDictionary is the built-in static dictionary (RFC 7932, Appendix A)
+// and transforms (Appendix B); data must not be modified.
+type staticDictionary struct {
+	data          []byte
+	sizeBits      [32]uint8
+	offsets       [32]uint32
+	numTransforms int
+}
+
+// maxTransformedWordLength bounds the length of built-in dictionary words
+// with the longest prefix and suffix of the built-in transforms.
+const maxTransformedWordLength = 64
+
+// builtinWords returns the words of the built-in static dictionary grouped
+// by length; they reference the data of builtinDictionary.
+func builtinWords() wordsByLength {
+	var byLength wordsByLength
+	d := builtinDictionary()
+	for length := minDictionaryWordLength; length <= maxDictionaryWordLength; length++ {
+		sizeBits := int(d.sizeBits[length])
+		if sizeBits == 0 {
+			continue
+		}
+		offset := int(d.offsets[length])
+		for i := 0; i < 1<<sizeBits; i++ {
+			byLength[length] = append(byLength[length], d.data[offset+i*length:offset+(i+1)*length])
+		}
+	}
+	return byLength
+}
+
+// ExtendBuiltinDictionary returns a serialized dictionary whose static
+// dictionary is the built-in one followed by words, used with the built-in
+// transforms. The built-in words keep their indices, so content that does not
+// use the custom words compresses as well as with the built-in dictionary.
+//
+// Words must be 4 to 31 bytes long; words already in the built-in dictionary
+// or repeated are ignored. Including the built-in words, there can be at most
+// 32768 words of each length. Each length with custom words costs up to as
+// many bytes as its built-in words (a bucket must hold a power of two words),
+// so the result is at least as large as the built-in dictionary (122784
+// bytes).
+//
+// The result is accepted by NewPreparedDictionary with DtSerialized and
+// NewSerializedDecoderDictionary; see BuildSerializedDictionary for
+// requirements.
+func ExtendBuiltinDictionary(words [][]byte) ([]byte, error) {
+	custom, err := groupWords(words)
+	if err != nil {
+		return nil, err
+	}
+	byLength := builtinWords()
+	for length, group := range custom {
+		builtin := len(byLength[length])
+		seen := make(map[string]bool, len(group))
+		for _, word := range group {
+			if seen[string(word)] || containsWord(byLength[length][:builtin], word) {
+				continue
+			}
+			seen[string(word)] = true
+			byLength[length] = append(byLength[length], word)
+		}
+		if n := len(byLength[length]); n > 1<<maxDictionarySizeBits {
+			return nil, fmt.Errorf("cbrotli: %d words of length %d and %d built-in ones exceed the limit of %d",
+				n-builtin, length, builtin, 1<<maxDictionarySizeBits)
+		}
+	}
+	out := []byte{serializedMagic0, serializedMagic1, 0}
+	// NUM_WORD_LISTS and the word list.
+	out = append(out, 1)
+	if out, err = appendWordList(out, &byLength); err != nil {
+		return nil, err
+	}
+	// NUM_TRANSFORM_LISTS (the built-in transforms), NUM_DICTIONARIES, the
+	// (words, transforms) pair and CONTEXT_ENABLED.
+	return append(out, 0, 1, 0, 0, 0), nil
+}
+
+func containsWord(words [][]byte, word []byte) bool {
+	for _, w := range words {
+		if bytes.Equal(w, word) {
+			return true
+		}
+	}
+	return false
+}
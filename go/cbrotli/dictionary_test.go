@@ -0,0 +1,36 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDictionarySizeLimits(t *testing.T) {
+	for _, tc := range []struct {
+		size           int
+		dictionaryType DictionaryType
+		want           error
+	}{
+		{0, DtRaw, ErrDictionaryEmpty},
+		{0, DtSerialized, ErrDictionaryEmpty},
+		{1, DtRaw, nil},
+		{MaxRawDictionarySize, DtRaw, nil},
+		{MaxRawDictionarySize + 1, DtRaw, ErrDictionaryTooLarge},
+		{MaxRawDictionarySize + 1, DtSerialized, nil},
+	} {
+		if err := checkDictionarySize(tc.size, tc.dictionaryType); !errors.Is(err, tc.want) {
+			t.Errorf("checkDictionarySize(%d, %d)=%v, want %v", tc.size, tc.dictionaryType, err, tc.want)
+		}
+	}
+	if err := checkSerializedPrefixSize(MaxSerializedPrefixSize); err != nil {
+		t.Errorf("checkSerializedPrefixSize(%d)=%v", MaxSerializedPrefixSize, err)
+	}
+	if err := checkSerializedPrefixSize(MaxSerializedPrefixSize + 1); !errors.Is(err, ErrDictionaryTooLarge) {
+		t.Errorf("checkSerializedPrefixSize(%d)=%v, want ErrDictionaryTooLarge", MaxSerializedPrefixSize+1, err)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2016 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package cbrotli compresses and decompresses data with C-Brotli library.
+//
+// The package compiles the C sources bundled with it, so that it needs no
+// installed library; with the libbrotli_system build tag, it links the
+// system libraries found with pkg-config instead. Version reports the
+// version in use.
+//
+// Without cgo (CGO_ENABLED=0, a cross-compiler without a C toolchain, or
+// GOOS=js and wasip1), the package builds with a pure-Go decoder instead, so
+// that programs that only decode still work. That decoder reads input ahead in
+// 4KiB chunks, which makes it unsuited to interactive streams such as those of
+// Conn, and it does not support the custom words and transforms of serialized
+// dictionaries (see NewSerializedDecoderDictionary). There is no encoder:
+// Writers and the Encode functions fail with ErrNotSupported. The package does
+// not load C-Brotli at run time; the separate dynbrotli module does, without
+// cgo, while with cgo the libbrotli_system build tag links a shared libbrotli.
+// In browsers, the pure-Go decoder is used rather than DecompressionStream,
+// which reports neither truncated input nor decoder error codes. The tests
+// run under both GOOS=js and wasip1 with test_wasm.sh.
+package cbrotli
@@ -0,0 +1,196 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package dynbrotli compresses and decompresses data with the C-Brotli shared
+// libraries, loaded at run time rather than linked with cgo, for builds that
+// cannot use cgo but run where libbrotlidec and libbrotlienc are installed.
+//
+// It is a separate module, so that cbrotli does not depend on purego, which
+// calls the C functions. The libraries are looked up in the system search
+// path on first use, unless UseDynamicBackend names their directory first:
+//
+//	if err := dynbrotli.UseDynamicBackend("/opt/brotli/lib"); err != nil {
+//		// Fall back to cbrotli, or fail.
+//	}
+//	content, err := dynbrotli.Decode(encoded)
+//
+// The API is a subset of that of cbrotli, whose errors it returns:
+// DecoderError, ErrTruncated and ErrWriterClosed. Loading works on Linux,
+// macOS, NetBSD and Windows; elsewhere, and when the libraries are missing,
+// functions fail with an error wrapping ErrUnavailable. Raw dictionaries need
+// C-Brotli 1.1.0 or later.
+package dynbrotli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"unsafe"
+)
+
+// ErrUnavailable is wrapped by the errors of the functions that need a
+// C-Brotli library that cannot be loaded.
+var ErrUnavailable = errors.New("dynbrotli: C-Brotli library is not available")
+
+// library holds the functions of the loaded C-Brotli libraries. BROTLI_BOOL
+// and the enums are int, i.e. int32, and size_t is uintptr.
+type library struct {
+	decoderCreateInstance   func(alloc, free, opaque uintptr) uintptr
+	decoderDestroyInstance  func(s uintptr)
+	decoderDecompressStream func(s uintptr, availableIn, nextIn, availableOut, nextOut, totalOut unsafe.Pointer) int32
+	decoderGetErrorCode     func(s uintptr) int32
+	decoderVersion          func() uint32
+	// decoderAttachDictionary is nil before C-Brotli 1.1.0.
+	decoderAttachDictionary func(s uintptr, kind int32, size uintptr, data unsafe.Pointer) int32
+
+	encoderCreateInstance  func(alloc, free, opaque uintptr) uintptr
+	encoderDestroyInstance func(s uintptr)
+	encoderSetParameter    func(s uintptr, param int32, value uint32) int32
+	encoderCompressStream  func(s uintptr, op int32, availableIn, nextIn, availableOut, nextOut, totalOut unsafe.Pointer) int32
+	encoderTakeOutput      func(s uintptr, size unsafe.Pointer) *byte
+	encoderHasMoreOutput   func(s uintptr) int32
+	encoderIsFinished      func(s uintptr) int32
+	// encoderErr is the error of loading the encoder library; decoding works
+	// without it.
+	encoderErr error
+}
+
+var (
+	mu      sync.Mutex
+	loaded  *library
+	loadErr error // of the default libraries, returned until UseDynamicBackend succeeds
+)
+
+// UseDynamicBackend loads the C-Brotli libraries in dir, or in the system
+// search path if dir is empty, and uses them from then on; Readers and Writers
+// created before keep the libraries they started with. A missing encoder
+// library is not an error: decoding works, and Writers fail. The libraries
+// are never unloaded.
+func UseDynamicBackend(dir string) error {
+	l, err := load(dir)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	loaded, loadErr = l, nil
+	return nil
+}
+
+// current returns the libraries in use, loading those of the system search
+// path the first time.
+func current() (*library, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if loaded == nil && loadErr == nil {
+		loaded, loadErr = load("")
+	}
+	return loaded, loadErr
+}
+
+// load opens the decoder and encoder libraries of dir.
+func load(dir string) (*library, error) {
+	l := &library{}
+	decoder, err := open(dir, decoderLibrary)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range []struct {
+		fptr any
+		name string
+	}{
+		{&l.decoderCreateInstance, "BrotliDecoderCreateInstance"},
+		{&l.decoderDestroyInstance, "BrotliDecoderDestroyInstance"},
+		{&l.decoderDecompressStream, "BrotliDecoderDecompressStream"},
+		{&l.decoderGetErrorCode, "BrotliDecoderGetErrorCode"},
+		{&l.decoderVersion, "BrotliDecoderVersion"},
+	} {
+		if err := decoder.bind(f.fptr, f.name); err != nil {
+			return nil, err
+		}
+	}
+	// Left nil if missing.
+	decoder.bind(&l.decoderAttachDictionary, "BrotliDecoderAttachDictionary")
+
+	encoder, err := open(dir, encoderLibrary)
+	if err != nil {
+		l.encoderErr = err
+		return l, nil
+	}
+	for _, f := range []struct {
+		fptr any
+		name string
+	}{
+		{&l.encoderCreateInstance, "BrotliEncoderCreateInstance"},
+		{&l.encoderDestroyInstance, "BrotliEncoderDestroyInstance"},
+		{&l.encoderSetParameter, "BrotliEncoderSetParameter"},
+		{&l.encoderCompressStream, "BrotliEncoderCompressStream"},
+		{&l.encoderTakeOutput, "BrotliEncoderTakeOutput"},
+		{&l.encoderHasMoreOutput, "BrotliEncoderHasMoreOutput"},
+		{&l.encoderIsFinished, "BrotliEncoderIsFinished"},
+	} {
+		if err := encoder.bind(f.fptr, f.name); err != nil {
+			l.encoderErr = err
+			break
+		}
+	}
+	return l, nil
+}
+
+// handle is an open library.
+type handle struct {
+	path string
+	h    uintptr
+}
+
+// open opens the library of the given file name in dir.
+func open(dir, name string) (handle, error) {
+	path := name
+	if dir != "" {
+		path = filepath.Join(dir, name)
+	}
+	h, err := openLibrary(path)
+	if err != nil {
+		return handle{}, fmt.Errorf("%w: %s: %v", ErrUnavailable, path, err)
+	}
+	return handle{path, h}, nil
+}
+
+// bind sets the function pointed to by fptr to call the C function name.
+func (h handle) bind(fptr any, name string) error {
+	sym, err := lookup(h.h, name)
+	if err != nil || sym == 0 {
+		return fmt.Errorf("%w: %s lacks %s", ErrUnavailable, h.path, name)
+	}
+	register(fptr, sym)
+	return nil
+}
+
+// Version returns the version of the decoder library, e.g. "1.1.0", loading
+// it if needed.
+func Version() (string, error) {
+	l, err := current()
+	if err != nil {
+		return "", err
+	}
+	v := l.decoderVersion()
+	return fmt.Sprintf("%d.%d.%d", v>>24, v>>12&0xfff, v&0xfff), nil
+}
+
+// frame holds the in/out arguments of the streaming functions. C stores the
+// addresses of pinned buffers in it, so it holds them as uintptr, and it is
+// pinned itself for the duration of each call.
+type frame struct {
+	availableIn, nextIn, availableOut, nextOut uintptr
+}
+
+// address returns the address of the first byte of p, or 0.
+func address(p []byte) uintptr {
+	if len(p) == 0 {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(&p[0]))
+}
@@ -0,0 +1,279 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package dynbrotli_test
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+	"github.com/google/brotli/go/cbrotli/dynbrotli"
+	"github.com/google/brotli/go/cbrotli/internal/conformance"
+)
+
+var libDir = flag.String("libdir", "", "directory of the C-Brotli libraries; the system search path if empty")
+
+// requireLibrary skips the test if the C-Brotli libraries cannot be loaded.
+func requireLibrary(t *testing.T) {
+	t.Helper()
+	if err := dynbrotli.UseDynamicBackend(*libDir); err != nil {
+		t.Skip(err)
+	}
+}
+
+// content returns n bytes of compressible text.
+func content(seed int64, n int) []byte {
+	words := []string{"brotli ", "stream ", "window ", "dictionary ", "quality ", "\n"}
+	rng := rand.New(rand.NewSource(seed))
+	var b []byte
+	for len(b) < n {
+		b = append(b, words[rng.Intn(len(words))]...)
+	}
+	return b[:n]
+}
+
+func TestEncodeDecode(t *testing.T) {
+	requireLibrary(t)
+	for _, size := range []int{0, 1, 1000, 300000} {
+		input := content(int64(size), size)
+		for _, quality := range []int{0, 5, 11} {
+			encoded, err := dynbrotli.Encode(input, dynbrotli.WriterOptions{Quality: quality})
+			if err != nil {
+				t.Fatalf("Encode (size %d, quality %d): %v", size, quality, err)
+			}
+			if size > 1000 && len(encoded) > size/5 {
+				t.Errorf("size %d, quality %d: encoded to %d bytes", size, quality, len(encoded))
+			}
+			decoded, err := dynbrotli.Decode(encoded)
+			if err != nil || !bytes.Equal(decoded, input) {
+				t.Errorf("Decode (size %d, quality %d): %d bytes, %v", size, quality, len(decoded), err)
+			}
+			// cbrotli decodes it too, with C-Brotli or its pure-Go decoder.
+			if decoded, err := cbrotli.Decode(encoded); err != nil || !bytes.Equal(decoded, input) {
+				t.Errorf("cbrotli.Decode (size %d, quality %d): %d bytes, %v", size, quality, len(decoded), err)
+			}
+		}
+	}
+}
+
+func TestEncodeMatchesCbrotli(t *testing.T) {
+	requireLibrary(t)
+	version, err := dynbrotli.Version()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != cbrotli.Version() {
+		t.Skipf("C-Brotli %s is loaded, cbrotli uses %q", version, cbrotli.Version())
+	}
+	input := content(1, 100000)
+	for _, options := range []dynbrotli.WriterOptions{{Quality: 5}, {Quality: 9, LGWin: 16}} {
+		got, err := dynbrotli.Encode(input, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: options.Quality, LGWin: options.LGWin})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%+v: Encode differs from cbrotli.Encode", options)
+		}
+	}
+}
+
+// TestDecodeStreams decodes the streams of the cbrotli tests.
+func TestDecodeStreams(t *testing.T) {
+	requireLibrary(t)
+	for _, name := range []string{"hello.br", "hello-short.br", "digits.br", "seekable.br", "seekable-plain.br", "truncated-q11.br"} {
+		encoded, err := os.ReadFile(filepath.Join("..", "testdata", "streams", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := cbrotli.Decode(encoded)
+		if err != nil {
+			t.Fatalf("cbrotli.Decode(%s): %v", name, err)
+		}
+		// Reads of a few bytes exercise the output of the decoder in parts.
+		r := dynbrotli.NewReader(bytes.NewReader(encoded))
+		got, err := io.ReadAll(io.LimitReader(r, 1000))
+		if err == nil {
+			var rest []byte
+			rest, err = io.ReadAll(r)
+			got = append(got, rest...)
+		}
+		r.Close()
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: decoded %d bytes, %v; want %d bytes", name, len(got), err, len(want))
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	requireLibrary(t)
+	input := content(2, 50000)
+	encoded, err := dynbrotli.Encode(input, dynbrotli.WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dynbrotli.Decode(encoded[:len(encoded)/2]); !errors.Is(err, cbrotli.ErrTruncated) {
+		t.Errorf("truncated: %v", err)
+	}
+	corrupt := bytes.Clone(encoded)
+	corrupt[0] = 0xff
+	var de cbrotli.DecoderError
+	if _, err := dynbrotli.Decode(corrupt); !errors.As(err, &de) || !errors.Is(err, cbrotli.ErrCorrupt) {
+		t.Errorf("corrupt: %v", err)
+	}
+	if _, err := dynbrotli.Decode(append(bytes.Clone(encoded), 0)); err == nil {
+		t.Error("trailing data: no error")
+	}
+
+	sentinel := errors.New("sentinel")
+	for _, cut := range []int{len(encoded) / 2, len(encoded)} {
+		src := io.MultiReader(bytes.NewReader(encoded[:cut]), &failingReader{sentinel})
+		r := dynbrotli.NewReader(src)
+		decoded, err := io.ReadAll(r)
+		if !errors.Is(err, sentinel) {
+			t.Errorf("source error after %d bytes: %v", cut, err)
+		}
+		if cut == len(encoded) && !bytes.Equal(decoded, input) {
+			t.Errorf("source error after the stream: decoded %d bytes, want %d", len(decoded), len(input))
+		}
+		if _, again := r.Read(make([]byte, 10)); again != err {
+			t.Errorf("second Read: %v, want %v", again, err)
+		}
+		r.Close()
+	}
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestReaderWithRawDictionary(t *testing.T) {
+	requireLibrary(t)
+	dictionary := content(3, 20000)
+	input := dictionary[5000:15000]
+	pd := cbrotli.NewPreparedDictionary(dictionary, cbrotli.DtRaw, 5)
+	defer pd.Close()
+	encoded, err := cbrotli.Encode(input, cbrotli.WriterOptions{Quality: 5, Dictionary: pd})
+	if errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Skip("cbrotli is built without cgo")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := dynbrotli.DecodeWithRawDictionary(encoded, dictionary)
+	if version, _ := dynbrotli.Version(); version < "1.1.0" {
+		if err == nil {
+			t.Errorf("C-Brotli %s decoded with a dictionary", version)
+		}
+		return
+	}
+	if err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("DecodeWithRawDictionary: %d bytes, %v", len(decoded), err)
+	}
+	if _, err := dynbrotli.Decode(encoded); err == nil {
+		t.Error("Decode without the dictionary succeeded")
+	}
+}
+
+func TestWriter(t *testing.T) {
+	requireLibrary(t)
+	input := content(4, 100000)
+	var out bytes.Buffer
+	w := dynbrotli.NewWriter(limitedWriter{&out, 7}, dynbrotli.WriterOptions{Quality: 5})
+	if _, err := w.Write(input[:1000]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	// The flushed output decodes to all of the input written before.
+	r := dynbrotli.NewReader(bytes.NewReader(out.Bytes()))
+	if flushed, err := io.ReadAll(r); !errors.Is(err, cbrotli.ErrTruncated) || !bytes.Equal(flushed, input[:1000]) {
+		t.Errorf("flushed output: %d bytes, %v", len(flushed), err)
+	}
+	r.Close()
+	if _, err := w.Write(input[1000:]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if decoded, err := dynbrotli.Decode(out.Bytes()); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("Decode: %d bytes, %v", len(decoded), err)
+	}
+	if _, err := w.Write(nil); err != cbrotli.ErrWriterClosed {
+		t.Errorf("Write after Close: %v", err)
+	}
+	if err := w.Close(); err != cbrotli.ErrWriterClosed {
+		t.Errorf("second Close: %v", err)
+	}
+
+	w = dynbrotli.NewWriter(limitedWriter{io.Discard, 0}, dynbrotli.WriterOptions{Quality: 5})
+	if err := w.Close(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Close with no progress: got %v, want %v", err, io.ErrShortWrite)
+	}
+	for _, options := range []dynbrotli.WriterOptions{{Quality: 12}, {Quality: -1}, {LGWin: 9}, {LGWin: 25}, {SizeHint: -1}} {
+		if _, err := dynbrotli.Encode(input, options); err == nil {
+			t.Errorf("Encode accepted %+v", options)
+		}
+	}
+}
+
+// limitedWriter accepts at most limit bytes per Write call.
+type limitedWriter struct {
+	dst   io.Writer
+	limit int
+}
+
+func (w limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		p = p[:w.limit]
+	}
+	return w.dst.Write(p)
+}
+
+func TestUseDynamicBackend(t *testing.T) {
+	requireLibrary(t)
+	err := dynbrotli.UseDynamicBackend(t.TempDir())
+	if !errors.Is(err, dynbrotli.ErrUnavailable) {
+		t.Errorf("UseDynamicBackend of an empty directory: %v", err)
+	}
+	// The libraries loaded before stay in use.
+	if _, err := dynbrotli.Version(); err != nil {
+		t.Errorf("Version: %v", err)
+	}
+}
+
+// TestConformance runs the tests cbrotli runs against itself.
+func TestConformance(t *testing.T) {
+	requireLibrary(t)
+	conformance.Run(t, conformance.Backend{
+		Decode:                  dynbrotli.Decode,
+		DecodeWithRawDictionary: dynbrotli.DecodeWithRawDictionary,
+		NewReader: func(src io.Reader) io.ReadCloser {
+			return dynbrotli.NewReader(src)
+		},
+		Encode: func(content []byte, quality, lgwin int) ([]byte, error) {
+			return dynbrotli.Encode(content, dynbrotli.WriterOptions{Quality: quality, LGWin: lgwin})
+		},
+		NewWriter: func(dst io.Writer, quality, lgwin int) conformance.Writer {
+			return dynbrotli.NewWriter(dst, dynbrotli.WriterOptions{Quality: quality, LGWin: lgwin})
+		},
+	}, os.DirFS(filepath.Join("..", "testdata", "streams")))
+}
@@ -0,0 +1,12 @@
+module github.com/google/brotli/go/cbrotli/dynbrotli
+
+go 1.21
+
+require (
+	github.com/ebitengine/purego v0.10.1
+	github.com/google/brotli/go/cbrotli v0.1.0
+)
+
+// Builds in this repository use the cbrotli next to it; replace has no
+// effect on importers, which get the version required above.
+replace github.com/google/brotli/go/cbrotli => ../
@@ -0,0 +1,32 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !(darwin || linux || netbsd || windows)
+
+package dynbrotli
+
+import (
+	"errors"
+	"runtime"
+)
+
+const (
+	decoderLibrary = "libbrotlidec"
+	encoderLibrary = "libbrotlienc"
+)
+
+var errNoLoader = errors.New("loading libraries is not supported on " + runtime.GOOS)
+
+func openLibrary(path string) (uintptr, error) {
+	return 0, errNoLoader
+}
+
+func lookup(h uintptr, name string) (uintptr, error) {
+	return 0, errNoLoader
+}
+
+func register(fptr any, sym uintptr) {
+	panic("dynbrotli: " + errNoLoader.Error())
+}
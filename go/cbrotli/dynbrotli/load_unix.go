@@ -0,0 +1,34 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build darwin || linux || netbsd
+
+package dynbrotli
+
+import (
+	"runtime"
+
+	"github.com/ebitengine/purego"
+)
+
+// The file names of the libraries, as installed by the CMake build of C-Brotli.
+var decoderLibrary, encoderLibrary = func() (string, string) {
+	if runtime.GOOS == "darwin" {
+		return "libbrotlidec.1.dylib", "libbrotlienc.1.dylib"
+	}
+	return "libbrotlidec.so.1", "libbrotlienc.so.1"
+}()
+
+func openLibrary(path string) (uintptr, error) {
+	return purego.Dlopen(path, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+}
+
+func lookup(h uintptr, name string) (uintptr, error) {
+	return purego.Dlsym(h, name)
+}
+
+func register(fptr any, sym uintptr) {
+	purego.RegisterFunc(fptr, sym)
+}
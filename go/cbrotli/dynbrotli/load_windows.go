@@ -0,0 +1,31 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package dynbrotli
+
+import (
+	"syscall"
+
+	"github.com/ebitengine/purego"
+)
+
+// The file names of the libraries, as installed by the CMake build of C-Brotli.
+const (
+	decoderLibrary = "brotlidec.dll"
+	encoderLibrary = "brotlienc.dll"
+)
+
+func openLibrary(path string) (uintptr, error) {
+	h, err := syscall.LoadLibrary(path)
+	return uintptr(h), err
+}
+
+func lookup(h uintptr, name string) (uintptr, error) {
+	return syscall.GetProcAddress(syscall.Handle(h), name)
+}
+
+func register(fptr any, sym uintptr) {
+	purego.RegisterFunc(fptr, sym)
+}
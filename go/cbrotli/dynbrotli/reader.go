@@ -0,0 +1,211 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package dynbrotli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"unsafe"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// BrotliDecoderResult values.
+const (
+	decoderResultError            = 0
+	decoderResultSuccess          = 1
+	decoderResultNeedsMoreInput   = 2
+	decoderResultNeedsMoreOutput  = 3
+	readBufSize                   = 32 * 1024
+	maxEmptyReads                 = 100
+	sharedDictionaryRaw           = 0 // BROTLI_SHARED_DICTIONARY_RAW
+	decoderErrorAllocContextModes = -21
+)
+
+var errExcessiveInput = errors.New("dynbrotli: excessive input")
+var errReaderClosed = errors.New("dynbrotli: Reader is closed")
+var errNoDictionaries = errors.New("dynbrotli: the decoder library does not support dictionaries; they need C-Brotli 1.1.0")
+
+// Reader implements io.ReadCloser by reading Brotli-encoded data from an
+// underlying Reader, like cbrotli.Reader.
+type Reader struct {
+	src   io.Reader
+	lib   *library
+	state uintptr
+	buf   []byte // input read from src
+	in    []byte // the part of buf not consumed by the decoder yet
+	frame *frame
+	// pinner pins buf, frame and the dictionary, which the decoder refers to
+	// until it is destroyed.
+	pinner runtime.Pinner
+	err    error // sticky
+	srcErr error // returned by src along with data, reported once it is decoded
+}
+
+// NewReader initializes new Reader instance.
+// Close MUST be called to free resources.
+func NewReader(src io.Reader) *Reader {
+	return NewReaderWithRawDictionary(src, nil)
+}
+
+// NewReaderWithRawDictionary initializes new Reader instance with shared
+// dictionary, which must not be modified until the Reader is closed. If the
+// libraries cannot be loaded or do not support dictionaries, the first Read
+// reports it.
+// Close MUST be called to free resources.
+func NewReaderWithRawDictionary(src io.Reader, dictionary []byte) *Reader {
+	r := &Reader{src: src}
+	r.lib, r.err = current()
+	if r.err != nil {
+		return r
+	}
+	if len(dictionary) != 0 && r.lib.decoderAttachDictionary == nil {
+		r.err = errNoDictionaries
+		return r
+	}
+	r.state = r.lib.decoderCreateInstance(0, 0, 0)
+	if r.state == 0 {
+		r.err = cbrotli.DecoderError{Code: decoderErrorAllocContextModes}
+		return r
+	}
+	r.buf = make([]byte, readBufSize)
+	r.frame = new(frame)
+	r.pinner.Pin(&r.buf[0])
+	r.pinner.Pin(r.frame)
+	if len(dictionary) != 0 {
+		r.pinner.Pin(&dictionary[0])
+		if r.lib.decoderAttachDictionary(r.state, sharedDictionaryRaw, uintptr(len(dictionary)), unsafe.Pointer(&dictionary[0])) == 0 {
+			r.err = errors.New("dynbrotli: dictionary can not be attached")
+		}
+	}
+	return r
+}
+
+// Close implements io.Closer. Close MUST be invoked to free native resources.
+func (r *Reader) Close() error {
+	if r.err == errReaderClosed {
+		return errReaderClosed
+	}
+	if r.state != 0 {
+		r.lib.decoderDestroyInstance(r.state)
+		r.state = 0
+	}
+	r.pinner.Unpin()
+	r.err = errReaderClosed
+	return nil
+}
+
+// Read implements io.Reader; errors are sticky.
+func (r *Reader) Read(p []byte) (n int, err error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for {
+		n, result := r.decompress(p)
+		switch result {
+		case decoderResultSuccess:
+			if len(r.in) > 0 {
+				r.err = errExcessiveInput
+				return n, r.err
+			}
+			if n > 0 {
+				return n, nil
+			}
+			// Data after the end of the stream is an error too.
+			m, err := r.readSource()
+			if m > 0 {
+				r.err = errExcessiveInput
+			} else {
+				r.err = err
+			}
+			return 0, r.err
+		case decoderResultNeedsMoreOutput:
+			return n, nil
+		case decoderResultNeedsMoreInput:
+			if n > 0 {
+				return n, nil
+			}
+			m, err := r.readSource()
+			if m == 0 {
+				if err == io.EOF {
+					err = cbrotli.ErrTruncated
+				}
+				r.err = err
+				return 0, r.err
+			}
+			r.in = r.buf[:m]
+		default:
+			r.err = cbrotli.DecoderError{Code: int(r.lib.decoderGetErrorCode(r.state))}
+			return n, r.err
+		}
+	}
+}
+
+// decompress decodes r.in into p, and returns the number of bytes written
+// and the BrotliDecoderResult.
+func (r *Reader) decompress(p []byte) (int, int32) {
+	// The decoder stores the address of p in r.frame.
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	pinner.Pin(&p[0])
+	f := r.frame
+	*f = frame{
+		availableIn:  uintptr(len(r.in)),
+		nextIn:       address(r.in),
+		availableOut: uintptr(len(p)),
+		nextOut:      address(p),
+	}
+	result := r.lib.decoderDecompressStream(r.state,
+		unsafe.Pointer(&f.availableIn), unsafe.Pointer(&f.nextIn),
+		unsafe.Pointer(&f.availableOut), unsafe.Pointer(&f.nextOut), nil)
+	r.in = r.in[len(r.in)-int(f.availableIn):]
+	return len(p) - int(f.availableOut), result
+}
+
+// readSource reads from src into r.buf. Errors of src other than io.EOF are
+// wrapped, so that they are distinguished from decoding errors; data read
+// along with them is returned first. Reads of no data and no error are
+// retried.
+func (r *Reader) readSource() (int, error) {
+	if r.srcErr != nil {
+		return 0, r.srcErr
+	}
+	for i := 0; ; i++ {
+		n, err := r.src.Read(r.buf)
+		if err != nil && err != io.EOF {
+			r.srcErr = fmt.Errorf("dynbrotli: reading source: %w", err)
+			if n > 0 {
+				return n, nil
+			}
+			return 0, r.srcErr
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+		if i == maxEmptyReads {
+			r.srcErr = fmt.Errorf("dynbrotli: reading source: %w", io.ErrNoProgress)
+			return 0, r.srcErr
+		}
+	}
+}
+
+// Decode decodes Brotli encoded data.
+func Decode(encodedData []byte) ([]byte, error) {
+	return DecodeWithRawDictionary(encodedData, nil)
+}
+
+// DecodeWithRawDictionary decodes Brotli encoded data with shared dictionary.
+func DecodeWithRawDictionary(encodedData []byte, dictionary []byte) ([]byte, error) {
+	r := NewReaderWithRawDictionary(bytes.NewReader(encodedData), dictionary)
+	defer r.Close()
+	return io.ReadAll(r)
+}
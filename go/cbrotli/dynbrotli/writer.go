@@ -0,0 +1,241 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package dynbrotli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"unsafe"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// BrotliEncoderOperation and BrotliEncoderParameter values.
+const (
+	operationProcess = 0
+	operationFlush   = 1
+	operationFinish  = 2
+	paramQuality     = 1
+	paramLGWin       = 2
+	paramSizeHint    = 5
+)
+
+// Limits and defaults of WriterOptions, as in cbrotli.
+const (
+	minQuality        = 0
+	maxQuality        = 11
+	minWindowBits     = 10
+	maxWindowBits     = 24
+	defaultWindowBits = 22
+)
+
+var errEncode = errors.New("dynbrotli: encode error")
+
+// WriterOptions configures Writer.
+type WriterOptions struct {
+	// Quality controls the compression-speed vs compression-density trade-offs.
+	// The higher the quality, the slower the compression. Range is 0 to 11.
+	Quality int
+	// LGWin is the base 2 logarithm of the sliding window size.
+	// Range is 10 to 24. 0 indicates automatic configuration: the smallest
+	// window that covers SizeHint bytes, or C-Brotli default (22) if SizeHint
+	// is not set or is larger than the default window.
+	LGWin int
+	// SizeHint is the expected total size of input, 0 if unknown. It is passed
+	// to the encoder and is used to choose the window size when LGWin is 0.
+	// Encode sets it to the length of its input.
+	SizeHint int
+}
+
+func (options *WriterOptions) validate() error {
+	if options.Quality < minQuality || options.Quality > maxQuality {
+		return fmt.Errorf("dynbrotli: quality %d out of range [%d, %d]",
+			options.Quality, minQuality, maxQuality)
+	}
+	if options.LGWin != 0 &&
+		(options.LGWin < minWindowBits || options.LGWin > maxWindowBits) {
+		return fmt.Errorf("dynbrotli: window bits %d out of range [%d, %d]",
+			options.LGWin, minWindowBits, maxWindowBits)
+	}
+	if options.SizeHint < 0 {
+		return fmt.Errorf("dynbrotli: negative size hint %d", options.SizeHint)
+	}
+	return nil
+}
+
+// windowBits returns the window size of the encoder, as cbrotli chooses it.
+func (options *WriterOptions) windowBits() int {
+	if options.LGWin != 0 {
+		return options.LGWin
+	}
+	lgwin := minWindowBits
+	// Brotli window size is (1 << lgwin) - 16.
+	for lgwin < defaultWindowBits && (1<<uint(lgwin))-16 < options.SizeHint {
+		lgwin++
+	}
+	if options.SizeHint == 0 {
+		lgwin = defaultWindowBits
+	}
+	return lgwin
+}
+
+// Writer implements io.WriteCloser by writing Brotli-encoded data to an
+// underlying Writer, like cbrotli.Writer.
+type Writer struct {
+	dst   io.Writer
+	lib   *library
+	state uintptr
+	frame *frame
+	// pinner pins frame until the Writer is closed.
+	pinner runtime.Pinner
+	err    error // sticky
+	closed bool
+}
+
+// NewWriter initializes new Writer instance. Invalid options, or libraries
+// that cannot be loaded, are reported by the first Write, Flush or Close.
+// Close MUST be called to free resources.
+func NewWriter(dst io.Writer, options WriterOptions) *Writer {
+	w := &Writer{dst: dst}
+	if w.err = options.validate(); w.err != nil {
+		return w
+	}
+	if w.lib, w.err = current(); w.err != nil {
+		return w
+	}
+	if w.err = w.lib.encoderErr; w.err != nil {
+		return w
+	}
+	w.state = w.lib.encoderCreateInstance(0, 0, 0)
+	if w.state == 0 {
+		w.err = errEncode
+		return w
+	}
+	w.lib.encoderSetParameter(w.state, paramQuality, uint32(options.Quality))
+	w.lib.encoderSetParameter(w.state, paramLGWin, uint32(options.windowBits()))
+	if options.SizeHint > 0 {
+		// C-Brotli does not distinguish sizes above 1GiB.
+		w.lib.encoderSetParameter(w.state, paramSizeHint, uint32(min(options.SizeHint, 1<<30)))
+	}
+	w.frame = new(frame)
+	w.pinner.Pin(w.frame)
+	return w
+}
+
+// Write implements io.Writer. Flush or Close must be called to ensure that the
+// encoded bytes are actually flushed to the underlying Writer.
+func (w *Writer) Write(p []byte) (n int, err error) {
+	return w.compress(p, operationProcess)
+}
+
+// Flush outputs encoded data for all input provided to Write. The resulting
+// output can be decoded to match all input before Flush, but the stream is
+// not yet complete until after Close.
+func (w *Writer) Flush() error {
+	_, err := w.compress(nil, operationFlush)
+	return err
+}
+
+// Close flushes remaining data to the decorated writer and frees native
+// resources; it does not close the underlying Writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return cbrotli.ErrWriterClosed
+	}
+	_, err := w.compress(nil, operationFinish)
+	w.closed = true
+	if w.state != 0 {
+		w.lib.encoderDestroyInstance(w.state)
+		w.state = 0
+	}
+	w.pinner.Unpin()
+	return err
+}
+
+func (w *Writer) compress(p []byte, op int32) (n int, err error) {
+	if w.closed {
+		return 0, cbrotli.ErrWriterClosed
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	// The encoder stores the address of p in w.frame.
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	if len(p) != 0 {
+		pinner.Pin(&p[0])
+	}
+	f := w.frame
+	for {
+		*f = frame{availableIn: uintptr(len(p)), nextIn: address(p)}
+		if w.lib.encoderCompressStream(w.state, op,
+			unsafe.Pointer(&f.availableIn), unsafe.Pointer(&f.nextIn),
+			unsafe.Pointer(&f.availableOut), nil, nil) == 0 {
+			w.err = errEncode
+			return n, w.err
+		}
+		consumed := len(p) - int(f.availableIn)
+		p = p[consumed:]
+		n += consumed
+		for w.lib.encoderHasMoreOutput(w.state) != 0 {
+			if w.err = w.writeOutput(); w.err != nil {
+				return n, w.err
+			}
+		}
+		done := len(p) == 0
+		if op == operationFinish {
+			done = w.lib.encoderIsFinished(w.state) != 0
+		}
+		if done {
+			return n, nil
+		}
+	}
+}
+
+// writeOutput sends the output taken from the encoder to dst. Destinations
+// that accept only a part of the data are retried with the remainder, as by
+// cbrotli.Writer.
+func (w *Writer) writeOutput() error {
+	f := w.frame
+	// The output is in C memory, valid until the next call of the encoder.
+	f.availableOut = 0
+	output := w.lib.encoderTakeOutput(w.state, unsafe.Pointer(&f.availableOut))
+	if f.availableOut == 0 {
+		return nil
+	}
+	out := unsafe.Slice(output, f.availableOut)
+	for len(out) > 0 {
+		m, err := w.dst.Write(out)
+		if err != nil {
+			return err
+		}
+		if m <= 0 || m > len(out) {
+			return io.ErrShortWrite
+		}
+		out = out[m:]
+	}
+	return nil
+}
+
+// Encode returns content encoded with Brotli.
+func Encode(content []byte, options WriterOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if options.SizeHint == 0 {
+		options.SizeHint = len(content)
+	}
+	w := NewWriter(&buf, options)
+	_, err := w.Write(content)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
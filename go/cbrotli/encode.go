@@ -0,0 +1,421 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DictionaryType is type for shared dictionary
+type DictionaryType int
+
+const (
+	// DtRaw denotes LZ77 prefix dictionary
+	DtRaw DictionaryType = 0
+	// DtSerialized denotes serialized format
+	DtSerialized DictionaryType = 1
+)
+
+// PrepareDictionaryOptions configures PrepareDictionary.
+type PrepareDictionaryOptions struct {
+	// Quality is the compression quality of the Writers the dictionary is
+	// prepared for; the effort spent on indexing the dictionary depends on
+	// it. A dictionary can be used by Writers of any quality; see Reprepare.
+	Quality int
+}
+
+const (
+	// MinQuality is the lowest (fastest) compression quality.
+	MinQuality = 0
+	// MaxQuality is the highest (densest) compression quality.
+	MaxQuality = 11
+	// DefaultQuality is the quality used by Compress; same as C-Brotli default.
+	DefaultQuality = 11
+
+	minWindowBits     = 10
+	maxWindowBits     = 24
+	defaultWindowBits = 22
+)
+
+// WriterOptions configures Writer.
+type WriterOptions struct {
+	// Quality controls the compression-speed vs compression-density trade-offs.
+	// The higher the quality, the slower the compression. Range is 0 to 11.
+	Quality int
+	// LGWin is the base 2 logarithm of the sliding window size.
+	// Range is 10 to 24. 0 indicates automatic configuration: the smallest
+	// window that covers SizeHint bytes, or C-Brotli default (22) if SizeHint
+	// is not set or is larger than the default window.
+	LGWin int
+	// SizeHint is the expected total size of input, 0 if unknown. It is passed
+	// to the encoder and is used to choose the window size when LGWin is 0.
+	// Encode sets it to the length of its input.
+	SizeHint int
+	// StreamOffset is the number of uncompressed bytes already encoded by
+	// previous Writers whose output was finished with CloseAppendable. If it
+	// is not 0, the stream header is omitted, so that output can be appended
+	// to that of the predecessors. All Writers producing parts of a stream
+	// must use the same Quality and LGWin; set LGWin explicitly, as automatic
+	// choice depends on SizeHint.
+	StreamOffset int64
+	// Prepared shared dictionary
+	Dictionary *PreparedDictionary
+	// DetectIncompressible enables a heuristic that estimates the entropy of
+	// each chunk of input and encodes chunks that look incompressible (e.g.
+	// already compressed images or encrypted data) at MinQuality, returning to
+	// Quality when compressible input resumes. Output remains a single valid
+	// stream, but each switch costs a flush and the loss of the window
+	// contents, i.e. data before the switch can not be referenced after it.
+	DetectIncompressible bool
+	// FlushInterval, if positive, makes the Writer flush automatically when
+	// data written to it has not been flushed within the interval. This bounds
+	// the latency of interactive streams. Automatic flushes run in the
+	// background; their errors are reported by the next call to Write, Flush
+	// or Close.
+	FlushInterval time.Duration
+	// WriteBufferSize, if positive, is the size of a buffer that accumulates
+	// writes shorter than it, so that applications writing a few bytes at a
+	// time do not pay for a call into C-Brotli per Write. Buffered data is
+	// passed to the encoder when the buffer fills, on Flush and on Close. The
+	// output does not depend on this setting.
+	WriteBufferSize int
+	// ChunkSize is the amount of input compressed independently by each
+	// worker of a ParallelWriter (if 0, it is 4MiB or the window size,
+	// whichever is larger), put in each frame by a SeekableWriter (if 0, it
+	// is 1MiB), or in each checksummed chunk by a FrameWriter (if 0, it is
+	// 1MiB; at most 1GiB). Other Writers ignore it.
+	ChunkSize int
+	// SelectDictionary, if not nil, picks the dictionary of the stream from
+	// its content: it is called once with the first SelectionSampleSize bytes
+	// of input (or all of it, if the stream is shorter or is flushed earlier)
+	// before anything is compressed, and the dictionary it returns (nil for
+	// none) is used as Dictionary, which must not be set. The input is held
+	// until then; the sample must not be retained by SelectDictionary.
+	SelectDictionary func(sample []byte) *PreparedDictionary
+	// SelectionSampleSize is the size of the sample given to
+	// SelectDictionary and inspected by AutoMode; 0 means 4KiB.
+	SelectionSampleSize int
+	// AutoMode makes the Writer choose the encoder mode from the content:
+	// the first SelectionSampleSize bytes of input (or all of it, if the
+	// stream is shorter or is flushed earlier) are held; if they start with
+	// the header of a TrueType or OpenType font (or font collection), the
+	// stream is encoded with BROTLI_MODE_FONT, which codes distances the way
+	// font tables favour, and if they look like text (valid UTF-8 with few
+	// control characters), with BROTLI_MODE_TEXT; otherwise with
+	// BROTLI_MODE_GENERIC. The choice is made once, before anything is
+	// compressed, and is reported by WriterStats. Encode inspects the start
+	// of its input likewise. C-Brotli encodes text like generic data (it
+	// detects UTF-8 itself), so only the font mode changes the output of the
+	// bundled encoder.
+	AutoMode bool
+	// PipelineDepth, if positive, makes the Writer encode in a background
+	// goroutine, which also writes to the destination: Write queues a copy of
+	// its input and returns, so that compression (at high qualities, the
+	// bottleneck) overlaps with the producer. At most PipelineDepth writes
+	// wait in the queue; Write blocks while it is full. The output is the
+	// same as without it. Errors of the goroutine are reported by the next
+	// call to Write, Flush or Close; the other methods, Flush included, wait
+	// for the queue to drain, and Close (or ResetOptions) stops the
+	// goroutine. Progress is called by the goroutine, concurrently with
+	// Write. ParallelWriter ignores it.
+	PipelineDepth int
+	// MaxOutputBytes, if positive, is a budget for the encoded stream: once
+	// the output would exceed it, the Writer fails with
+	// ErrOutputBudgetExceeded, including when the output is produced by
+	// Flush or Close. Output beyond the budget is not written to the
+	// destination. The Writer is then closed, as by a Close that failed: its
+	// resources are freed, and further calls (Close included) return
+	// ErrWriterClosed. Encode takes the streaming path when it is set, so
+	// that it stops as soon as the budget is crossed. ParallelWriter and
+	// SeekableWriter ignore it.
+	MaxOutputBytes int64
+	// Progress, if not nil, is called with the number of input bytes consumed
+	// by the encoder and of output bytes written to the destination so far
+	// (see WriterStats). It is called after steps of the encoder, including
+	// those of Flush and Close, once the counters have advanced by
+	// progressInterval bytes since the previous call, and when Close or
+	// CloseAppendable completes successfully, so that the final totals are
+	// reported exactly once. Calls are serialized with the Writer methods and
+	// automatic flushes, and must not call them.
+	Progress func(inputConsumed, outputProduced int64)
+}
+
+const defaultSelectionSampleSize = 4 << 10
+
+// WriterStats reports the activity of a Writer.
+type WriterStats struct {
+	// BytesIn is the number of uncompressed bytes consumed by the encoder;
+	// data held in the write buffer (see WriterOptions.WriteBufferSize) is
+	// not counted until it is passed to the encoder.
+	BytesIn int64
+	// BytesOut is the number of compressed bytes written to the destination.
+	BytesOut int64
+	// IncompressibleBytes is the number of input bytes that were encoded at
+	// MinQuality, because DetectIncompressible classified them as
+	// incompressible.
+	IncompressibleBytes int64
+	// DictionaryQuality is the quality for which the representation of
+	// WriterOptions.Dictionary attached to the current encoder instance was
+	// prepared (see PreparedDictionary.Reprepare), or -1 if there is no
+	// dictionary.
+	DictionaryQuality int
+	// ModeChosen is set once WriterOptions.AutoMode has chosen the encoder
+	// mode, TextMode if it has chosen BROTLI_MODE_TEXT, and FontMode if it
+	// has chosen BROTLI_MODE_FONT.
+	ModeChosen, TextMode, FontMode bool
+}
+
+func validateQuality(quality int) error {
+	if quality < MinQuality || quality > MaxQuality {
+		return fmt.Errorf("cbrotli: quality %d out of range [%d, %d]",
+			quality, MinQuality, MaxQuality)
+	}
+	return nil
+}
+
+// validate checks that options are within the ranges supported by C-Brotli.
+func (options *WriterOptions) validate() error {
+	if err := validateQuality(options.Quality); err != nil {
+		return err
+	}
+	if options.LGWin != 0 &&
+		(options.LGWin < minWindowBits || options.LGWin > maxWindowBits) {
+		return fmt.Errorf("cbrotli: window bits %d out of range [%d, %d]",
+			options.LGWin, minWindowBits, maxWindowBits)
+	}
+	if options.SizeHint < 0 {
+		return fmt.Errorf("cbrotli: negative size hint %d", options.SizeHint)
+	}
+	if options.StreamOffset < 0 {
+		return fmt.Errorf("cbrotli: negative stream offset %d", options.StreamOffset)
+	}
+	if options.FlushInterval < 0 {
+		return fmt.Errorf("cbrotli: negative flush interval %v", options.FlushInterval)
+	}
+	if options.WriteBufferSize < 0 {
+		return fmt.Errorf("cbrotli: negative write buffer size %d", options.WriteBufferSize)
+	}
+	if options.ChunkSize < 0 {
+		return fmt.Errorf("cbrotli: negative chunk size %d", options.ChunkSize)
+	}
+	if options.PipelineDepth < 0 {
+		return fmt.Errorf("cbrotli: negative pipeline depth %d", options.PipelineDepth)
+	}
+	if options.MaxOutputBytes < 0 {
+		return fmt.Errorf("cbrotli: negative output budget %d", options.MaxOutputBytes)
+	}
+	if options.SelectionSampleSize < 0 {
+		return fmt.Errorf("cbrotli: negative selection sample size %d", options.SelectionSampleSize)
+	}
+	if options.SelectDictionary != nil && options.Dictionary != nil {
+		return errors.New("cbrotli: both Dictionary and SelectDictionary are set")
+	}
+	return nil
+}
+
+// oneShot reports whether Encode can compress with a single call to the C
+// encoder: the options that need the streaming encoder are not set.
+func (options *WriterOptions) oneShot() bool {
+	return options.Dictionary == nil && options.SelectDictionary == nil &&
+		options.Progress == nil && options.MaxOutputBytes == 0
+}
+
+// CompressBound returns the largest size of the Brotli stream of n bytes
+// encoded without a dictionary, or 0 if it does not fit int; see EncodeTo.
+func CompressBound(n int) int {
+	return compressBound(n)
+}
+
+// windowBits returns the window size that the encoder is configured with.
+func (options *WriterOptions) windowBits() int {
+	if options.LGWin != 0 {
+		return options.LGWin
+	}
+	if options.SizeHint > 0 {
+		// Automatic choice never exceeds the default.
+		return min(windowBitsFor(options.SizeHint), defaultWindowBits)
+	}
+	return defaultWindowBits
+}
+
+// windowBitsFor returns the smallest window that covers size bytes of input.
+func windowBitsFor(size int) int {
+	lgwin := minWindowBits
+	// Brotli window size is (1 << lgwin) - 16.
+	for lgwin < maxWindowBits && (1<<uint(lgwin))-16 < size {
+		lgwin++
+	}
+	return lgwin
+}
+
+// timer is the part of *time.Timer used by the Writer; replaced in tests.
+type timer interface {
+	Stop() bool
+}
+
+var afterFunc = func(d time.Duration, f func()) timer {
+	return time.AfterFunc(d, f)
+}
+
+// Dictionary is a shared dictionary given by its content.
+type Dictionary struct {
+	Data []byte
+	Type DictionaryType
+}
+
+// EncodeWithDictionaries encodes content with options and several shared
+// dictionaries, like a Writer made by NewWriterWithDictionaries.
+func EncodeWithDictionaries(content []byte, options WriterOptions, dictionaries []Dictionary) ([]byte, error) {
+	if options.SizeHint == 0 {
+		options.SizeHint = len(content)
+	}
+	var buf bytes.Buffer
+	w := NewWriterWithDictionaries(&buf, options, dictionaries)
+	_, err := w.Write(content)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeLevel returns data encoded with Brotli at the given quality, using the
+// smallest window that covers data (but not larger than the default one).
+func EncodeLevel(data []byte, quality int) ([]byte, error) {
+	return Encode(data, WriterOptions{Quality: quality})
+}
+
+// Compress returns data encoded with Brotli at DefaultQuality.
+func Compress(data []byte) ([]byte, error) {
+	return EncodeLevel(data, DefaultQuality)
+}
+
+// encodeIfSmallerPool keeps the buffers of EncodeIfSmaller.
+var encodeIfSmallerPool sync.Pool // *batchEncoder
+
+// EncodeIfSmaller encodes data with options, like Encode, and keeps the result
+// only if it pays off: if it is smaller than data by at least
+// minSavingsPercent percent of len(data) (in [0, 100]), it returns it with
+// compressed set; otherwise, it returns data itself, not a copy. Empty data
+// is never compressed. Encoding uses pooled buffers, so that output that is
+// thrown away is not allocated.
+func EncodeIfSmaller(data []byte, options WriterOptions, minSavingsPercent float64) (out []byte, compressed bool, err error) {
+	if !(minSavingsPercent >= 0 && minSavingsPercent <= 100) {
+		return nil, false, fmt.Errorf("cbrotli: savings percent %v out of range [0, 100]", minSavingsPercent)
+	}
+	if err := options.validate(); err != nil {
+		return nil, false, err
+	}
+	if len(data) == 0 {
+		return data, false, nil
+	}
+	be, _ := encodeIfSmallerPool.Get().(*batchEncoder)
+	if be == nil {
+		count(&metrics.poolMisses, 1)
+		be = &batchEncoder{}
+	} else {
+		count(&metrics.poolHits, 1)
+	}
+	defer encodeIfSmallerPool.Put(be)
+	encoded, err := be.encodeTemporary(data, options)
+	if err != nil {
+		return nil, false, err
+	}
+	saved := len(data) - len(encoded)
+	if saved <= 0 || float64(saved)*100/float64(len(data)) < minSavingsPercent {
+		return data, false, nil
+	}
+	// encoded is a pooled buffer.
+	return bytes.Clone(encoded), true, nil
+}
+
+// outputBuffer is a destination that appends to a slice.
+type outputBuffer struct {
+	buf []byte
+}
+
+func (o *outputBuffer) Write(p []byte) (int, error) {
+	o.buf = append(o.buf, p...)
+	return len(p), nil
+}
+
+// BufferWriter compresses data written to it into an internal buffer; it
+// replaces a Writer decorating a bytes.Buffer. The buffer is allocated
+// according to WriterOptions.SizeHint, if set.
+//
+// A BufferWriter can be reused (e.g. via sync.Pool) with Reset or ResetOptions;
+// the buffer is retained.
+type BufferWriter struct {
+	w       Writer
+	out     outputBuffer
+	options WriterOptions
+	closed  bool // successfully
+}
+
+// NewBufferWriter initializes new BufferWriter instance.
+// Close MUST be called to free resources.
+func NewBufferWriter(options WriterOptions) *BufferWriter {
+	b := &BufferWriter{}
+	b.ResetOptions(options)
+	return b
+}
+
+// ResetOptions discards the content of b and makes it equivalent to the result
+// of NewBufferWriter(options); see Writer.ResetOptions.
+func (b *BufferWriter) ResetOptions(options WriterOptions) error {
+	b.options = options
+	b.closed = false
+	if want := initialBufferSize(options.SizeHint); cap(b.out.buf) < want {
+		b.out.buf = make([]byte, 0, want)
+	} else {
+		b.out.buf = b.out.buf[:0]
+	}
+	return b.w.ResetOptions(&b.out, options)
+}
+
+// Reset discards the content of b, so that it can compress another stream with
+// the same options. Slices returned by Bytes become invalid.
+func (b *BufferWriter) Reset() error {
+	return b.ResetOptions(b.options)
+}
+
+// Write implements io.Writer.
+func (b *BufferWriter) Write(p []byte) (int, error) {
+	return b.w.Write(p)
+}
+
+// WriteString implements io.StringWriter.
+func (b *BufferWriter) WriteString(s string) (int, error) {
+	return b.w.WriteString(s)
+}
+
+// Flush outputs encoded data for all input provided to Write; see Writer.Flush.
+func (b *BufferWriter) Flush() error {
+	return b.w.Flush()
+}
+
+// Close completes the stream and frees C resources; the compressed stream is
+// then available via Bytes.
+func (b *BufferWriter) Close() error {
+	err := b.w.Close()
+	b.closed = err == nil
+	return err
+}
+
+// Bytes returns the compressed stream. It is nil unless Close has succeeded;
+// the slice is valid until the next call to Reset or ResetOptions.
+func (b *BufferWriter) Bytes() []byte {
+	if !b.closed {
+		return nil
+	}
+	return b.out.buf
+}
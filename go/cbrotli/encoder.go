@@ -0,0 +1,161 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+)
+
+var errDecoderClosed = errors.New("cbrotli: Decoder is closed")
+
+// Encoder compresses whole buffers with fixed WriterOptions, like the Encoder
+// of github.com/klauspost/compress/zstd does with EncodeAll. It is safe for
+// concurrent use, and reuses the buffers and Writers of previous calls.
+// C-Brotli can not reset an encoder instance, so a new one is created per
+// call, as by Encode; its cost is small next to that of compression.
+type Encoder struct {
+	options WriterOptions
+	pool    sync.Pool // *batchEncoder
+}
+
+// NewEncoder returns an Encoder that compresses with options, which must be
+// valid. options.Dictionary, if set, must not be closed before the Encoder.
+func NewEncoder(options WriterOptions) (*Encoder, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return &Encoder{options: options}, nil
+}
+
+// EncodeAll appends the Brotli stream of src to dst and returns the result.
+// It panics if the encoder fails, which can only happen if
+// options.Dictionary has been closed.
+func (e *Encoder) EncodeAll(src, dst []byte) []byte {
+	be, _ := e.pool.Get().(*batchEncoder)
+	if be == nil {
+		count(&metrics.poolMisses, 1)
+		be = &batchEncoder{}
+	} else {
+		count(&metrics.poolHits, 1)
+	}
+	defer e.pool.Put(be)
+	encoded, err := be.encodeTemporary(src, e.options)
+	if err != nil {
+		panic(err)
+	}
+	return append(dst, encoded...)
+}
+
+// Close releases the pooled buffers. The Encoder holds no native resources
+// between calls, so EncodeAll may still be called after Close.
+func (e *Encoder) Close() error {
+	for e.pool.Get() != nil {
+	}
+	return nil
+}
+
+// Decoder decompresses whole buffers with fixed ReaderOptions, like the
+// Decoder of github.com/klauspost/compress/zstd does with DecodeAll. It is
+// safe for concurrent use, and keeps the Readers of previous calls, with
+// their buffers and attached dictionaries, until Close.
+type Decoder struct {
+	options ReaderOptions
+	mu      sync.Mutex
+	free    []*Reader
+	closed  bool
+}
+
+// NewDecoder returns a Decoder that decompresses with options, e.g. with a
+// dictionary or a limit of the decoded size (options.MaxDecodedSize), which
+// applies to each call of DecodeAll. options.Dictionary, if set, must not be
+// closed before the Decoder.
+func NewDecoder(options ReaderOptions) (*Decoder, error) {
+	r := NewReaderWithOptions(bytes.NewReader(nil), options)
+	// r.err holds invalid options and dictionaries.
+	if r.err != nil {
+		err := r.err
+		r.Close()
+		return nil, err
+	}
+	return &Decoder{options: options, free: []*Reader{r}}, nil
+}
+
+// DecodeAll appends the content decoded from the Brotli stream src to dst and
+// returns the result. On error, dst is returned unchanged, with the error.
+func (d *Decoder) DecodeAll(src, dst []byte) ([]byte, error) {
+	r, err := d.get()
+	if err != nil {
+		return dst, err
+	}
+	defer d.put(r)
+	// The decoder reads src in place; the source only reports its end.
+	r.in = src
+	out := dst
+	for {
+		if len(out) == cap(out) {
+			out = append(out, 0)[:len(out)]
+		}
+		n, err := r.Read(out[len(out):cap(out)])
+		out = out[:len(out)+n]
+		if err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return dst, err
+		}
+	}
+}
+
+// get returns a Reader ready to decode a new stream.
+func (d *Decoder) get() (*Reader, error) {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return nil, errDecoderClosed
+	}
+	var r *Reader
+	if n := len(d.free); n != 0 {
+		r = d.free[n-1]
+		d.free = d.free[:n-1]
+	}
+	d.mu.Unlock()
+	if r == nil {
+		count(&metrics.poolMisses, 1)
+		return NewReaderWithOptions(bytes.NewReader(nil), d.options), nil
+	}
+	count(&metrics.poolHits, 1)
+	r.Reset(bytes.NewReader(nil))
+	return r, nil
+}
+
+// put keeps r for the next call, or closes it if the Decoder is closed.
+func (d *Decoder) put(r *Reader) {
+	r.in = nil
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		r.Close()
+		return
+	}
+	d.free = append(d.free, r)
+}
+
+// Close releases the native decoder instances and dictionaries kept by the
+// Decoder; calls of DecodeAll still running release theirs when they return.
+// DecodeAll fails after Close.
+func (d *Decoder) Close() error {
+	d.mu.Lock()
+	free := d.free
+	d.free, d.closed = nil, true
+	d.mu.Unlock()
+	for _, r := range free {
+		r.Close()
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrTruncated is returned by Readers and Decode functions when the
+	// input ends in the middle of a stream; it wraps io.ErrUnexpectedEOF.
+	ErrTruncated = fmt.Errorf("cbrotli: truncated stream: %w", io.ErrUnexpectedEOF)
+	// ErrCorrupt matches (with errors.Is) the DecoderError of streams that
+	// are malformed or do not match the dictionaries given to the decoder.
+	ErrCorrupt = errors.New("cbrotli: corrupt stream")
+	// ErrDecodedTooLarge is returned by Readers once the decoded content
+	// exceeds ReaderOptions.MaxDecodedSize.
+	ErrDecodedTooLarge = errors.New("cbrotli: decoded content exceeds the size limit")
+	// ErrOutputBudgetExceeded is returned by Writers and Encode functions once
+	// the encoded stream would exceed WriterOptions.MaxOutputBytes.
+	ErrOutputBudgetExceeded = errors.New("cbrotli: encoded stream exceeds the output budget")
+	// ErrSizeMismatch matches (with errors.Is) the SizeMismatchError of
+	// content that does not have ReaderOptions.ExpectedSize.
+	ErrSizeMismatch = errors.New("cbrotli: decoded size mismatch")
+)
+
+// SizeMismatchError is returned by Readers when the decoded content does not
+// have ReaderOptions.ExpectedSize. Actual is the decoded size, or
+// ExpectedSize+1 if decoding stopped at the first byte too many.
+type SizeMismatchError struct {
+	Expected, Actual int64
+}
+
+func (err SizeMismatchError) Error() string {
+	return fmt.Sprintf("cbrotli: decoded size %d, expected %d", err.Actual, err.Expected)
+}
+
+// Is reports whether target is ErrSizeMismatch.
+func (err SizeMismatchError) Is(target error) bool {
+	return target == ErrSizeMismatch
+}
+
+// DecoderError is a failure reported by the decoder.
+type DecoderError struct {
+	// Code is the BrotliDecoderErrorCode, a negative number.
+	Code int
+}
+
+// Is reports whether target is ErrCorrupt and the code denotes a malformed
+// stream (BROTLI_DECODER_ERROR_FORMAT_*) or a reference to a missing
+// dictionary; allocation failures and invalid arguments are not corruption.
+func (err DecoderError) Is(target error) bool {
+	return target == ErrCorrupt && err.Code < 0 &&
+		err.Code >= decoderErrorDictionaryNotSet
+}
+
+var errExcessiveInput = errors.New("cbrotli: excessive input")
+var errInvalidState = errors.New("cbrotli: invalid state")
+var errReaderClosed = errors.New("cbrotli: Reader is closed")
+var errReaderStarted = errors.New("cbrotli: Reader has already started")
+var errAttachDictionary = errors.New("cbrotli: dictionaries can not be attached together")
+
+var (
+	errDictionaryInUse   = errors.New("cbrotli: DecoderDictionary is in use")
+	errDictionaryClosed  = errors.New("cbrotli: DecoderDictionary is closed")
+	errInvalidDictionary = errors.New("cbrotli: invalid or unsupported serialized dictionary")
+)
+
+var (
+	errPreparedDictionaryInUse  = errors.New("cbrotli: PreparedDictionary is in use")
+	errPreparedDictionaryClosed = errors.New("cbrotli: PreparedDictionary is closed")
+)
+
+// ErrWriterClosed is returned by Writer methods invoked after Close, even if
+// Close itself has failed.
+var ErrWriterClosed = errors.New("cbrotli: Writer is closed")
+
+var (
+	errEncoderInit     = errors.New("cbrotli: encoder initialization failed")
+	errWriterUnhealthy = errors.New("cbrotli: Writer is unhealthy")
+)
+
+// EncoderError is a failure of the C encoder, with the context it occurred in;
+// C-Brotli does not tell the cause (e.g. an allocation failure). Once it is
+// returned, the Writer is unusable, and Close returns it again.
+type EncoderError struct {
+	// Op is the operation requested from the encoder: "process", "flush",
+	// "finish" or "emit metadata".
+	Op string
+	// Reason tells whether the encoder reported the failure or stalled.
+	Reason string
+	// BytesIn and BytesOut are the stream totals at the time of the failure
+	// (see WriterStats).
+	BytesIn, BytesOut int64
+	// Quality and LGWin are the parameters of the encoder instance.
+	Quality, LGWin int
+}
+
+func (e EncoderError) Error() string {
+	return fmt.Sprintf("cbrotli: encode error: %s during %s at quality %d, window %d, after %d bytes in, %d bytes out",
+		e.Reason, e.Op, e.Quality, e.LGWin, e.BytesIn, e.BytesOut)
+}
+
+// ErrNotSupported is returned by the functions and methods that need
+// C-Brotli when the package is built without cgo: Writers and Encode
+// functions, encoder dictionaries, and the custom words and transforms of
+// serialized dictionaries. Decoding works in both builds.
+var ErrNotSupported = errors.New("cbrotli: not supported without cgo")
+
+// decoderErrorDictionaryNotSet is BROTLI_DECODER_ERROR_DICTIONARY_NOT_SET, the
+// last code that denotes corruption.
+const decoderErrorDictionaryNotSet = -19
+
+// decoderErrorNames are the strings of BrotliDecoderErrorString, by code.
+var decoderErrorNames = map[int]string{
+	-1:  "_ERROR_FORMAT_EXUBERANT_NIBBLE",
+	-2:  "_ERROR_FORMAT_RESERVED",
+	-3:  "_ERROR_FORMAT_EXUBERANT_META_NIBBLE",
+	-4:  "_ERROR_FORMAT_SIMPLE_HUFFMAN_ALPHABET",
+	-5:  "_ERROR_FORMAT_SIMPLE_HUFFMAN_SAME",
+	-6:  "_ERROR_FORMAT_CL_SPACE",
+	-7:  "_ERROR_FORMAT_HUFFMAN_SPACE",
+	-8:  "_ERROR_FORMAT_CONTEXT_MAP_REPEAT",
+	-9:  "_ERROR_FORMAT_BLOCK_LENGTH_1",
+	-10: "_ERROR_FORMAT_BLOCK_LENGTH_2",
+	-11: "_ERROR_FORMAT_TRANSFORM",
+	-12: "_ERROR_FORMAT_DICTIONARY",
+	-13: "_ERROR_FORMAT_WINDOW_BITS",
+	-14: "_ERROR_FORMAT_PADDING_1",
+	-15: "_ERROR_FORMAT_PADDING_2",
+	-16: "_ERROR_FORMAT_DISTANCE",
+	-17: "_ERROR_FORMAT_BLOCK_SWITCH",
+	-18: "_ERROR_COMPOUND_DICTIONARY",
+	-19: "_ERROR_DICTIONARY_NOT_SET",
+	-20: "_ERROR_INVALID_ARGUMENTS",
+	-21: "_ERROR_ALLOC_CONTEXT_MODES",
+	-22: "_ERROR_ALLOC_TREE_GROUPS",
+	-25: "_ERROR_ALLOC_CONTEXT_MAP",
+	-26: "_ERROR_ALLOC_RING_BUFFER_1",
+	-27: "_ERROR_ALLOC_RING_BUFFER_2",
+	-30: "_ERROR_ALLOC_BLOCK_TYPE_TREES",
+	-31: "_ERROR_UNREACHABLE",
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package fasthttpbrotli compresses the responses of fasthttp handlers with
+// Brotli, using cbrotli, as cbrotli.NewHTTPHandler does for net/http:
+//
+//	handler, err := fasthttpbrotli.Compress(handler, fasthttpbrotli.Options{})
+//	...
+//	server := &fasthttp.Server{Handler: handler}
+//
+// It is a separate module, so that cbrotli does not depend on fasthttp.
+package fasthttpbrotli
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/brotli/go/cbrotli"
+	"github.com/valyala/fasthttp"
+)
+
+// Options configures Compress.
+type Options struct {
+	// Writer configures the compression of responses.
+	Writer cbrotli.WriterOptions
+	// MinSize is the size of the smallest body that is compressed; 0 means
+	// 1KiB.
+	MinSize int
+	// StreamSize is the size of the smallest body that is compressed while
+	// it is sent, with SetBodyStreamWriter; smaller bodies are compressed
+	// whole and sent with a Content-Length. 0 means 64KiB.
+	StreamSize int
+	// ContentTypes lists the media types of the responses to compress, as
+	// cbrotli.HTTPOptions.ContentTypes does; nil means the same default.
+	ContentTypes []string
+}
+
+var defaultContentTypes = []string{
+	"text/*",
+	"application/json",
+	"application/javascript",
+	"application/xml",
+	"application/wasm",
+	"image/svg+xml",
+}
+
+// Compress returns a handler that compresses the responses of h with
+// Content-Encoding "br" for clients that accept it, as negotiated by
+// cbrotli.NegotiateContentEncoding, and adds Accept-Encoding to their Vary.
+//
+// Responses are sent unchanged if they have a Content-Encoding already, no
+// body (to HEAD requests, 204 and 304), a Content-Range, a Content-Type not
+// listed in options.ContentTypes, or fewer than options.MinSize bytes. Bodies
+// set as streams (SetBodyStream, SetBodyStreamWriter) are sent unchanged
+// too, as fasthttp closes a body stream that is replaced.
+//
+// The body of the response is not retained: a buffered one is compressed
+// into a pooled buffer and copied into the response with SetBody, a streamed
+// one is copied into a pooled buffer that is released once it is sent.
+// Writers are pooled as well. Compress fails if options.Writer is invalid.
+func Compress(h fasthttp.RequestHandler, options Options) (fasthttp.RequestHandler, error) {
+	encoder, err := cbrotli.NewEncoder(options.Writer)
+	if err != nil {
+		return nil, err
+	}
+	if options.MinSize <= 0 {
+		options.MinSize = 1 << 10
+	}
+	if options.StreamSize <= 0 {
+		options.StreamSize = 64 << 10
+	}
+	if options.ContentTypes == nil {
+		options.ContentTypes = defaultContentTypes
+	}
+	c := &compressor{options: options, encoder: encoder}
+	return func(ctx *fasthttp.RequestCtx) {
+		h(ctx)
+		c.compress(ctx)
+	}, nil
+}
+
+type compressor struct {
+	options Options
+	encoder *cbrotli.Encoder
+	buffers sync.Pool // *[]byte
+	writers sync.Pool // *cbrotli.Writer
+}
+
+func (c *compressor) compress(ctx *fasthttp.RequestCtx) {
+	resp := &ctx.Response
+	status := resp.StatusCode()
+	if ctx.IsHead() || status < 200 || status == fasthttp.StatusNoContent ||
+		status == fasthttp.StatusNotModified || status == fasthttp.StatusPartialContent ||
+		resp.IsBodyStream() ||
+		len(resp.Header.ContentEncoding()) != 0 || len(resp.Header.Peek(fasthttp.HeaderContentRange)) != 0 ||
+		!matchContentType(string(resp.Header.ContentType()), c.options.ContentTypes) {
+		return
+	}
+	body := resp.Body()
+	if len(body) < c.options.MinSize {
+		return
+	}
+	addVary(&resp.Header)
+	if !accepts(ctx) {
+		return
+	}
+	resp.Header.SetContentEncoding("br")
+
+	buf := c.buffer()
+	if len(body) < c.options.StreamSize {
+		*buf = c.encoder.EncodeAll(body, (*buf)[:0])
+		resp.SetBody(*buf)
+		c.buffers.Put(buf)
+		return
+	}
+	*buf = append((*buf)[:0], body...)
+	resp.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer c.buffers.Put(buf)
+		z := c.writer(w)
+		if _, err := z.Write(*buf); err != nil {
+			z.Close()
+			return
+		}
+		if z.Close() == nil {
+			c.writers.Put(z)
+		}
+		w.Flush()
+	})
+}
+
+// buffer returns a buffer from the pool.
+func (c *compressor) buffer() *[]byte {
+	if buf, ok := c.buffers.Get().(*[]byte); ok {
+		return buf
+	}
+	return new([]byte)
+}
+
+// writer returns a Writer to w from the pool.
+func (c *compressor) writer(w *bufio.Writer) *cbrotli.Writer {
+	if z, ok := c.writers.Get().(*cbrotli.Writer); ok {
+		if z.ResetOptions(w, c.options.Writer) == nil {
+			return z
+		}
+		z.Close()
+	}
+	return cbrotli.NewWriter(w, c.options.Writer)
+}
+
+// accepts reports whether the client accepts "br".
+func accepts(ctx *fasthttp.RequestCtx) bool {
+	value := ctx.Request.Header.Peek(fasthttp.HeaderAcceptEncoding)
+	if len(value) == 0 {
+		return false
+	}
+	req := &http.Request{Header: http.Header{"Accept-Encoding": {string(value)}}}
+	return cbrotli.WantsBrotli(req)
+}
+
+// addVary adds Accept-Encoding to the Vary header, unless it is listed
+// already.
+func addVary(header *fasthttp.ResponseHeader) {
+	vary := header.Peek(fasthttp.HeaderVary)
+	for _, field := range bytes.Split(vary, []byte(",")) {
+		field = bytes.TrimSpace(field)
+		if string(field) == "*" || bytes.EqualFold(field, []byte("Accept-Encoding")) {
+			return
+		}
+	}
+	if len(vary) == 0 {
+		header.Set(fasthttp.HeaderVary, "Accept-Encoding")
+	} else {
+		header.Set(fasthttp.HeaderVary, string(vary)+", Accept-Encoding")
+	}
+}
+
+// matchContentType reports whether the media type of a Content-Type value is
+// listed in types; "type/*" matches all subtypes.
+func matchContentType(contentType string, types []string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, t := range types {
+		if prefix, ok := strings.CutSuffix(t, "/*"); ok {
+			if strings.HasPrefix(mediaType, strings.ToLower(prefix)+"/") {
+				return true
+			}
+		} else if strings.EqualFold(mediaType, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package fasthttpbrotli_test
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+	"github.com/google/brotli/go/cbrotli/fasthttpbrotli"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttputil"
+)
+
+func TestCompress(t *testing.T) {
+	text := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 5000))
+	handler := func(ctx *fasthttp.RequestCtx) {
+		body := text
+		switch string(ctx.Path()) {
+		case "/small":
+			body = text[:100]
+		case "/medium":
+			body = text[:20000]
+		case "/image":
+			ctx.SetContentType("image/png")
+		case "/encoded":
+			ctx.Response.Header.SetContentEncoding("gzip")
+		case "/stream":
+			ctx.SetBodyStream(bytes.NewReader(text), len(text))
+			return
+		case "/vary":
+			ctx.Response.Header.Set("Vary", "Origin")
+		}
+		ctx.SetBody(body)
+	}
+	if _, err := fasthttpbrotli.Compress(handler, fasthttpbrotli.Options{
+		Writer: cbrotli.WriterOptions{Quality: cbrotli.MaxQuality + 1},
+	}); err == nil {
+		t.Error("Compress accepted an invalid quality")
+	}
+	compressed, err := fasthttpbrotli.Compress(handler, fasthttpbrotli.Options{
+		Writer:     cbrotli.WriterOptions{Quality: 5},
+		StreamSize: 100000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln := fasthttputil.NewInmemoryListener()
+	defer ln.Close()
+	server := &fasthttp.Server{Handler: compressed}
+	go server.Serve(ln)
+	client := &fasthttp.Client{Dial: func(string) (net.Conn, error) { return ln.Dial() }}
+
+	for _, tc := range []struct {
+		path, accept string
+		compressed   bool
+		streamed     bool
+		want         []byte
+	}{
+		{"/medium", "gzip, br", true, false, text[:20000]},
+		{"/large", "br", true, true, text},
+		{"/medium", "gzip", false, false, text[:20000]},
+		{"/medium", "br;q=0", false, false, text[:20000]},
+		{"/small", "br", false, false, text[:100]},
+		{"/image", "br", false, false, text},
+		{"/encoded", "br", false, false, text},
+		{"/stream", "br", false, false, text},
+		{"/vary", "br", true, true, text},
+	} {
+		// Several rounds reuse pooled buffers and Writers.
+		for i := 0; i < 2; i++ {
+			req := fasthttp.AcquireRequest()
+			resp := fasthttp.AcquireResponse()
+			req.SetRequestURI("http://test" + tc.path)
+			req.Header.Set("Accept-Encoding", tc.accept)
+			if err := client.Do(req, resp); err != nil {
+				t.Fatalf("%s: %v", tc.path, err)
+			}
+			name := tc.path + " with " + tc.accept
+			body := resp.Body()
+			if got := string(resp.Header.ContentEncoding()) == "br"; got != tc.compressed {
+				t.Errorf("%s: Content-Encoding %q", name, resp.Header.ContentEncoding())
+			} else if tc.compressed {
+				if streamed := resp.Header.ContentLength() < 0; streamed != tc.streamed {
+					t.Errorf("%s: Content-Length %d", name, resp.Header.ContentLength())
+				}
+				var err error
+				if body, err = cbrotli.Decode(body); err != nil {
+					t.Errorf("%s: %v", name, err)
+				}
+			}
+			if !bytes.Equal(body, tc.want) {
+				t.Errorf("%s: body of %d bytes, want %d", name, len(body), len(tc.want))
+			}
+			if tc.compressed && tc.path == "/vary" {
+				if vary := string(resp.Header.Peek("Vary")); vary != "Origin, Accept-Encoding" {
+					t.Errorf("%s: Vary %q", name, vary)
+				}
+			}
+			fasthttp.ReleaseRequest(req)
+			fasthttp.ReleaseResponse(resp)
+		}
+	}
+}
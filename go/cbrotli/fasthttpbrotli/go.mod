@@ -0,0 +1,18 @@
+module github.com/google/brotli/go/cbrotli/fasthttpbrotli
+
+go 1.21
+
+require (
+	github.com/google/brotli/go/cbrotli v0.1.0
+	github.com/valyala/fasthttp v1.55.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.0 // indirect
+	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+)
+
+// Builds in this repository use the cbrotli next to it; replace has no
+// effect on importers, which get the version required above.
+replace github.com/google/brotli/go/cbrotli => ../
@@ -0,0 +1,162 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileOptions configures CompressFileWithOptions and
+// DecompressFileWithOptions.
+type FileOptions struct {
+	// Writer configures the compression of CompressFileWithOptions.
+	Writer WriterOptions
+	// Reader configures the decompression of DecompressFileWithOptions.
+	Reader ReaderOptions
+	// Overwrite allows replacing an existing destination file. Without it,
+	// the functions fail with an error matching fs.ErrExist, even if the
+	// destination appears while they run.
+	Overwrite bool
+	// RemoveSource removes the source file once the destination is in place;
+	// if that fails, the error is returned with the destination kept.
+	RemoveSource bool
+}
+
+// CompressFile compresses the file src to dst, which must not exist; see
+// CompressFileWithOptions.
+func CompressFile(src, dst string, options WriterOptions) error {
+	return CompressFileWithOptions(src, dst, FileOptions{Writer: options})
+}
+
+// DecompressFile decompresses the file src to dst, which must not exist; see
+// DecompressFileWithOptions.
+func DecompressFile(src, dst string) error {
+	return DecompressFileWithOptions(src, dst, FileOptions{})
+}
+
+// CompressFileWithOptions compresses the regular file src to dst. The output
+// is written to a temporary file with a unique name in the directory of dst,
+// synced, given the permissions and the modification time of src, and then
+// renamed to dst, so that dst is either absent (or unchanged) or complete,
+// whatever the error. Only a process killed midway leaves its temporary file,
+// named ".<base of dst>.tmp<random>", behind.
+func CompressFileWithOptions(src, dst string, options FileOptions) error {
+	return transformFile(src, dst, options, func(out io.Writer, in io.Reader) error {
+		w := NewWriter(out, options.Writer)
+		if _, err := io.Copy(w, in); err != nil {
+			w.Close()
+			return err
+		}
+		return w.Close()
+	})
+}
+
+// DecompressFileWithOptions is like CompressFileWithOptions, but decompresses
+// src. A corrupt or truncated src leaves no output.
+func DecompressFileWithOptions(src, dst string, options FileOptions) error {
+	return transformFile(src, dst, options, func(out io.Writer, in io.Reader) error {
+		r := NewReaderWithOptions(in, options.Reader)
+		defer r.Close()
+		_, err := io.Copy(out, r)
+		return err
+	})
+}
+
+// transformFile writes the output of transform for src to dst atomically.
+func transformFile(src, dst string, options FileOptions, transform func(out io.Writer, in io.Reader) error) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return &fs.PathError{Op: "open", Path: src, Err: errors.New("not a regular file")}
+	}
+	if di, err := os.Stat(dst); err == nil {
+		if os.SameFile(info, di) {
+			return &fs.PathError{Op: "create", Path: dst, Err: errors.New("same file as the source")}
+		}
+		if !options.Overwrite {
+			return &fs.PathError{Op: "create", Path: dst, Err: fs.ErrExist}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmpName)
+		}
+	}()
+	if err = transform(tmp, in); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chtimes(tmpName, time.Time{}, info.ModTime()); err != nil {
+		return err
+	}
+	if err = publishFile(tmpName, dst, options.Overwrite); err != nil {
+		return err
+	}
+	syncDir(filepath.Dir(dst))
+	if options.RemoveSource {
+		return os.Remove(src)
+	}
+	return nil
+}
+
+// publishFile renames tmp to dst. Unless overwrite is set, it fails if dst
+// exists: a hard link, where the file system supports them, makes the check
+// atomic; tmp is removed either way on success.
+func publishFile(tmp, dst string, overwrite bool) error {
+	if overwrite {
+		return os.Rename(tmp, dst)
+	}
+	err := os.Link(tmp, dst)
+	if err == nil {
+		os.Remove(tmp)
+		return nil
+	}
+	if errors.Is(err, fs.ErrExist) {
+		return &fs.PathError{Op: "create", Path: dst, Err: fs.ErrExist}
+	}
+	// No hard links: check, then rename.
+	if _, err := os.Lstat(dst); err == nil {
+		return &fs.PathError{Op: "create", Path: dst, Err: fs.ErrExist}
+	}
+	return os.Rename(tmp, dst)
+}
+
+// syncDir makes a rename in dir durable, where directories can be synced.
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+}
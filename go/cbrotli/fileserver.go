@@ -0,0 +1,308 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// CachingFileServerOptions configures NewCachingFileServer.
+type CachingFileServerOptions struct {
+	// Writer configures the compression of files.
+	Writer WriterOptions
+	// MaxBytes bounds the total size of the compressed files in the cache;
+	// 0 means 64MiB.
+	MaxBytes int64
+	// MaxEntrySize is the size of the largest file that is compressed into
+	// the cache; 0 means 4MiB. Larger files are compressed while they are
+	// sent, as by NewHTTPHandler, every time.
+	MaxEntrySize int64
+	// MinSize and ContentTypes select the files to compress, as in
+	// HTTPOptions; the Content-Type of a file is that of its extension, or
+	// sniffed from its content.
+	MinSize      int
+	ContentTypes []string
+}
+
+const (
+	defaultFileCacheBytes     = 64 << 20
+	defaultFileCacheEntrySize = 4 << 20
+)
+
+// CachingFileServer serves the files of a file system like
+// http.FileServer, compressed with Brotli on demand for the clients that
+// accept "br", and keeps the compressed files in memory, so that a file is
+// compressed once while it is in demand rather than for every request. It is
+// safe for concurrent use.
+//
+// Cached files are keyed by path, size, modification time and quality: a
+// file that changes is compressed again, and its stale entry dropped, on the
+// next request for it. Concurrent requests for a file that is not cached wait
+// for a single compression. The least recently used files are evicted when
+// the cache is over options.MaxBytes.
+//
+// A compressed file is served with http.ServeContent, with the modification
+// time of the file as Last-Modified, a strong ETag derived from the content
+// and specific to the Brotli representation, and "Vary: Accept-Encoding";
+// range requests select bytes of the compressed file. Other responses,
+// directory listings and redirects are those of http.FileServer.
+type CachingFileServer struct {
+	options CachingFileServerOptions
+	root    fs.FS
+	files   http.Handler // identity responses
+	stream  http.Handler // files over MaxEntrySize
+
+	mu      sync.Mutex
+	entries map[fileKey]*fileEntry
+	byName  map[string]*fileEntry // latest entry of each path
+	lru     list.List             // *fileEntry; most recently used at the front
+	stats   CacheStats
+}
+
+type fileKey struct {
+	name          string
+	size, modtime int64
+	quality       int
+}
+
+type fileEntry struct {
+	key   fileKey
+	data  []byte // compressed file
+	ctype string
+	etag  string
+	ready chan struct{} // closed when data or err is set
+	err   error
+	elem  *list.Element
+}
+
+// NewCachingFileServer returns a CachingFileServer for the files of root. It
+// panics if options.Writer is invalid.
+func NewCachingFileServer(root fs.FS, options CachingFileServerOptions) *CachingFileServer {
+	if err := options.Writer.validate(); err != nil {
+		panic(err)
+	}
+	if options.MaxBytes <= 0 {
+		options.MaxBytes = defaultFileCacheBytes
+	}
+	if options.MaxEntrySize <= 0 {
+		options.MaxEntrySize = defaultFileCacheEntrySize
+	}
+	if options.MinSize <= 0 {
+		options.MinSize = defaultHTTPMinSize
+	}
+	if options.ContentTypes == nil {
+		options.ContentTypes = defaultHTTPContentTypes
+	}
+	files := http.FileServer(http.FS(root))
+	return &CachingFileServer{
+		options: options,
+		root:    root,
+		files:   files,
+		stream: NewHTTPHandler(files, HTTPOptions{
+			Writer:       options.Writer,
+			MinSize:      options.MinSize,
+			ContentTypes: options.ContentTypes,
+		}),
+		entries: make(map[fileKey]*fileEntry),
+		byName:  make(map[string]*fileEntry),
+	}
+}
+
+// Stats returns the activity of the cache: Hits counts the requests served
+// from memory, and Misses the compressions into the cache.
+func (s *CachingFileServer) Stats() CacheStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+func (s *CachingFileServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	name, info := s.resolve(req.URL.Path)
+	if info == nil || int64(s.options.MinSize) > info.Size() {
+		s.files.ServeHTTP(rw, req)
+		return
+	}
+	if info.Size() > s.options.MaxEntrySize {
+		s.stream.ServeHTTP(rw, req)
+		return
+	}
+	ctype := mime.TypeByExtension(path.Ext(name))
+	if ctype != "" && !matchContentType(ctype, s.options.ContentTypes) {
+		s.files.ServeHTTP(rw, req)
+		return
+	}
+	if !WantsBrotli(req) {
+		if ctype != "" {
+			// Compressible, but not for this client.
+			rw.Header().Add("Vary", "Accept-Encoding")
+		}
+		s.files.ServeHTTP(rw, req)
+		return
+	}
+	e, err := s.get(req, name, info)
+	if err != nil || e.data == nil {
+		// Unreadable, changed while read, or not compressible.
+		s.stream.ServeHTTP(rw, req)
+		return
+	}
+	header := rw.Header()
+	header.Add("Vary", "Accept-Encoding")
+	header.Set("Content-Type", e.ctype)
+	header.Set("Content-Encoding", "br")
+	header.Set("Etag", e.etag)
+	http.ServeContent(rw, req, name, info.ModTime(), bytes.NewReader(e.data))
+}
+
+// resolve returns the name and the FileInfo of the regular file served for
+// the URL path upath, or a nil FileInfo if http.FileServer is to handle the
+// request: for redirects, directory listings and errors.
+func (s *CachingFileServer) resolve(upath string) (string, fs.FileInfo) {
+	if !strings.HasPrefix(upath, "/") {
+		upath = "/" + upath
+	}
+	if strings.HasSuffix(upath, "/index.html") {
+		return "", nil
+	}
+	name := strings.TrimPrefix(path.Clean(upath), "/")
+	if name == "" {
+		name = "."
+	}
+	if !fs.ValidPath(name) {
+		return "", nil
+	}
+	info, err := fs.Stat(s.root, name)
+	if err != nil {
+		return "", nil
+	}
+	if info.IsDir() {
+		if !strings.HasSuffix(upath, "/") {
+			return "", nil
+		}
+		name = path.Join(name, "index.html")
+		if info, err = fs.Stat(s.root, name); err != nil {
+			return "", nil
+		}
+	} else if strings.HasSuffix(upath, "/") {
+		return "", nil
+	}
+	if !info.Mode().IsRegular() {
+		return "", nil
+	}
+	return name, info
+}
+
+// get returns the entry of the file name, compressing it on a miss. The entry
+// has no data if the file is not to be compressed after all.
+func (s *CachingFileServer) get(req *http.Request, name string, info fs.FileInfo) (*fileEntry, error) {
+	key := fileKey{name, info.Size(), info.ModTime().UnixNano(), s.options.Writer.Quality}
+	s.mu.Lock()
+	e := s.entries[key]
+	if e != nil {
+		s.stats.Hits++
+		s.lru.MoveToFront(e.elem)
+		s.mu.Unlock()
+		select {
+		case <-e.ready:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		return e, e.err
+	}
+	s.stats.Misses++
+	if old := s.byName[name]; old != nil && old.data != nil {
+		// The file has changed.
+		s.remove(old)
+	}
+	e = &fileEntry{key: key, ready: make(chan struct{})}
+	e.elem = s.lru.PushFront(e)
+	s.entries[key] = e
+	s.byName[name] = e
+	s.mu.Unlock()
+
+	data, ctype, etag, err := s.compress(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.data, e.ctype, e.etag, e.err = data, ctype, etag, err
+	close(e.ready)
+	if err != nil || data == nil {
+		s.remove(e)
+		return e, err
+	}
+	s.stats.Entries++
+	s.stats.Bytes += int64(len(data))
+	s.evict()
+	return e, nil
+}
+
+// compress reads and compresses the file of key. It returns no data if the
+// file no longer matches the key, or if its sniffed type is not compressible.
+func (s *CachingFileServer) compress(key fileKey) (data []byte, ctype, etag string, err error) {
+	f, err := s.root.Open(key.name)
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer f.Close()
+	content, err := io.ReadAll(io.LimitReader(f, key.size+1))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if int64(len(content)) != key.size {
+		return nil, "", "", nil
+	}
+	ctype = mime.TypeByExtension(path.Ext(key.name))
+	if ctype == "" {
+		ctype = http.DetectContentType(content)
+		if !matchContentType(ctype, s.options.ContentTypes) {
+			return nil, "", "", nil
+		}
+	}
+	if data, err = Encode(content, s.options.Writer); err != nil {
+		return nil, "", "", err
+	}
+	if int64(len(data)) > s.options.MaxBytes {
+		return nil, "", "", nil
+	}
+	sum := sha256.Sum256(content)
+	return data, ctype, `"` + base64.RawURLEncoding.EncodeToString(sum[:12]) + `-br"`, nil
+}
+
+// evict drops least recently used entries while the cache is over MaxBytes.
+// Entries being compressed take no memory yet and are skipped.
+func (s *CachingFileServer) evict() {
+	for elem := s.lru.Back(); elem != nil && s.stats.Bytes > s.options.MaxBytes; {
+		e := elem.Value.(*fileEntry)
+		elem = elem.Prev()
+		if e.data != nil {
+			s.stats.Evictions++
+			s.remove(e)
+		}
+	}
+}
+
+// remove drops e from the cache; its data stays valid for the requests
+// serving it.
+func (s *CachingFileServer) remove(e *fileEntry) {
+	if e.data != nil {
+		s.stats.Entries--
+		s.stats.Bytes -= int64(len(e.data))
+	}
+	s.lru.Remove(e.elem)
+	delete(s.entries, e.key)
+	if s.byName[e.key.name] == e {
+		delete(s.byName, e.key.name)
+	}
+}
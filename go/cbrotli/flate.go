@@ -0,0 +1,79 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"fmt"
+	"io"
+)
+
+// Compression levels of NewWriterLevel and NewWriterDict, with the values of
+// compress/flate, so that code written for compress/flate or compress/gzip
+// can switch packages. Brotli has no stored or Huffman-only mode: those
+// levels select MinQuality, the fastest Brotli compression.
+const (
+	HuffmanOnly        = -2
+	DefaultCompression = -1
+	NoCompression      = 0
+	BestSpeed          = 1
+	BestCompression    = 9
+)
+
+// levelQualities maps the levels 0 to 9 to qualities.
+var levelQualities = [...]int{0, 1, 2, 3, 4, 5, 6, 7, 9, MaxQuality}
+
+// levelQuality returns the quality of a compression level; see NewWriterLevel.
+func levelQuality(level int) (int, error) {
+	switch {
+	case level == HuffmanOnly:
+		return MinQuality, nil
+	case level == DefaultCompression:
+		return levelQualities[6], nil
+	case level < 0 || level >= len(levelQualities):
+		return 0, fmt.Errorf("cbrotli: invalid compression level %d: want value in range [%d, %d]",
+			level, HuffmanOnly, BestCompression)
+	}
+	return levelQualities[level], nil
+}
+
+// NewWriterLevel is like NewWriter with a compression level of
+// compress/flate instead of WriterOptions, for code migrating from it. The
+// levels 0 to 7 select the same qualities, 8 selects 9 and BestCompression
+// (9) MaxQuality; DefaultCompression, like in compress/flate, is level 6. The
+// error is non-nil only if level is invalid.
+// Close MUST be called to free resources.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	quality, err := levelQuality(level)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriter(w, WriterOptions{Quality: quality}), nil
+}
+
+// NewWriterDict is like NewWriterLevel with a preset dictionary, used as a
+// raw (LZ77 prefix) shared dictionary; the output must be read with
+// NewReaderDict and the same dictionary. The levels below 2 (including
+// BestSpeed) select qualities that do not use dictionaries. dict must not be
+// modified until the Writer is closed.
+// Close MUST be called to free resources.
+func NewWriterDict(w io.Writer, level int, dict []byte) (*Writer, error) {
+	quality, err := levelQuality(level)
+	if err != nil {
+		return nil, err
+	}
+	options := WriterOptions{Quality: quality}
+	if len(dict) == 0 {
+		return NewWriter(w, options), nil
+	}
+	return NewWriterWithDictionaries(w, options, []Dictionary{{Data: dict, Type: DtRaw}}), nil
+}
+
+// NewReaderDict is like compress/flate.NewReaderDict: it is
+// NewReaderWithRawDictionary(r, dict), for streams written by NewWriterDict.
+// Close MUST be called to free resources.
+func NewReaderDict(r io.Reader, dict []byte) *Reader {
+	return NewReaderWithRawDictionary(r, dict)
+}
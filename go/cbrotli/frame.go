@@ -0,0 +1,478 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"math"
+	"slices"
+)
+
+// Framed format (.brc)
+//
+// A framed file wraps a single Brotli stream with checksums, so that storage
+// corruption is detected instead of decoding into garbage:
+//
+//	header chunk_0 ... chunk_{n-1} end
+//
+// header is 8 bytes:
+//
+//	magic            frameMagic
+//	version          1 byte, frameVersion
+//	flags            1 byte, zero; readers reject unknown flags
+//	reserved         2 zero bytes
+//
+// Each chunk holds the next piece of the Brotli stream:
+//
+//	length           uint32, little endian, not zero
+//	checksum         uint32, little endian: CRC-32C of payload
+//	payload          length bytes
+//
+// end marks the end of the content; it is 16 bytes:
+//
+//	zero             uint32, a chunk length of 0
+//	size             uint64, little endian: size of the uncompressed content
+//	digest           uint32, little endian: CRC-32C of the uncompressed content
+//
+// The payloads concatenated form the Brotli stream. A chunk holds the
+// compressed data of (up to) a fixed amount of input, and a Flush of the
+// writer ends the current chunk, so that a consumer can check and decode each
+// chunk as it arrives. A chunk ends at a byte boundary of the stream, but it
+// cannot be decoded without the chunks before it. FrameReader releases the
+// content of a chunk only once its checksum matches.
+
+const (
+	frameMagic      = "\xceBRC"
+	frameVersion    = 1
+	frameHeaderSize = 8
+	frameEndSize    = 16
+	// defaultFrameChunkSize is the FrameWriter chunk size if ChunkSize is not
+	// set.
+	defaultFrameChunkSize = 1 << 20
+	// maxFrameChunkSize keeps the compressed size of chunks within uint32.
+	maxFrameChunkSize = 1 << 30
+	// maxFramePayloadSize bounds the payload of a chunk of maxFrameChunkSize
+	// bytes of input, see compressBound; readers reject larger chunks.
+	maxFramePayloadSize = maxFrameChunkSize + maxFrameChunkSize>>12 + 1024
+	// frameReadSize is the first part of a payload that FrameReader reads.
+	frameReadSize = 64 << 10
+)
+
+// castagnoli is the table of CRC-32C, the checksum of framed files.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// FrameWriter implements io.WriteCloser by writing a framed Brotli stream
+// (.brc, see the format above) to an underlying Writer.
+//
+// Input is compressed into a single Brotli stream, whose output is cut into
+// checksummed chunks: a chunk holds the compressed data of
+// WriterOptions.ChunkSize bytes of input, or of the input written before a
+// Flush. Close writes the end of the file, with the size and the CRC-32C of the
+// whole content.
+//
+// WriterOptions.FlushInterval is ignored.
+type FrameWriter struct {
+	dst     io.Writer
+	w       *Writer
+	buf     bytes.Buffer // output of w for the current chunk
+	chunk   int
+	pending int // input of the current chunk
+	size    int64
+	digest  hash.Hash32
+	header  bool  // the header has been written
+	err     error // first error; sticky
+	closed  bool
+}
+
+// NewFrameWriter initializes new FrameWriter instance.
+// Close MUST be called to free resources and to write the end of the file.
+func NewFrameWriter(dst io.Writer, options WriterOptions) *FrameWriter {
+	f := &FrameWriter{dst: dst, digest: crc32.New(castagnoli)}
+	f.chunk = options.ChunkSize
+	if f.chunk == 0 {
+		f.chunk = defaultFrameChunkSize
+	}
+	if f.chunk > maxFrameChunkSize {
+		f.err = fmt.Errorf("cbrotli: chunk size %d above %d", f.chunk, maxFrameChunkSize)
+	}
+	options.FlushInterval = 0
+	f.w = NewWriter(&f.buf, options)
+	if f.err == nil {
+		f.err = options.validate()
+	}
+	return f
+}
+
+// Write implements io.Writer.
+func (f *FrameWriter) Write(p []byte) (n int, err error) {
+	if f.closed {
+		return 0, ErrWriterClosed
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+	for len(p) > 0 {
+		m, err := f.w.Write(p[:min(len(p), f.chunk-f.pending)])
+		f.digest.Write(p[:m])
+		f.size += int64(m)
+		f.pending += m
+		n += m
+		if err != nil {
+			f.err = err
+			return n, err
+		}
+		p = p[m:]
+		if f.pending == f.chunk {
+			if err := f.endChunk(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// endChunk flushes the encoder and writes its output as a chunk.
+func (f *FrameWriter) endChunk() error {
+	if err := f.w.Flush(); err != nil {
+		f.err = err
+		return err
+	}
+	return f.writeChunk()
+}
+
+// writeChunk writes the output of the encoder as a chunk, if there is any.
+func (f *FrameWriter) writeChunk() error {
+	f.pending = 0
+	if f.buf.Len() == 0 {
+		return nil
+	}
+	var b []byte
+	if !f.header {
+		b = append(b, frameMagic...)
+		b = append(b, frameVersion, 0, 0, 0)
+	}
+	payload := f.buf.Bytes()
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(payload)))
+	b = binary.LittleEndian.AppendUint32(b, crc32.Checksum(payload, castagnoli))
+	if err := f.write(b, payload); err != nil {
+		return err
+	}
+	f.header = true
+	f.buf.Reset()
+	return nil
+}
+
+// write writes the parts to dst; a write that takes a part of them fails with
+// io.ErrShortWrite.
+func (f *FrameWriter) write(parts ...[]byte) error {
+	for _, p := range parts {
+		n, err := f.dst.Write(p)
+		if err == nil && n < len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			f.err = err
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush ends the current chunk: it outputs encoded data for all input provided
+// to Write, so that readers can check and decode it.
+func (f *FrameWriter) Flush() error {
+	if f.closed {
+		return ErrWriterClosed
+	}
+	if f.err != nil {
+		return f.err
+	}
+	return f.endChunk()
+}
+
+// Close finishes the stream, writes its last chunk and the end of the file.
+func (f *FrameWriter) Close() error {
+	if f.closed {
+		return ErrWriterClosed
+	}
+	f.closed = true
+	err := f.w.Close()
+	if f.err != nil {
+		return f.err
+	}
+	if err != nil {
+		f.err = err
+		return err
+	}
+	if err := f.writeChunk(); err != nil {
+		return err
+	}
+	end := make([]byte, 4, frameEndSize)
+	end = binary.LittleEndian.AppendUint64(end, uint64(f.size))
+	end = binary.LittleEndian.AppendUint32(end, f.digest.Sum32())
+	return f.write(end)
+}
+
+// FrameError is returned by FrameReader and VerifyFile when a framed file is
+// malformed or corrupted. It locates the damage: Offset and Length are the
+// byte range of the part of the file that failed.
+type FrameError struct {
+	// Chunk is the index of the failed chunk, or -1 for the header and the end
+	// of the file.
+	Chunk  int
+	Offset int64
+	Length int64
+	Reason string
+}
+
+func (e *FrameError) Error() string {
+	part := "header"
+	if e.Chunk >= 0 {
+		part = fmt.Sprintf("chunk %d", e.Chunk)
+	} else if e.Offset > 0 {
+		part = "end"
+	}
+	return fmt.Sprintf("cbrotli: framed file: %s (bytes %d to %d): %s", part, e.Offset, e.Offset+e.Length, e.Reason)
+}
+
+// FrameReaderOptions configures FrameReader.
+type FrameReaderOptions struct {
+	// Reader configures the decoding of the Brotli stream, e.g. with the
+	// dictionaries it was compressed with.
+	Reader ReaderOptions
+	// SkipVerification disables the checksums of chunks and the digest of the
+	// content, for trusted sources; the structure of the file and the size of
+	// the content are still checked.
+	SkipVerification bool
+}
+
+// FrameReader implements io.ReadCloser by reading the content of a framed
+// Brotli file (.brc, see FrameWriter) from an underlying Reader.
+//
+// The checksum of each chunk is verified before any of its content is
+// returned, and the size and the digest of the content are checked at the end
+// of the file: damage is reported with a *FrameError before Read returns
+// io.EOF.
+type FrameReader struct {
+	src     io.Reader
+	r       *Reader
+	verify  bool
+	header  bool  // the header has been read
+	chunks  int   // chunks read
+	offset  int64 // in the file, of the next record
+	buf     []byte
+	payload []byte // verified, not yet given to r
+	ended   bool   // the end record has been read
+	end     [frameEndSize]byte
+	size    int64
+	digest  hash.Hash32
+	err     error // first framing or read error; sticky
+	closed  bool
+}
+
+// NewFrameReader initializes new FrameReader instance.
+// Close MUST be called to free resources.
+func NewFrameReader(src io.Reader) *FrameReader {
+	return NewFrameReaderWithOptions(src, FrameReaderOptions{})
+}
+
+// NewFrameReaderWithOptions initializes new FrameReader instance with given
+// options.
+// Close MUST be called to free resources.
+func NewFrameReaderWithOptions(src io.Reader, options FrameReaderOptions) *FrameReader {
+	f := &FrameReader{
+		src:    src,
+		verify: !options.SkipVerification,
+		digest: crc32.New(castagnoli),
+	}
+	f.r = NewReaderWithOptions(frameChunks{f}, options.Reader)
+	return f
+}
+
+// fail records a FrameError about the record at the current offset.
+func (f *FrameReader) fail(chunk int, length int64, reason string, args ...any) error {
+	f.err = &FrameError{Chunk: chunk, Offset: f.offset, Length: length, Reason: fmt.Sprintf(reason, args...)}
+	return f.err
+}
+
+// readFull reads len(p) bytes of the record at the current offset that
+// started with head bytes.
+func (f *FrameReader) readFull(p []byte, chunk int, head int) error {
+	if _, err := io.ReadFull(f.src, p); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return f.fail(chunk, int64(head+len(p)), "truncated")
+		}
+		f.err = fmt.Errorf("cbrotli: reading source: %w", err)
+		return f.err
+	}
+	return nil
+}
+
+// readHeader reads and checks the header.
+func (f *FrameReader) readHeader() error {
+	var header [frameHeaderSize]byte
+	if err := f.readFull(header[:], -1, 0); err != nil {
+		return err
+	}
+	switch {
+	case string(header[:4]) != frameMagic:
+		return f.fail(-1, frameHeaderSize, "not a framed file")
+	case header[4] != frameVersion:
+		return f.fail(-1, frameHeaderSize, "unsupported version %d", header[4])
+	case header[5] != 0:
+		return f.fail(-1, frameHeaderSize, "unknown flags %#x", header[5])
+	case header[6] != 0 || header[7] != 0:
+		return f.fail(-1, frameHeaderSize, "reserved bytes not zero")
+	}
+	f.header = true
+	f.offset = frameHeaderSize
+	return nil
+}
+
+// readRecord reads the next chunk, verified, into f.payload, or the end of the
+// file into f.end.
+func (f *FrameReader) readRecord() error {
+	if !f.header {
+		if err := f.readHeader(); err != nil {
+			return err
+		}
+	}
+	var head [8]byte
+	if err := f.readFull(head[:4], f.chunks, 0); err != nil {
+		return err
+	}
+	n := binary.LittleEndian.Uint32(head[:])
+	if n == 0 {
+		if err := f.readFull(f.end[4:], -1, 4); err != nil {
+			return err
+		}
+		f.ended = true
+		return nil
+	}
+	if n > maxFramePayloadSize {
+		return f.fail(f.chunks, 8+int64(n), "chunk of %d bytes above the limit", n)
+	}
+	if err := f.readFull(head[4:], f.chunks, 4); err != nil {
+		return err
+	}
+	// The length is not verified yet: the buffer grows as the payload
+	// arrives, so that a corrupt length costs no more memory than the file
+	// holds.
+	payload := f.buf[:0]
+	for len(payload) < int(n) {
+		step := min(int(n)-len(payload), max(len(payload), frameReadSize))
+		payload = slices.Grow(payload, step)
+		// A truncated payload is reported for the whole record.
+		if err := f.readFull(payload[len(payload):len(payload)+step], f.chunks, 8+int(n)-step); err != nil {
+			return err
+		}
+		payload = payload[:len(payload)+step]
+	}
+	f.buf = payload
+	if f.verify && binary.LittleEndian.Uint32(head[4:]) != crc32.Checksum(payload, castagnoli) {
+		return f.fail(f.chunks, 8+int64(n), "checksum mismatch")
+	}
+	f.payload = payload
+	f.chunks++
+	f.offset += 8 + int64(n)
+	return nil
+}
+
+// frameChunks is the source of the Reader of a FrameReader: the verified
+// payloads of chunks.
+type frameChunks struct {
+	f *FrameReader
+}
+
+func (c frameChunks) Read(p []byte) (int, error) {
+	f := c.f
+	for len(f.payload) == 0 {
+		if f.err != nil {
+			return 0, f.err
+		}
+		if f.ended {
+			return 0, io.EOF
+		}
+		f.readRecord()
+	}
+	n := copy(p, f.payload)
+	f.payload = f.payload[n:]
+	return n, nil
+}
+
+// Read implements io.Reader.
+func (f *FrameReader) Read(p []byte) (n int, err error) {
+	if f.closed {
+		return 0, errReaderClosed
+	}
+	n, err = f.r.Read(p)
+	if f.verify {
+		f.digest.Write(p[:n])
+	}
+	f.size += int64(n)
+	switch {
+	case f.err != nil && err != nil:
+		err = f.err
+	case err == io.EOF:
+		if endErr := f.checkEnd(); endErr != nil {
+			err = endErr
+		}
+	}
+	return n, err
+}
+
+// checkEnd checks the end of the file, once the Brotli stream is decoded.
+func (f *FrameReader) checkEnd() error {
+	if f.err != nil {
+		return f.err
+	}
+	if !f.ended {
+		if err := f.readRecord(); err != nil {
+			return err
+		}
+		if !f.ended {
+			f.offset -= 8 + int64(len(f.payload))
+			return f.fail(f.chunks-1, 8+int64(len(f.payload)), "chunk after the end of the Brotli stream")
+		}
+	}
+	if size := binary.LittleEndian.Uint64(f.end[4:]); size != uint64(f.size) {
+		return f.fail(-1, frameEndSize, "content size %d, decoded %d bytes", size, f.size)
+	}
+	if f.verify && binary.LittleEndian.Uint32(f.end[12:]) != f.digest.Sum32() {
+		return f.fail(-1, frameEndSize, "content digest mismatch")
+	}
+	var b [1]byte
+	if n, _ := io.ReadFull(f.src, b[:]); n != 0 {
+		f.offset += frameEndSize
+		return f.fail(-1, 1, "data after the end of the file")
+	}
+	return nil
+}
+
+// Close releases the decoder; it does not close the source.
+func (f *FrameReader) Close() error {
+	if f.closed {
+		return errReaderClosed
+	}
+	f.closed = true
+	return f.r.Close()
+}
+
+// VerifyFile checks the integrity of the framed file provided by src: its
+// structure, the checksums of its chunks, and the size and the digest of its
+// content, which is decoded and discarded. Damage is reported with a
+// *FrameError.
+func VerifyFile(src io.ReaderAt) error {
+	f := NewFrameReader(io.NewSectionReader(src, 0, math.MaxInt64))
+	defer f.Close()
+	_, err := io.Copy(io.Discard, f)
+	return err
+}
@@ -0,0 +1,405 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LoadDictionaryFS reads the dictionary at path in fsys (e.g. an embed.FS or
+// os.DirFS) and copies it to a new DecoderDictionary; see NewDecoderDictionary
+// and NewSerializedDecoderDictionary. Errors mention the path.
+// Close MUST be called to free resources.
+func LoadDictionaryFS(fsys fs.FS, path string, dictionaryType DictionaryType) (*DecoderDictionary, error) {
+	data, err := readDictionaryFS(fsys, path, dictionaryType)
+	if err != nil {
+		return nil, err
+	}
+	var d *DecoderDictionary
+	if dictionaryType == DtSerialized {
+		d, err = NewSerializedDecoderDictionary(data)
+	} else {
+		d, err = NewDecoderDictionary(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	return d, nil
+}
+
+// LoadPreparedDictionaryFS reads the dictionary at path in fsys and prepares
+// it with options; see PrepareDictionary. Errors mention the path.
+// Close MUST be called to free resources.
+//
+// To share dictionaries loaded repeatedly, use a PreparedDictionaryCache with
+// FSDictionaryLoader instead.
+func LoadPreparedDictionaryFS(fsys fs.FS, path string, dictionaryType DictionaryType, options PrepareDictionaryOptions) (*PreparedDictionary, error) {
+	data, err := readDictionaryFS(fsys, path, dictionaryType)
+	if err != nil {
+		return nil, err
+	}
+	d, err := PrepareDictionary(data, dictionaryType, options)
+	if err != nil {
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	return d, nil
+}
+
+// FSDictionaryLoader returns a function that reads and validates dictionaries
+// of dictionaryType from fsys, for PreparedDictionaryCacheOptions.Load; the
+// cache keys are then paths in fsys, and Get returns the same dictionary for
+// repeated loads of a path.
+func FSDictionaryLoader(fsys fs.FS, dictionaryType DictionaryType) func(path string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		return readDictionaryFS(fsys, path, dictionaryType)
+	}
+}
+
+// readDictionaryFS reads a dictionary and checks its size and, for serialized
+// dictionaries, its format.
+func readDictionaryFS(fsys fs.FS, path string, dictionaryType DictionaryType) ([]byte, error) {
+	if dictionaryType != DtRaw && dictionaryType != DtSerialized {
+		return nil, fmt.Errorf("cbrotli: %s: unknown dictionary type %d", path, dictionaryType)
+	}
+	// Errors of fs.ReadFile mention the path already.
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), dictionaryType); err != nil {
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	if dictionaryType == DtSerialized {
+		if _, err := ParseSerializedDictionary(data); err != nil {
+			return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+		}
+	}
+	return data, nil
+}
+
+// FSOptions configures FSWithOptions.
+type FSOptions struct {
+	// PreferCompressed makes Open decode name.br even if name exists too.
+	PreferCompressed bool
+}
+
+// FS returns a file system that serves the files of inner, and for each
+// Brotli-compressed file name.br of inner without a name counterpart, a file
+// name with the decoded content (e.g. for assets precompressed at build time
+// and embedded with go:embed). Directory listings show such a pair once,
+// without the suffix. Files whose name ends with .br can still be opened with
+// their own name, to get the compressed content.
+//
+// The size of a decoded file is not known until it is read: its Stat reports
+// the size stored as a decimal number in the file name.br.size (which
+// listings hide), or -1 if there is none. Decoded files implement io.Seeker,
+// e.g. for http.FileServer, but seeking is slow: going back decodes the file
+// from its start again, and going to the end of a file without size hint
+// decodes all of it.
+func FS(inner fs.FS) fs.FS {
+	return FSWithOptions(inner, FSOptions{})
+}
+
+// FSWithOptions is like FS with options.
+func FSWithOptions(inner fs.FS, options FSOptions) fs.FS {
+	return &brFS{inner: inner, options: options}
+}
+
+// Suffixes of the compressed files of FS and of their size hints.
+const (
+	brSuffix   = ".br"
+	sizeSuffix = ".br.size"
+)
+
+type brFS struct {
+	inner   fs.FS
+	options FSOptions
+}
+
+func (f *brFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if f.options.PreferCompressed {
+		file, err := f.openCompressed(name)
+		if !errors.Is(err, fs.ErrNotExist) {
+			return file, err
+		}
+	}
+	file, err := f.inner.Open(name)
+	if errors.Is(err, fs.ErrNotExist) && !f.options.PreferCompressed {
+		return f.openCompressed(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dir, ok := file.(fs.ReadDirFile); ok {
+		if info, err := file.Stat(); err == nil && info.IsDir() {
+			return &brDir{ReadDirFile: dir, fsys: f, name: name}, nil
+		}
+	}
+	return file, nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (f *brFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	file, err := f.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	dir, ok := file.(fs.ReadDirFile)
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not implemented")}
+	}
+	return dir.ReadDir(-1)
+}
+
+// openCompressed opens name.br, decoded.
+func (f *brFS) openCompressed(name string) (fs.File, error) {
+	notExist := &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	if name == "." {
+		return nil, notExist
+	}
+	file, err := f.inner.Open(name + brSuffix)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, notExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, notExist
+	}
+	return &brFile{
+		fsys: f,
+		name: name,
+		file: file,
+		r:    NewReader(file),
+		info: brFileInfo{FileInfo: info, name: path.Base(name), size: f.sizeHint(name)},
+		size: -1,
+	}, nil
+}
+
+// sizeHint returns the decoded size of name.br stored in name.br.size, or -1.
+func (f *brFS) sizeHint(name string) int64 {
+	data, err := fs.ReadFile(f.inner, name+sizeSuffix)
+	if err != nil {
+		return -1
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || size < 0 {
+		return -1
+	}
+	return size
+}
+
+// brFileInfo describes a decoded file by the FileInfo of the compressed one.
+type brFileInfo struct {
+	fs.FileInfo
+	name string
+	size int64
+}
+
+func (i brFileInfo) Name() string { return i.name }
+func (i brFileInfo) Size() int64  { return i.size }
+
+// brFile is a file of FS decoded from name.br.
+type brFile struct {
+	fsys    *brFS
+	name    string
+	file    fs.File
+	r       *Reader
+	info    brFileInfo
+	pos     int64 // set by Seek
+	decoded int64 // bytes read from r
+	size    int64 // decoded size, -1 until known
+}
+
+func (f *brFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *brFile) Read(p []byte) (int, error) {
+	if f.pos < f.decoded {
+		if err := f.rewind(); err != nil {
+			return 0, err
+		}
+	}
+	if f.pos > f.decoded {
+		n, err := io.CopyN(io.Discard, f.r, f.pos-f.decoded)
+		f.decoded += n
+		if err != nil {
+			return 0, f.readError(err)
+		}
+	}
+	n, err := f.r.Read(p)
+	f.decoded += int64(n)
+	f.pos = f.decoded
+	return n, f.readError(err)
+}
+
+func (f *brFile) readError(err error) error {
+	if err != nil && err != io.EOF {
+		return &fs.PathError{Op: "read", Path: f.name, Err: err}
+	}
+	return err
+}
+
+// rewind reopens the compressed file, to decode it from the start.
+func (f *brFile) rewind() error {
+	file, err := f.fsys.inner.Open(f.name + brSuffix)
+	if err != nil {
+		return err
+	}
+	f.file.Close()
+	f.file = file
+	f.r.Reset(file)
+	f.decoded = 0
+	return nil
+}
+
+// Seek implements io.Seeker. The new position takes effect on the next Read,
+// which decodes the file from its start again if it is before the current
+// one. The end of a file without size hint is found by decoding it.
+func (f *brFile) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = f.pos + offset
+	case io.SeekEnd:
+		size, err := f.decodedSize()
+		if err != nil {
+			return 0, err
+		}
+		pos = size + offset
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: fs.ErrInvalid}
+	}
+	if pos < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: errors.New("negative position")}
+	}
+	f.pos = pos
+	return pos, nil
+}
+
+// decodedSize returns the size hint or, if there is none, the decoded size
+// of the file.
+func (f *brFile) decodedSize() (int64, error) {
+	if f.info.size >= 0 {
+		return f.info.size, nil
+	}
+	if f.size < 0 {
+		n, err := io.Copy(io.Discard, f.r)
+		f.decoded += n
+		if err != nil {
+			return 0, f.readError(err)
+		}
+		f.size = f.decoded
+	}
+	return f.size, nil
+}
+
+func (f *brFile) Close() error {
+	f.r.Close()
+	return f.file.Close()
+}
+
+// brDir is a directory of FS; its listing hides the suffix of compressed
+// files and the size hints.
+type brDir struct {
+	fs.ReadDirFile
+	fsys    *brFS
+	name    string
+	entries []fs.DirEntry // nil until the first ReadDir
+	read    bool
+}
+
+func (d *brDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.read {
+		entries, err := d.ReadDirFile.ReadDir(-1)
+		if err != nil {
+			return nil, err
+		}
+		d.read = true
+		d.entries = d.fsys.listing(d.name, entries)
+	}
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	entries := d.entries[:min(n, len(d.entries))]
+	d.entries = d.entries[len(entries):]
+	return entries, nil
+}
+
+// listing returns the entries of the directory dir of FS, sorted by name,
+// given those of inner.
+func (f *brFS) listing(dir string, entries []fs.DirEntry) []fs.DirEntry {
+	names := make(map[string]bool, len(entries))
+	compressed := make(map[string]bool)
+	for _, e := range entries {
+		names[e.Name()] = true
+		if plain, ok := strings.CutSuffix(e.Name(), brSuffix); ok && !e.IsDir() {
+			compressed[plain] = true
+		}
+	}
+	var out []fs.DirEntry
+	for _, e := range entries {
+		name := e.Name()
+		if plain, ok := strings.CutSuffix(name, sizeSuffix); ok && compressed[plain] {
+			continue
+		}
+		if plain, ok := strings.CutSuffix(name, brSuffix); ok && !e.IsDir() {
+			if names[plain] && !f.options.PreferCompressed {
+				continue
+			}
+			out = append(out, &brDirEntry{DirEntry: e, fsys: f, name: plain, path: path.Join(dir, plain)})
+			continue
+		}
+		if compressed[name] && f.options.PreferCompressed {
+			continue
+		}
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
+
+// brDirEntry is the entry of a decoded file.
+type brDirEntry struct {
+	fs.DirEntry
+	fsys *brFS
+	name string
+	path string
+}
+
+func (e *brDirEntry) Name() string { return e.name }
+
+func (e *brDirEntry) Info() (fs.FileInfo, error) {
+	info, err := e.DirEntry.Info()
+	if err != nil {
+		return nil, err
+	}
+	return brFileInfo{FileInfo: info, name: e.name, size: e.fsys.sizeHint(e.path)}, nil
+}
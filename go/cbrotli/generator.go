@@ -0,0 +1,376 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// GeneratorOptions configures GenerateDictionaryWithOptions.
+type GeneratorOptions struct {
+	// MinLength is the length of the shortest string considered for the
+	// dictionary; 0 means 8. Shorter strings are rarely worth a backward
+	// reference to the dictionary.
+	MinLength int
+	// Weights, if not nil, gives the relative importance of each sample (e.g.
+	// its frequency in traffic); by default all samples weigh 1.
+	Weights []float64
+}
+
+const defaultMinLength = 8
+
+var (
+	errNoSamples       = errors.New("cbrotli: no samples")
+	errNoCommonStrings = errors.New("cbrotli: samples have no common strings")
+)
+
+// GenerateDictionary builds a raw dictionary of at most targetSize bytes from
+// samples of the data it will be used for; see GenerateDictionaryWithOptions.
+func GenerateDictionary(samples [][]byte, targetSize int) ([]byte, error) {
+	return GenerateDictionaryWithOptions(samples, targetSize, GeneratorOptions{})
+}
+
+// GenerateDictionaryWithOptions builds a raw dictionary of at most targetSize
+// bytes from samples of the data it will be used for. The result is suitable
+// for NewPreparedDictionary (with DtRaw), NewReaderWithRawDictionary and
+// NewDecoderDictionary.
+//
+// This is a variant of the "sieve" method of the Brotli dictionary generator:
+// maximal strings formed of substrings of MinLength bytes that occur in two or
+// more samples are the candidates. They are picked greedily by the total
+// weight of samples containing their substrings not yet in the dictionary, per
+// byte, and concatenated. The most valuable strings are placed at the end of
+// the dictionary, where backward distances are shortest.
+func GenerateDictionaryWithOptions(samples [][]byte, targetSize int, options GeneratorOptions) ([]byte, error) {
+	if targetSize <= 0 {
+		return nil, errors.New("cbrotli: non-positive dictionary size")
+	}
+	if options.Weights != nil && len(options.Weights) != len(samples) {
+		return nil, errors.New("cbrotli: number of weights does not match number of samples")
+	}
+	k := options.MinLength
+	if k <= 0 {
+		k = defaultMinLength
+	}
+	weight := func(i int) float64 {
+		if options.Weights == nil {
+			return 1
+		}
+		return options.Weights[i]
+	}
+
+	// Weigh each string of length k (by hash) with the samples containing it.
+	type counter struct {
+		samples int
+		weight  float64
+		last    int // last sample that was counted, plus 1
+	}
+	counts := make(map[uint64]*counter)
+	total := 0
+	for i, sample := range samples {
+		total += len(sample)
+		forEachHash(sample, k, func(_ int, h uint64) {
+			c := counts[h]
+			if c == nil {
+				c = &counter{}
+				counts[h] = c
+			}
+			if c.last != i+1 {
+				c.last = i + 1
+				c.samples++
+				c.weight += weight(i)
+			}
+		})
+	}
+	if total == 0 {
+		return nil, errNoSamples
+	}
+
+	// Maximal runs of common strings are the candidates.
+	segments := make(map[string]bool)
+	for _, sample := range samples {
+		runStart, runEnd := -1, -1
+		forEachHash(sample, k, func(pos int, h uint64) {
+			if counts[h].samples < 2 {
+				return
+			}
+			if runStart >= 0 && pos > runEnd-k+1 {
+				segments[string(sample[runStart:runEnd])] = true
+				runStart = -1
+			}
+			if runStart < 0 {
+				runStart = pos
+			}
+			runEnd = pos + k
+		})
+		if runStart >= 0 {
+			segments[string(sample[runStart:runEnd])] = true
+		}
+	}
+	if len(segments) == 0 {
+		return nil, errNoCommonStrings
+	}
+
+	// Greedily pick the candidates that add the most weight of strings not in
+	// the dictionary yet per byte; gains only decrease as the dictionary
+	// grows, so stale gains are recomputed lazily.
+	covered := make(map[uint64]bool)
+	gain := func(text string) float64 {
+		var g float64
+		forEachHash([]byte(text), k, func(_ int, h uint64) {
+			if !covered[h] {
+				g += counts[h].weight
+			}
+		})
+		return g / float64(len(text))
+	}
+	queue := make(candidateQueue, 0, len(segments))
+	for text := range segments {
+		if len(text) <= targetSize {
+			queue = append(queue, candidate{text, gain(text)})
+		}
+	}
+	heap.Init(&queue)
+	var picked []string
+	size := 0
+	for queue.Len() > 0 && targetSize-size >= k {
+		c := heap.Pop(&queue).(candidate)
+		if size+len(c.text) > targetSize {
+			continue
+		}
+		if g := gain(c.text); queue.Len() > 0 && g < queue[0].gain {
+			c.gain = g
+			heap.Push(&queue, c)
+			continue
+		} else if g == 0 {
+			break
+		}
+		forEachHash([]byte(c.text), k, func(_ int, h uint64) { covered[h] = true })
+		picked = append(picked, c.text)
+		size += len(c.text)
+	}
+
+	dictionary := make([]byte, 0, size)
+	for i := len(picked) - 1; i >= 0; i-- {
+		dictionary = append(dictionary, picked[i]...)
+	}
+	return dictionary, nil
+}
+
+type candidate struct {
+	text string
+	gain float64
+}
+
+// candidateQueue is a max-heap of candidates by gain.
+type candidateQueue []candidate
+
+func (q candidateQueue) Len() int { return len(q) }
+func (q candidateQueue) Less(i, j int) bool {
+	if q[i].gain != q[j].gain {
+		return q[i].gain > q[j].gain
+	}
+	return q[i].text < q[j].text
+}
+func (q candidateQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *candidateQueue) Push(x any)   { *q = append(*q, x.(candidate)) }
+func (q *candidateQueue) Pop() any {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
+
+// forEachHash calls f with the position and a rolling hash of each string of
+// length k in data.
+func forEachHash(data []byte, k int, f func(pos int, h uint64)) {
+	if len(data) < k {
+		return
+	}
+	const prime = 0x100000001b3
+	var h, pow uint64 = 0, 1
+	for i := 0; i < k; i++ {
+		h = h*prime + uint64(data[i])
+		if i != 0 {
+			pow *= prime
+		}
+	}
+	f(0, h)
+	for i := k; i < len(data); i++ {
+		h = (h-uint64(data[i-k])*pow)*prime + uint64(data[i])
+		f(i-k+1, h)
+	}
+}
+
+// DictionaryBuilderOptions configures DictionaryBuilder.
+type DictionaryBuilderOptions struct {
+	// MaxSamples is the number of samples kept; 0 means 10000.
+	MaxSamples int
+	// MaxSampleSize is the number of leading bytes kept of each sample; 0
+	// means 64KiB.
+	MaxSampleSize int
+	// MinLength is passed to GenerateDictionaryWithOptions.
+	MinLength int
+}
+
+// DictionaryBuilder generates a dictionary from a stream of samples that does
+// not fit in memory. It keeps a uniform random subset of the samples
+// (reservoir sampling), so its memory is limited by MaxSamples and
+// MaxSampleSize. The random source is fixed, so that the same sequence of
+// samples always produces the same dictionary.
+type DictionaryBuilder struct {
+	options   DictionaryBuilderOptions
+	reservoir [][]byte
+	count     int64
+	rng       *rand.Rand
+}
+
+var errEmptySample = errors.New("cbrotli: empty sample")
+
+// NewDictionaryBuilder initializes new DictionaryBuilder instance.
+func NewDictionaryBuilder(options DictionaryBuilderOptions) *DictionaryBuilder {
+	if options.MaxSamples <= 0 {
+		options.MaxSamples = 10000
+	}
+	if options.MaxSampleSize <= 0 {
+		options.MaxSampleSize = 64 << 10
+	}
+	return &DictionaryBuilder{
+		options: options,
+		rng:     rand.New(rand.NewSource(1)),
+	}
+}
+
+// Add offers sample to the builder; the data is copied if kept.
+func (b *DictionaryBuilder) Add(sample []byte) error {
+	if len(sample) == 0 {
+		return errEmptySample
+	}
+	b.count++
+	sample = sample[:min(len(sample), b.options.MaxSampleSize)]
+	if len(b.reservoir) < b.options.MaxSamples {
+		b.reservoir = append(b.reservoir, bytes.Clone(sample))
+		return nil
+	}
+	if i := b.rng.Int63n(b.count); i < int64(len(b.reservoir)) {
+		// Reuse the buffer of the evicted sample.
+		b.reservoir[i] = append(b.reservoir[i][:0], sample...)
+	}
+	return nil
+}
+
+// SampleCount returns the number of samples added so far.
+func (b *DictionaryBuilder) SampleCount() int64 { return b.count }
+
+// Build generates a raw dictionary of at most targetSize bytes from the kept
+// samples. It can be called many times, and samples can be added between the
+// calls.
+func (b *DictionaryBuilder) Build(targetSize int) ([]byte, error) {
+	return GenerateDictionaryWithOptions(b.reservoir, targetSize,
+		GeneratorOptions{MinLength: b.options.MinLength})
+}
+
+// GainReport is the result of EstimateDictionaryGain.
+type GainReport struct {
+	// Samples is the number of samples.
+	Samples int
+	// InputSize is the total size of the samples.
+	InputSize int64
+	// PlainSize and DictionarySize are the total compressed sizes of the
+	// samples without and with the dictionary.
+	PlainSize, DictionarySize int64
+	// Ratios are the compressed sizes with the dictionary divided by those
+	// without it, in sample order; lower is better.
+	Ratios []float64
+	// P50, P90 and P99 are percentiles of Ratios (nearest rank).
+	P50, P90, P99 float64
+}
+
+// Gain returns the fraction of the compressed size saved by the dictionary.
+func (r GainReport) Gain() float64 {
+	if r.PlainSize == 0 {
+		return 0
+	}
+	return 1 - float64(r.DictionarySize)/float64(r.PlainSize)
+}
+
+// EstimateDictionaryGain compresses each sample with options, with and without
+// the raw dictionary, and reports the compressed sizes. The dictionary is
+// prepared once and encoders are reused; samples are compressed by up to
+// GOMAXPROCS goroutines, but the report does not depend on their scheduling.
+// options.Dictionary, options.SelectDictionary and options.Progress are
+// ignored.
+func EstimateDictionaryGain(samples [][]byte, dictionary []byte, options WriterOptions) (GainReport, error) {
+	options.Dictionary, options.SelectDictionary, options.Progress = nil, nil, nil
+	if err := options.validate(); err != nil {
+		return GainReport{}, err
+	}
+	if len(samples) == 0 {
+		return GainReport{}, errNoSamples
+	}
+	pd, err := PrepareDictionary(dictionary, DtRaw, PrepareDictionaryOptions{Quality: options.Quality})
+	if err != nil {
+		return GainReport{}, err
+	}
+	defer pd.Close()
+	plainOptions := options
+	dictionaryOptions := options
+	dictionaryOptions.Dictionary = pd
+
+	plain := make([]int, len(samples))
+	withDictionary := make([]int, len(samples))
+	errs := make([]error, len(samples))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < min(runtime.GOMAXPROCS(0), len(samples)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e := &batchEncoder{}
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(samples) {
+					return
+				}
+				encoded, err := e.encodeTemporary(samples[i], plainOptions)
+				if err == nil {
+					plain[i] = len(encoded)
+					encoded, err = e.encodeTemporary(samples[i], dictionaryOptions)
+					withDictionary[i] = len(encoded)
+				}
+				errs[i] = err
+			}
+		}()
+	}
+	wg.Wait()
+
+	report := GainReport{Samples: len(samples), Ratios: make([]float64, len(samples))}
+	for i, sample := range samples {
+		if errs[i] != nil {
+			return GainReport{}, ItemError{Index: i, Err: errs[i]}
+		}
+		report.InputSize += int64(len(sample))
+		report.PlainSize += int64(plain[i])
+		report.DictionarySize += int64(withDictionary[i])
+		report.Ratios[i] = float64(withDictionary[i]) / float64(plain[i])
+	}
+	sorted := append([]float64(nil), report.Ratios...)
+	sort.Float64s(sorted)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+		return sorted[max(rank, 0)]
+	}
+	report.P50, report.P90, report.P99 = percentile(0.5), percentile(0.9), percentile(0.99)
+	return report, nil
+}
@@ -0,0 +1,20 @@
+module github.com/google/brotli/go/cbrotli/grpcbrotli
+
+go 1.21
+
+require (
+	github.com/google/brotli/go/cbrotli v0.1.0
+	google.golang.org/grpc v1.64.0
+)
+
+require (
+	golang.org/x/net v0.22.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
+	google.golang.org/protobuf v1.33.0 // indirect
+)
+
+// Builds in this repository use the cbrotli next to it; replace has no
+// effect on importers, which get the version required above.
+replace github.com/google/brotli/go/cbrotli => ../
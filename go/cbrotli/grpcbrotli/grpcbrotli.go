@@ -0,0 +1,128 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package grpcbrotli is a gRPC message compressor using cbrotli.
+//
+// It is a separate module, so that cbrotli does not depend on gRPC. Messages
+// are compressed by RegisterBrotli'ed peers when a call selects it:
+//
+//	grpcbrotli.RegisterBrotli(5)
+//	conn, err := grpc.NewClient(target,
+//		grpc.WithDefaultCallOptions(grpc.UseCompressor(grpcbrotli.Name)), ...)
+//
+// Servers decompress requests and compress responses with the compressor of
+// the request once it is registered.
+package grpcbrotli
+
+import (
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/google/brotli/go/cbrotli"
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the name of the compressor, i.e. its grpc-encoding.
+const Name = "br"
+
+// RegisterBrotli registers the Brotli compressor with gRPC, compressing at
+// quality. Like encoding.RegisterCompressor, it must be called at
+// initialization time, before any RPC is made; a later call replaces the
+// compressor for new messages.
+func RegisterBrotli(quality int) error {
+	if _, err := cbrotli.Encode(nil, cbrotli.WriterOptions{Quality: quality}); err != nil {
+		return err
+	}
+	encoding.RegisterCompressor(&compressor{quality: quality})
+	return nil
+}
+
+// compressor implements encoding.Compressor; a Writer or a Reader is used for
+// a single message, then returned to the pool.
+type compressor struct {
+	quality int
+	writers sync.Pool // *writer
+	readers sync.Pool // *reader
+}
+
+func (c *compressor) Name() string { return Name }
+
+type writer struct {
+	*cbrotli.Writer
+	pool *sync.Pool
+}
+
+// Compress returns a Writer that compresses a message to w; gRPC closes it
+// once the message has been written, which completes the stream, even for an
+// empty message.
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	options := cbrotli.WriterOptions{Quality: c.quality}
+	if z, ok := c.writers.Get().(*writer); ok {
+		if err := z.ResetOptions(w, options); err == nil {
+			return z, nil
+		}
+		z.Writer.Close()
+	}
+	return &writer{Writer: cbrotli.NewWriter(w, options), pool: &c.writers}, nil
+}
+
+// Close completes the message and returns the Writer to the pool; it must not
+// be used afterwards.
+func (z *writer) Close() error {
+	err := z.Writer.Close()
+	if err == nil {
+		z.pool.Put(z)
+	}
+	return err
+}
+
+type reader struct {
+	*cbrotli.Reader
+	src  countingReader
+	pool *sync.Pool
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Decompress returns a Reader of the message compressed in r. An empty
+// payload is an empty message, as some peers do not compress those.
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	z, ok := c.readers.Get().(*reader)
+	if !ok {
+		z = &reader{pool: &c.readers}
+		z.Reader = cbrotli.NewReader(&z.src)
+		// The pool drops Readers silently; free their decoder then.
+		runtime.SetFinalizer(z, func(z *reader) { z.Reader.Close() })
+	}
+	z.src = countingReader{r: r}
+	if err := z.Reset(&z.src); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Read decodes the message; the Reader goes back to the pool at its end.
+func (z *reader) Read(p []byte) (int, error) {
+	n, err := z.Reader.Read(p)
+	if errors.Is(err, cbrotli.ErrTruncated) && z.src.n == 0 {
+		err = io.EOF
+	}
+	if err == io.EOF {
+		z.pool.Put(z)
+	}
+	return n, err
+}
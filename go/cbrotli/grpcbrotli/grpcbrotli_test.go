@@ -0,0 +1,147 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package grpcbrotli_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+	"github.com/google/brotli/go/cbrotli/grpcbrotli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestCompressor(t *testing.T) {
+	if err := grpcbrotli.RegisterBrotli(cbrotli.MaxQuality + 1); err == nil {
+		t.Error("RegisterBrotli accepted an invalid quality")
+	}
+	if err := grpcbrotli.RegisterBrotli(5); err != nil {
+		t.Fatal(err)
+	}
+	c := encoding.GetCompressor(grpcbrotli.Name)
+	if c == nil || c.Name() != "br" {
+		t.Fatalf("GetCompressor: %v", c)
+	}
+	for _, message := range [][]byte{nil, []byte("x"), bytes.Repeat([]byte("message "), 10000)} {
+		// Several rounds reuse pooled Writers and Readers.
+		for i := 0; i < 3; i++ {
+			var buf bytes.Buffer
+			w, err := c.Compress(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.Write(message)
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			r, err := c.Decompress(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := io.ReadAll(r); err != nil || !bytes.Equal(got, message) {
+				t.Errorf("%d bytes: got %d, %v", len(message), len(got), err)
+			}
+		}
+	}
+	// An empty payload is an empty message.
+	r, err := c.Decompress(bytes.NewReader(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := io.ReadAll(r); err != nil || len(got) != 0 {
+		t.Errorf("empty payload: got %d bytes, %v", len(got), err)
+	}
+}
+
+func TestRoundTripThroughServer(t *testing.T) {
+	if err := grpcbrotli.RegisterBrotli(5); err != nil {
+		t.Fatal(err)
+	}
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	// A long service name makes messages worth compressing.
+	service := strings.Repeat("brotli.test.Service/", 500)
+	healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	rpcs := &rpcStats{}
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(rpcs),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(grpcbrotli.Name)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	for _, name := range []string{service, ""} {
+		// The empty request is an empty message.
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: name})
+		if err != nil {
+			t.Fatalf("Check(%d bytes): %v", len(name), err)
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("Check(%d bytes): %v", len(name), resp.Status)
+		}
+		// The server answers with the compressor of the request.
+		in, out := rpcs.last()
+		if in.Compression != "br" {
+			t.Errorf("Check(%d bytes): response grpc-encoding %q", len(name), in.Compression)
+		}
+		if out.Length > 1000 && out.CompressedLength*10 > out.Length {
+			t.Errorf("Check(%d bytes): request of %d bytes compressed to %d", len(name), out.Length, out.CompressedLength)
+		}
+	}
+}
+
+// rpcStats records the response header and the request payload of the
+// client's last RPC; gRPC does not report the grpc-encoding in metadata.
+type rpcStats struct {
+	mu  sync.Mutex
+	in  stats.InHeader
+	out stats.OutPayload
+}
+
+func (s *rpcStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context { return ctx }
+
+func (s *rpcStats) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch rs := rs.(type) {
+	case *stats.InHeader:
+		s.in = *rs
+	case *stats.OutPayload:
+		s.out = *rs
+	}
+}
+
+func (s *rpcStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context { return ctx }
+
+func (s *rpcStats) HandleConn(context.Context, stats.ConnStats) {}
+
+func (s *rpcStats) last() (stats.InHeader, stats.OutPayload) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.in, s.out
+}
@@ -0,0 +1,843 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HTTPOptions configures NewHTTPHandler.
+type HTTPOptions struct {
+	// Writer configures the compression of responses; its FlushInterval is
+	// the default of FlushInterval.
+	Writer WriterOptions
+	// FlushEvery, if positive, makes the handler flush a compressed response
+	// through the ResponseWriter once FlushEvery bytes have been written to
+	// it since the last flush.
+	FlushEvery int
+	// FlushInterval, if positive, makes the handler flush a compressed
+	// response through the ResponseWriter when data written to it has not
+	// been flushed within the interval, e.g. for server-sent events; 0 means
+	// Writer.FlushInterval. The timer is stopped when the handler returns or
+	// the request context is done.
+	//
+	// A flush by the handler resets both FlushEvery and FlushInterval. They
+	// do not apply to responses buffered with BufferSize.
+	FlushInterval time.Duration
+	// MinSize is the size of the smallest response that is compressed; 0
+	// means 1KiB. Up to MinSize bytes are held until the size is known;
+	// Content-Length, if set before the first write, decides at once, and so
+	// does a flush by the handler, for compression.
+	MinSize int
+	// ContentTypes lists the media types of the responses to compress, e.g.
+	// "application/json"; "text/*" matches all subtypes of text. nil means
+	// text/*, application/json, application/javascript, application/xml,
+	// application/wasm and image/svg+xml. Responses without Content-Type get
+	// the one sniffed by http.DetectContentType, as net/http would set it.
+	ContentTypes []string
+	// ExcludedContentTypes lists media types that are never compressed, even
+	// if ContentTypes matches them, e.g. "image/*" next to a broad
+	// ContentTypes such as "application/*". nil means the common formats that
+	// are compressed already: image/png, image/jpeg, image/gif, image/webp,
+	// image/avif, video/*, audio/*, font/woff, font/woff2, application/zip,
+	// application/gzip and application/x-gzip; an empty list excludes none.
+	ExcludedContentTypes []string
+	// BufferSize, if positive, makes the handler compress responses of up to
+	// BufferSize bytes (before compression) whole before sending the header,
+	// so that they have a Content-Length. Longer responses, and those that
+	// the handler flushes, are streamed: the compressed bytes buffered so far
+	// are sent first, and the rest follows as it is compressed.
+	BufferSize int
+	// BufferMemory bounds the memory of the buffers of all the responses
+	// compressed at once with BufferSize, each of which takes the largest
+	// compressed size of BufferSize bytes; other responses are streamed. 0
+	// means 16MiB.
+	BufferMemory int64
+	// ETagSuffix, if set, is appended to the strong ETags of compressed
+	// responses, e.g. "-br" turns "v1" into "v1-br", and removed from the
+	// tags of If-None-Match before next sees them, so that conditional
+	// requests keep matching the tags next sets. If ETagSuffix is empty,
+	// strong ETags of compressed responses are made weak instead (W/"v1"),
+	// which the weak comparison of If-None-Match still matches. Either way,
+	// the compressed and identity representations never share a strong
+	// ETag, as RFC 9110 requires.
+	ETagSuffix string
+}
+
+const (
+	// sniffLen is the number of bytes http.DetectContentType considers.
+	sniffLen                = 512
+	defaultHTTPMinSize      = 1 << 10
+	defaultHTTPBufferMemory = 16 << 20
+)
+
+var defaultHTTPContentTypes = []string{
+	"text/*",
+	"application/json",
+	"application/javascript",
+	"application/xml",
+	"application/wasm",
+	"image/svg+xml",
+}
+
+var defaultHTTPExcludedContentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"image/avif",
+	"video/*",
+	"audio/*",
+	"font/woff",
+	"font/woff2",
+	"application/zip",
+	"application/gzip",
+	"application/x-gzip",
+}
+
+// httpWriterPools keep the Writers of NewHTTPHandler, by quality.
+var httpWriterPools [MaxQuality + 1]sync.Pool // *Writer
+
+// NewHTTPHandler returns a handler that compresses the responses of next with
+// Content-Encoding "br" for clients that accept it. The responses are
+// streamed: they are not buffered beyond options.MinSize.
+//
+// Responses are sent unchanged, with their Content-Length, if they have a
+// Content-Encoding other than identity already, no body (e.g. 204 and 304),
+// a Content-Range, a Content-Type not listed in options.ContentTypes or
+// listed in options.ExcludedContentTypes, or fewer than options.MinSize
+// bytes. The decision is made before any byte is sent: at WriteHeader if the
+// headers tell, else once MinSize bytes are written or the handler returns. Compressed
+// responses have no Content-Length, unless they are buffered (see
+// HTTPOptions.BufferSize). Vary lists Accept-Encoding in all
+// responses that are compressed for clients accepting "br", once even if
+// next lists it already, as caches must not mix the variants; see
+// HTTPOptions.ETagSuffix for their ETags. 304 responses are sent as next
+// writes them. Upgrade requests (e.g. WebSocket) are passed through
+// untouched.
+//
+// If next panics, the response is left incomplete: the compressed stream is
+// not terminated, and a buffered response is not sent at all.
+//
+// NewHTTPHandler panics if options.Writer is invalid, or if options.BufferSize
+// is too large for this platform.
+func NewHTTPHandler(next http.Handler, options HTTPOptions) http.Handler {
+	if err := options.Writer.validate(); err != nil {
+		panic(err)
+	}
+	if options.MinSize <= 0 {
+		options.MinSize = defaultHTTPMinSize
+	}
+	if options.ContentTypes == nil {
+		options.ContentTypes = defaultHTTPContentTypes
+	}
+	if options.ExcludedContentTypes == nil {
+		options.ExcludedContentTypes = defaultHTTPExcludedContentTypes
+	}
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = options.Writer.FlushInterval
+	}
+	var buffers *httpBuffers
+	if options.BufferSize > 0 {
+		if options.BufferMemory <= 0 {
+			options.BufferMemory = defaultHTTPBufferMemory
+		}
+		buffers = &httpBuffers{
+			size:  options.BufferSize,
+			bound: compressBound(options.BufferSize),
+			limit: options.BufferMemory,
+		}
+		if buffers.bound == 0 {
+			panic(fmt.Errorf("%w: HTTPOptions.BufferSize %d", ErrTooLarge, options.BufferSize))
+		}
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(rw, req)
+			return
+		}
+		hw := &httpResponseWriter{
+			ResponseWriter: rw,
+			options:        &options,
+			buffers:        buffers,
+			accepted:       WantsBrotli(req),
+			head:           req.Method == http.MethodHead,
+		}
+		if hw.accepted && options.ETagSuffix != "" {
+			if inm, ok := stripETagSuffix(req.Header.Values("If-None-Match"), options.ETagSuffix); ok {
+				req = req.Clone(req.Context())
+				req.Header["If-None-Match"] = inm
+			}
+		}
+		if options.FlushInterval > 0 {
+			stop := context.AfterFunc(req.Context(), hw.stopTimer)
+			defer stop()
+		}
+		returned := false
+		defer func() {
+			if returned {
+				hw.close()
+			} else {
+				hw.abort()
+			}
+		}()
+		next.ServeHTTP(hw, req)
+		returned = true
+	})
+}
+
+// httpBuffers keeps the buffers of the responses of a handler with
+// HTTPOptions.BufferSize, and accounts for their memory.
+type httpBuffers struct {
+	size  int // HTTPOptions.BufferSize
+	bound int // capacity of a buffer: the largest compressed size of size bytes
+	limit int64
+	used  atomic.Int64
+	pool  sync.Pool // *[]byte
+}
+
+// get returns an empty buffer, or nil if the memory limit is reached.
+func (b *httpBuffers) get() *[]byte {
+	if b.used.Add(int64(b.bound)) > b.limit {
+		b.used.Add(-int64(b.bound))
+		return nil
+	}
+	buf, _ := b.pool.Get().(*[]byte)
+	if buf == nil {
+		s := make([]byte, 0, b.bound)
+		buf = &s
+	}
+	return buf
+}
+
+func (b *httpBuffers) put(buf *[]byte) {
+	*buf = (*buf)[:0]
+	b.pool.Put(buf)
+	b.used.Add(-int64(b.bound))
+}
+
+// httpOutput is the destination of the Writer of a compressed response: the
+// buffer, while the response is buffered, and then the ResponseWriter.
+type httpOutput struct {
+	rw      http.ResponseWriter
+	buf     *[]byte
+	discard bool // set when the response is aborted
+}
+
+func (o *httpOutput) Write(p []byte) (int, error) {
+	switch {
+	case o.discard:
+		return len(p), nil
+	case o.buf != nil:
+		*o.buf = append(*o.buf, p...)
+		return len(p), nil
+	}
+	return o.rw.Write(p)
+}
+
+// httpResponseWriter holds the status and the first bytes of a response until
+// it decides whether to compress it. Like the ResponseWriters of net/http, it
+// implements http.Flusher, http.Hijacker and io.ReaderFrom, and its methods
+// fail with http.ErrNotSupported if the wrapped ResponseWriter lacks the
+// capability; http.ResponseController finds the other ones with Unwrap.
+type httpResponseWriter struct {
+	http.ResponseWriter
+	options  *HTTPOptions
+	buffers  *httpBuffers // nil without HTTPOptions.BufferSize
+	accepted bool         // the client accepts "br"
+	head     bool         // the request is HEAD
+
+	mu        sync.Mutex // guards against automatic flushes
+	status    int        // 0 until WriteHeader
+	held      []byte
+	decided   bool
+	sized     bool    // Content-Length decides compression, the first write the type
+	w         *Writer // nil if the response is not compressed
+	out       httpOutput
+	input     int // bytes written to w
+	unflushed int // bytes written to w since the last flush
+	timer     timer
+	noTimer   bool // the request context is done
+	closed    bool
+	hijacked  bool
+}
+
+func (hw *httpResponseWriter) WriteHeader(status int) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	hw.writeHeader(status)
+}
+
+func (hw *httpResponseWriter) writeHeader(status int) {
+	if hw.decided || hw.status != 0 || hw.hijacked {
+		return
+	}
+	if status < 200 && status != http.StatusSwitchingProtocols {
+		// Informational responses (e.g. 103 Early Hints) precede the final one.
+		hw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	hw.status = status
+	if !hw.compressible() {
+		hw.decide(false)
+		return
+	}
+	if length, err := strconv.ParseInt(hw.Header().Get("Content-Length"), 10, 64); err == nil {
+		if length >= int64(hw.options.MinSize) && hw.sniffing() {
+			// The type is sniffed from the first write, decided by the length.
+			hw.sized = true
+			return
+		}
+		hw.decide(length >= int64(hw.options.MinSize))
+	}
+}
+
+// sniffing reports whether the Content-Type of the response is to be sniffed
+// from its first bytes.
+func (hw *httpResponseWriter) sniffing() bool {
+	_, ok := hw.Header()["Content-Type"]
+	return !ok
+}
+
+func (hw *httpResponseWriter) Write(p []byte) (int, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	if hw.hijacked {
+		return 0, http.ErrHijacked
+	}
+	if hw.status == 0 && !hw.decided {
+		hw.writeHeader(http.StatusOK)
+	}
+	if !hw.decided {
+		if len(hw.held)+len(p) < hw.options.MinSize && !hw.sized {
+			hw.held = append(hw.held, p...)
+			return len(p), nil
+		}
+		if hw.sniffing() {
+			// Sniff from the first bytes, not only those held.
+			sample := hw.held[:len(hw.held):len(hw.held)]
+			if len(sample) < sniffLen {
+				sample = append(sample, p[:min(len(p), sniffLen-len(sample))]...)
+			}
+			hw.Header().Set("Content-Type", http.DetectContentType(sample))
+		}
+		if err := hw.decide(hw.compressible()); err != nil {
+			return 0, err
+		}
+	}
+	if hw.w == nil {
+		return hw.ResponseWriter.Write(p)
+	}
+	return hw.writeCompressed(p)
+}
+
+// writeCompressed writes p to the encoder, ending the buffering of the
+// response first if the response gets too long for it.
+func (hw *httpResponseWriter) writeCompressed(p []byte) (int, error) {
+	if hw.out.buf != nil && hw.input+len(p) > hw.buffers.size {
+		if err := hw.stream(); err != nil {
+			return 0, err
+		}
+	}
+	hw.input += len(p)
+	n, err := hw.w.Write(p)
+	if err != nil || hw.out.buf != nil {
+		return n, err
+	}
+	hw.unflushed += n
+	if hw.options.FlushEvery > 0 && hw.unflushed >= hw.options.FlushEvery {
+		return n, hw.flush()
+	}
+	hw.armTimer()
+	return n, nil
+}
+
+// stream ends the buffering of a response: it sends the header and the
+// compressed bytes buffered so far, after which the encoder writes to the
+// ResponseWriter.
+func (hw *httpResponseWriter) stream() error {
+	buf := hw.out.buf
+	hw.out.buf = nil
+	defer hw.buffers.put(buf)
+	hw.ResponseWriter.WriteHeader(hw.status)
+	_, err := hw.ResponseWriter.Write(*buf)
+	return err
+}
+
+// compressible reports whether the response would be compressed for a client
+// that accepts it, given its status and headers.
+func (hw *httpResponseWriter) compressible() bool {
+	header := hw.Header()
+	if !bodyAllowed(hw.status) || hw.status == http.StatusPartialContent || header.Get("Content-Range") != "" {
+		return false
+	}
+	for _, encoding := range header.Values("Content-Encoding") {
+		if !strings.EqualFold(strings.TrimSpace(encoding), "identity") {
+			return false
+		}
+	}
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		if _, ok := header["Content-Type"]; ok {
+			// An explicit empty Content-Type disables sniffing.
+			return false
+		}
+		// The type is sniffed once the first bytes are known.
+		return true
+	}
+	return matchContentType(contentType, hw.options.ContentTypes) &&
+		!matchContentType(contentType, hw.options.ExcludedContentTypes)
+}
+
+// decide sends the header of the response, compressed if compress is true
+// and the response is compressible, and the bytes held so far.
+func (hw *httpResponseWriter) decide(compress bool) error {
+	hw.decided = true
+	header := hw.Header()
+	if compress && header.Get("Content-Type") == "" {
+		if _, ok := header["Content-Type"]; !ok {
+			header.Set("Content-Type", http.DetectContentType(hw.held))
+		}
+		compress = hw.compressible()
+	}
+	if compress {
+		addVary(header, "Accept-Encoding")
+		if hw.accepted {
+			header.Del("Content-Length")
+			header.Set("Content-Encoding", "br")
+			if etag := header.Get("Etag"); strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`) && len(etag) > 1 {
+				if hw.options.ETagSuffix == "" {
+					header.Set("Etag", "W/"+etag)
+				} else {
+					header.Set("Etag", etag[:len(etag)-1]+hw.options.ETagSuffix+`"`)
+				}
+			}
+			hw.out.rw = hw.ResponseWriter
+			if hw.buffers != nil {
+				hw.out.buf = hw.buffers.get()
+			}
+			hw.w = getHTTPWriter(&hw.out, hw.options.Writer)
+		}
+	}
+	if hw.out.buf == nil {
+		hw.ResponseWriter.WriteHeader(hw.status)
+	}
+	held := hw.held
+	hw.held = nil
+	if len(held) == 0 {
+		return nil
+	}
+	if hw.w == nil {
+		_, err := hw.ResponseWriter.Write(held)
+		return err
+	}
+	_, err := hw.writeCompressed(held)
+	return err
+}
+
+func (hw *httpResponseWriter) armTimer() {
+	// Buffered responses are sent when complete.
+	if hw.options.FlushInterval <= 0 || hw.timer != nil || hw.noTimer || hw.out.buf != nil {
+		return
+	}
+	// hw.mu is held, so t is set when f runs.
+	var t timer
+	t = afterFunc(hw.options.FlushInterval, func() {
+		hw.mu.Lock()
+		defer hw.mu.Unlock()
+		if hw.timer != t {
+			// Stopped too late, e.g. by a flush of the handler.
+			return
+		}
+		hw.timer = nil
+		if !hw.closed && !hw.hijacked {
+			hw.flush()
+		}
+	})
+	hw.timer = t
+}
+
+// stopTimer stops the flush timer for good, once the request context is
+// done.
+func (hw *httpResponseWriter) stopTimer() {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	hw.noTimer = true
+	if hw.timer != nil {
+		hw.timer.Stop()
+		hw.timer = nil
+	}
+}
+
+// Flush implements http.Flusher.
+func (hw *httpResponseWriter) Flush() {
+	hw.FlushError()
+}
+
+// FlushError is Flush that reports errors, used by http.ResponseController.
+func (hw *httpResponseWriter) FlushError() error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	if hw.hijacked {
+		return http.ErrHijacked
+	}
+	if hw.status == 0 && !hw.decided {
+		hw.writeHeader(http.StatusOK)
+	}
+	return hw.flush()
+}
+
+// flush sends all data written so far to the client; the encoder is flushed
+// first, so that the client can decode it. The flush policies start afresh.
+func (hw *httpResponseWriter) flush() error {
+	if hw.timer != nil {
+		hw.timer.Stop()
+		hw.timer = nil
+	}
+	hw.unflushed = 0
+	if !hw.decided {
+		if err := hw.decide(true); err != nil {
+			return err
+		}
+	}
+	if hw.out.buf != nil {
+		if err := hw.stream(); err != nil {
+			return err
+		}
+	}
+	if hw.w != nil {
+		if err := hw.w.Flush(); err != nil {
+			return err
+		}
+	}
+	switch f := hw.ResponseWriter.(type) {
+	case interface{ FlushError() error }:
+		return f.FlushError()
+	case http.Flusher:
+		f.Flush()
+		return nil
+	default:
+		return http.ErrNotSupported
+	}
+}
+
+// Hijack implements http.Hijacker. The response must not have been written
+// to, as the bytes held or buffered by the encoder would be lost.
+func (hw *httpResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	h, ok := hw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	if len(hw.held) != 0 || hw.w != nil {
+		return nil, nil, errors.New("cbrotli: Hijack after writing to a compressed response")
+	}
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		hw.hijacked = true
+	}
+	return conn, rw, err
+}
+
+// ReadFrom implements io.ReaderFrom. The wrapped ResponseWriter reads src
+// itself (e.g. with sendfile) if it can and the response is not compressed.
+func (hw *httpResponseWriter) ReadFrom(src io.Reader) (int64, error) {
+	hw.mu.Lock()
+	if hw.status == 0 && !hw.decided {
+		hw.writeHeader(http.StatusOK)
+	}
+	rf, ok := hw.ResponseWriter.(io.ReaderFrom)
+	direct := ok && hw.decided && hw.w == nil && !hw.hijacked
+	hw.mu.Unlock()
+	if direct {
+		return rf.ReadFrom(src)
+	}
+	// Hide ReadFrom from io.Copy.
+	return io.Copy(struct{ io.Writer }{hw}, src)
+}
+
+// Unwrap returns the wrapped ResponseWriter, for http.ResponseController.
+func (hw *httpResponseWriter) Unwrap() http.ResponseWriter {
+	return hw.ResponseWriter
+}
+
+// close completes the response once the handler has returned.
+func (hw *httpResponseWriter) close() {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	hw.closed = true
+	if hw.hijacked {
+		return
+	}
+	if hw.timer != nil {
+		hw.timer.Stop()
+		hw.timer = nil
+	}
+	if !hw.decided && hw.status != 0 {
+		// The whole response is shorter than MinSize.
+		hw.decide(false)
+	}
+	if hw.w != nil {
+		err := hw.w.Close()
+		if hw.out.buf != nil {
+			hw.sendBuffered()
+		}
+		if err == nil {
+			httpWriterPools[hw.options.Writer.Quality].Put(hw.w)
+		}
+		hw.w = nil
+	}
+}
+
+// sendBuffered sends a complete buffered response, with its Content-Length.
+// A HEAD response without content keeps the length unknown, as the handler
+// has only set the length of the uncompressed content, if any.
+func (hw *httpResponseWriter) sendBuffered() {
+	buf := hw.out.buf
+	hw.out.buf = nil
+	defer hw.buffers.put(buf)
+	if !hw.head || hw.input != 0 {
+		hw.Header().Set("Content-Length", strconv.Itoa(len(*buf)))
+	}
+	hw.ResponseWriter.WriteHeader(hw.status)
+	hw.ResponseWriter.Write(*buf)
+}
+
+// abort releases the resources of a response without completing it, when the
+// handler panics.
+func (hw *httpResponseWriter) abort() {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	hw.closed = true
+	if hw.timer != nil {
+		hw.timer.Stop()
+		hw.timer = nil
+	}
+	if hw.w != nil {
+		hw.out.discard = true
+		if hw.w.Close() == nil {
+			httpWriterPools[hw.options.Writer.Quality].Put(hw.w)
+		}
+		hw.w = nil
+	}
+	if hw.out.buf != nil {
+		hw.buffers.put(hw.out.buf)
+		hw.out.buf = nil
+	}
+}
+
+// stripETagSuffix removes suffix from the entity tags of If-None-Match
+// values; ok reports whether any tag had it.
+func stripETagSuffix(values []string, suffix string) (stripped []string, ok bool) {
+	tail := suffix + `"`
+	for _, value := range values {
+		if strings.Contains(value, tail) {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return values, false
+	}
+	stripped = make([]string, len(values))
+	for i, value := range values {
+		tags := strings.Split(value, ",")
+		for j, tag := range tags {
+			tag = strings.TrimSpace(tag)
+			if t, cut := strings.CutSuffix(tag, tail); cut {
+				tag = t + `"`
+			}
+			tags[j] = tag
+		}
+		stripped[i] = strings.Join(tags, ", ")
+	}
+	return stripped, true
+}
+
+// getHTTPWriter returns a Writer to dst from the pool for options.Quality.
+func getHTTPWriter(dst io.Writer, options WriterOptions) *Writer {
+	// The handler flushes the ResponseWriter too.
+	options.FlushInterval = 0
+	if w, ok := httpWriterPools[options.Quality].Get().(*Writer); ok {
+		if w.ResetOptions(dst, options) == nil {
+			return w
+		}
+		w.Close()
+	}
+	return NewWriter(dst, options)
+}
+
+// matchContentType reports whether the media type of a Content-Type value is
+// listed in types.
+func matchContentType(contentType string, types []string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, t := range types {
+		if prefix, ok := strings.CutSuffix(t, "/*"); ok {
+			if strings.HasPrefix(mediaType, strings.ToLower(prefix)+"/") {
+				return true
+			}
+		} else if strings.EqualFold(mediaType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRoundTripper returns an http.RoundTripper that requests Brotli-compressed
+// responses from base (nil means http.DefaultTransport) and decodes them, as
+// http.Transport does for gzip: Accept-Encoding "br" is added to requests
+// that do not set Accept-Encoding, and the responses with Content-Encoding
+// "br" lose that header and Content-Length, have Uncompressed set, and a Body
+// that decodes the original one; closing it closes both. Requests that set
+// Accept-Encoding themselves get their responses untouched.
+//
+// It is a DictionaryTransport that never advertises a dictionary.
+func NewRoundTripper(base http.RoundTripper) http.RoundTripper {
+	return &DictionaryTransport{Base: base}
+}
+
+// NegotiateContentEncoding chooses the content coding of the response to r
+// among offered (e.g. "br", "gzip"), following the Accept-Encoding header of
+// r as specified by RFC 9110, section 12.5.3: the acceptable coding with the
+// highest weight is chosen, the first one in offered among those of the same
+// weight. Codings with weight 0 are never chosen; "*" applies to the codings
+// not listed. "identity" is returned if no coding is acceptable, or if r has
+// no Accept-Encoding or a malformed one, unless identity is refused too
+// ("identity;q=0", or "*;q=0" without identity), in which case the result is
+// "". "identity" may be offered to prefer it at a higher weight.
+func NegotiateContentEncoding(r *http.Request, offered []string) string {
+	values, ok := r.Header["Accept-Encoding"]
+	if !ok {
+		return "identity"
+	}
+	weights, ok := parseAcceptEncoding(strings.Join(values, ","))
+	if !ok {
+		return "identity"
+	}
+	weight := func(coding string) (int, bool) {
+		coding = strings.ToLower(coding)
+		if coding == "x-gzip" {
+			coding = "gzip"
+		}
+		if w, ok := weights[coding]; ok {
+			return w, true
+		}
+		w, ok := weights["*"]
+		return w, ok
+	}
+	best, bestWeight := "", 0
+	for _, coding := range offered {
+		if w, _ := weight(coding); w > bestWeight {
+			best, bestWeight = coding, w
+		}
+	}
+	if best != "" {
+		return best
+	}
+	if w, listed := weight("identity"); listed && w == 0 {
+		return ""
+	}
+	return "identity"
+}
+
+// WantsBrotli reports whether the response to r should be encoded with "br":
+// it is NegotiateContentEncoding(r, []string{"br"}) == "br".
+func WantsBrotli(r *http.Request) bool {
+	return NegotiateContentEncoding(r, []string{"br"}) == "br"
+}
+
+// parseAcceptEncoding returns the weights (in thousandths) of the codings
+// listed in an Accept-Encoding value, by lowercase name; "x-gzip" is listed as
+// "gzip". If a coding is listed several times, the highest weight counts.
+func parseAcceptEncoding(value string) (map[string]int, bool) {
+	weights := make(map[string]int)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			// Empty list elements are allowed.
+			continue
+		}
+		coding, params, _ := strings.Cut(item, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if !isToken(coding) {
+			return nil, false
+		}
+		if coding == "x-gzip" {
+			coding = "gzip"
+		}
+		weight := 1000
+		for params != "" {
+			var param string
+			param, params, _ = strings.Cut(params, ";")
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !isToken(name) {
+				return nil, false
+			}
+			if strings.EqualFold(name, "q") {
+				if weight, ok = parseQValue(value); !ok {
+					return nil, false
+				}
+			}
+		}
+		if w, ok := weights[coding]; !ok || weight > w {
+			weights[coding] = weight
+		}
+	}
+	return weights, true
+}
+
+// parseQValue parses a weight of RFC 9110, section 12.4.2, in thousandths.
+func parseQValue(s string) (int, bool) {
+	if s == "" || len(s) > 5 || (s[0] != '0' && s[0] != '1') {
+		return 0, false
+	}
+	whole := int(s[0]-'0') * 1000
+	if len(s) == 1 {
+		return whole, true
+	}
+	if s[1] != '.' {
+		return 0, false
+	}
+	fraction, scale := 0, 100
+	for _, c := range s[2:] {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		fraction += int(c-'0') * scale
+		scale /= 10
+	}
+	if whole == 1000 && fraction != 0 {
+		return 0, false
+	}
+	return whole + fraction, true
+}
+
+// isToken reports whether s is a token of RFC 9110, section 5.6.2.
+func isToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range []byte(s) {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+			strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// flushRecorder counts the flushes of a ResponseRecorder.
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushes int
+}
+
+func (r *flushRecorder) Flush() {
+	r.flushes++
+	r.ResponseRecorder.Flush()
+}
+
+func TestHTTPFlushPolicies(t *testing.T) {
+	clock := useFakeClock(t)
+	event := bytes.Repeat([]byte("data: event\n\n"), 10)
+	steps := make(chan func(http.ResponseWriter))
+	done := make(chan bool)
+	h := NewHTTPHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		for step := range steps {
+			step(rw)
+			done <- true
+		}
+	}), HTTPOptions{
+		Writer:        WriterOptions{Quality: 5},
+		MinSize:       1,
+		FlushEvery:    3 * len(event),
+		FlushInterval: time.Second,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+	req.Header.Set("Accept-Encoding", "br")
+	rec := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+	served := make(chan bool)
+	go func() {
+		h.ServeHTTP(rec, req)
+		served <- true
+	}()
+	step := func(f func(rw http.ResponseWriter)) {
+		steps <- f
+		<-done
+	}
+	write := func(rw http.ResponseWriter) { rw.Write(event) }
+	check := func(what string, flushes, timers int) {
+		t.Helper()
+		step(func(http.ResponseWriter) {
+			if rec.flushes != flushes || clock.pending() != timers {
+				t.Errorf("%s: %d flushes and %d timers, want %d and %d",
+					what, rec.flushes, clock.pending(), flushes, timers)
+			}
+		})
+	}
+
+	step(write)
+	check("first write", 0, 1)
+	step(write)
+	step(write)
+	check("FlushEvery reached", 1, 0)
+	step(write)
+	check("write after flush", 1, 1)
+	step(func(http.ResponseWriter) { clock.advance() })
+	check("FlushInterval elapsed", 2, 0)
+	step(write)
+	step(write)
+	step(func(rw http.ResponseWriter) { rw.(http.Flusher).Flush() })
+	check("explicit flush", 3, 0)
+	step(write)
+	step(write)
+	check("FlushEvery restarted by the flush", 3, 1)
+	cancel()
+	step(func(http.ResponseWriter) {
+		for i := 0; clock.pending() != 0; i++ {
+			if i == 1000 {
+				t.Fatal("timer not stopped when the context is done")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+	step(write)
+	check("write after the context is done", 4, 0)
+	close(steps)
+	<-served
+	if clock.pending() != 0 {
+		t.Error("timer running after the handler returned")
+	}
+	got, err := Decode(rec.Body.Bytes())
+	if err != nil || !bytes.Equal(got, bytes.Repeat(event, 9)) {
+		t.Errorf("decoded %d bytes, %v", len(got), err)
+	}
+}
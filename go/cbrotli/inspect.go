@@ -0,0 +1,1077 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strconv"
+)
+
+// BlockType is the kind of a meta-block (RFC 7932, section 9.2).
+type BlockType int
+
+const (
+	// BlockCompressed is a meta-block of prefix-coded commands.
+	BlockCompressed BlockType = iota
+	// BlockUncompressed holds its data verbatim.
+	BlockUncompressed
+	// BlockMetadata holds metadata that decoders skip; it produces no output.
+	BlockMetadata
+	// BlockEmpty is the empty last meta-block (ISLASTEMPTY) that ends most
+	// streams.
+	BlockEmpty
+)
+
+var blockTypeNames = [...]string{"compressed", "uncompressed", "metadata", "empty"}
+
+func (t BlockType) String() string {
+	if t >= 0 && int(t) < len(blockTypeNames) {
+		return blockTypeNames[t]
+	}
+	return "BlockType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// BlockInfo describes a meta-block of a Brotli stream. Meta-blocks are not
+// aligned to bytes: the header of a compressed meta-block starts right after
+// the last bit of the previous one.
+type BlockInfo struct {
+	Type BlockType
+	// Last is set for the last meta-block of a stream (ISLAST).
+	Last bool
+	// CompressedOffset is the offset of the byte holding the first bit of
+	// the meta-block, StartBit the position of that bit in it (0 is the
+	// least significant) and CompressedBits the size of the meta-block,
+	// header and padding included.
+	CompressedOffset int64
+	StartBit         int
+	CompressedBits   int64
+	// DecompressedOffset is the offset of the first decompressed byte of the
+	// meta-block and Length the number of bytes it decodes to; for metadata
+	// blocks, Length is the size of the metadata, which is not part of the
+	// decompressed data.
+	DecompressedOffset int64
+	Length             int64
+}
+
+// end returns the offsets of the first compressed byte that holds no bit of
+// the meta-block and of the first decompressed byte after it.
+func (b *BlockInfo) end() (compressed, decompressed int64) {
+	compressed = (b.CompressedOffset*8 + int64(b.StartBit) + b.CompressedBits + 7) / 8
+	decompressed = b.DecompressedOffset
+	if b.Type != BlockMetadata {
+		decompressed += b.Length
+	}
+	return compressed, decompressed
+}
+
+// InspectError is a format error found by InspectStream and InspectReader.
+type InspectError struct {
+	// Bit is the position in the stream of the bit where the error was
+	// detected: bit Bit%8 of byte Bit/8.
+	Bit    int64
+	Reason string
+}
+
+func (e *InspectError) Error() string {
+	return fmt.Sprintf("cbrotli: invalid stream at byte %d, bit %d: %s", e.Bit/8, e.Bit%8, e.Reason)
+}
+
+// bitReader reads the bits of a stream, least significant first, and counts
+// them. Bytes are taken from src only when their bits are needed. Errors are
+// sticky: once the input is exhausted or fails, reads return zeros and err is
+// set.
+type bitReader struct {
+	src    io.Reader
+	buf    []byte
+	next   []byte // unread part of buf
+	srcErr error  // returned by src along with next
+	val    uint64
+	nbits  uint
+	pos    int64 // number of bits read
+	err    error
+}
+
+func newBitReader(src io.Reader) bitReader {
+	return bitReader{src: src, buf: make([]byte, 4096)}
+}
+
+// fill makes sure that next is not empty.
+func (br *bitReader) fill() bool {
+	for len(br.next) == 0 {
+		if br.srcErr != nil {
+			return false
+		}
+		var n int
+		n, br.srcErr = br.src.Read(br.buf)
+		br.next = br.buf[:n]
+	}
+	return true
+}
+
+func (br *bitReader) fail() {
+	if br.err != nil {
+		return
+	}
+	if br.srcErr == io.EOF {
+		br.err = ErrTruncated
+	} else {
+		br.err = br.srcErr
+	}
+}
+
+// bits reads n <= 32 bits.
+func (br *bitReader) bits(n uint) uint32 {
+	for br.nbits < n {
+		if !br.fill() {
+			br.fail()
+			return 0
+		}
+		br.val |= uint64(br.next[0]) << br.nbits
+		br.next = br.next[1:]
+		br.nbits += 8
+	}
+	v := uint32(br.val & (1<<n - 1))
+	br.val >>= n
+	br.nbits -= n
+	br.pos += int64(n)
+	return v
+}
+
+// alignZero skips to the next byte boundary and reports whether the skipped
+// bits are zero, as required for padding.
+func (br *bitReader) alignZero() bool {
+	return br.bits(uint(-br.pos)&7) == 0
+}
+
+// bytes passes the next n bytes to f; the reader must be at a byte boundary.
+func (br *bitReader) bytes(n int64, f func([]byte)) {
+	for n > 0 && br.err == nil {
+		if !br.fill() {
+			br.fail()
+			return
+		}
+		k := len(br.next)
+		if int64(k) > n {
+			k = int(n)
+		}
+		f(br.next[:k])
+		br.next = br.next[k:]
+		br.pos += int64(k) * 8
+		n -= int64(k)
+	}
+}
+
+// more reports whether there is input left; the reader must be at a byte
+// boundary.
+func (br *bitReader) more() bool {
+	return br.fill()
+}
+
+// varLenUint8 reads a number in 0..255 (RFC 7932, section 9.2).
+func (br *bitReader) varLenUint8() int {
+	if br.bits(1) == 0 {
+		return 0
+	}
+	n := uint(br.bits(3))
+	if n == 0 {
+		return 1
+	}
+	return 1<<n + int(br.bits(n))
+}
+
+// huffman is a canonical prefix code (RFC 7932, section 3.2), decoded a bit
+// at a time.
+type huffman struct {
+	count   [16]uint16 // number of codes of each length; count[0] is 1 for the 0-bit code
+	symbols []uint16   // in the order of their codes
+}
+
+// newHuffman builds the code of the given code lengths, indexed by symbol (0
+// for unused symbols). The code must be complete.
+func newHuffman(lengths []uint8) *huffman {
+	h := new(huffman)
+	n := 0
+	for _, l := range lengths {
+		if l != 0 {
+			h.count[l]++
+			n++
+		}
+	}
+	var offsets [16]int
+	for l := 2; l < 16; l++ {
+		offsets[l] = offsets[l-1] + int(h.count[l-1])
+	}
+	h.symbols = make([]uint16, n)
+	for symbol, l := range lengths {
+		if l != 0 {
+			h.symbols[offsets[l]] = uint16(symbol)
+			offsets[l]++
+		}
+	}
+	return h
+}
+
+// singleSymbol returns the 0-bit code of symbol.
+func singleSymbol(symbol int) *huffman {
+	h := &huffman{symbols: []uint16{uint16(symbol)}}
+	h.count[0] = 1
+	return h
+}
+
+func (h *huffman) decode(br *bitReader) int {
+	if h.count[0] != 0 {
+		return int(h.symbols[0])
+	}
+	code, first, index := 0, 0, 0
+	for l := 1; l < 16; l++ {
+		code |= int(br.bits(1))
+		count := int(h.count[l])
+		if code-first < count {
+			return int(h.symbols[index+code-first])
+		}
+		index += count
+		first = (first + count) << 1
+		code <<= 1
+	}
+	// Unreachable with complete codes.
+	return 0
+}
+
+var codeLengthCodeOrder = [18]uint8{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// blockLengthPrefix is the offset and number of extra bits of each block
+// length code (RFC 7932, section 6).
+var blockLengthPrefix = [26]struct {
+	offset int
+	nbits  uint
+}{
+	{1, 2}, {5, 2}, {9, 2}, {13, 2}, {17, 3}, {25, 3}, {33, 3}, {41, 3},
+	{49, 4}, {65, 4}, {81, 4}, {97, 4}, {113, 5}, {145, 5}, {177, 5}, {209, 5},
+	{241, 6}, {305, 6}, {369, 7}, {497, 8}, {753, 9}, {1265, 10}, {2289, 11}, {4337, 12},
+	{8433, 13}, {16625, 24},
+}
+
+// command is the meaning of an insert-and-copy length code (RFC 7932,
+// section 5).
+type command struct {
+	insertOffset, copyOffset int
+	insertBits, copyBits     uint
+	// context is the distance context, and distance is -1 if a distance
+	// code follows and 0 if the last distance is reused.
+	context, distance int
+}
+
+var commands = func() (table [704]command) {
+	insertBits := [24]uint{0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24}
+	copyBits := [24]uint{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24}
+	cellPos := [11]int{0, 1, 0, 1, 8, 9, 2, 16, 10, 17, 18}
+	var insertOffsets, copyOffsets [24]int
+	copyOffsets[0] = 2
+	for i := 0; i < 23; i++ {
+		insertOffsets[i+1] = insertOffsets[i] + 1<<insertBits[i]
+		copyOffsets[i+1] = copyOffsets[i] + 1<<copyBits[i]
+	}
+	for symbol := range table {
+		cell := symbol >> 6
+		pos := cellPos[cell]
+		copyCode := (pos<<3)&0x18 + symbol&7
+		insertCode := pos&0x18 + (symbol>>3)&7
+		c := command{
+			insertOffset: insertOffsets[insertCode],
+			insertBits:   insertBits[insertCode],
+			copyOffset:   copyOffsets[copyCode],
+			copyBits:     copyBits[copyCode],
+			context:      3,
+			distance:     -1,
+		}
+		if c.copyOffset <= 4 {
+			c.context = c.copyOffset - 2
+		}
+		if cell < 2 {
+			c.distance = 0
+		}
+		table[symbol] = c
+	}
+	return table
+}()
+
+const (
+	maxAllowedDistance = 0x7FFFFFFC
+	windowGap          = 16
+)
+
+// inspector decodes Brotli streams in Go to describe their meta-blocks. It
+// supports the built-in static dictionary and raw (LZ77 prefix) shared
+// dictionaries, but not serialized ones.
+type inspector struct {
+	br          bitReader
+	prefix      []byte // concatenated raw dictionaries
+	multistream bool   // decode streams until the end of input
+	largeWindow bool   // accept large window streams
+	onBlock     func(BlockInfo)
+	dict        *staticDictionary
+	lut         []byte
+	word        [maxTransformedWordLength]byte
+	out         int64 // decompressed bytes of previous streams
+
+	// State of the current stream.
+	window      int    // 1 << WBITS
+	large       bool   // the stream uses a large window
+	maxBackward int64  // maximal backward distance
+	hist        []byte // output window; its size is a power of two
+	pos         int64  // decompressed bytes
+	p1, p2      byte   // last bytes of output
+	distRB      [4]int // recent distances
+	distIdx     int
+}
+
+func newInspector(src io.Reader, onBlock func(BlockInfo)) *inspector {
+	return &inspector{
+		br:      newBitReader(src),
+		onBlock: onBlock,
+		dict:    builtinDictionary(),
+		lut:     contextLookupTable(),
+	}
+}
+
+func (in *inspector) errorf(format string, args ...interface{}) error {
+	return &InspectError{Bit: in.br.pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+// run decodes the input; it stops at the first error.
+func (in *inspector) run() error {
+	for {
+		if err := in.stream(); err != nil {
+			return err
+		}
+		if !in.multistream || !in.br.more() {
+			return nil
+		}
+	}
+}
+
+func (in *inspector) stream() error {
+	br := &in.br
+	wbits, err := in.windowBits()
+	if err != nil {
+		return err
+	}
+	in.window = 1 << wbits
+	in.large = wbits > 24
+	in.maxBackward = int64(in.window - windowGap)
+	in.hist = make([]byte, min(in.window, 1<<16))
+	in.pos, in.p1, in.p2 = 0, 0, 0
+	in.distRB, in.distIdx = [4]int{16, 15, 11, 4}, 0
+	for {
+		start := br.pos
+		info := BlockInfo{
+			CompressedOffset:   start / 8,
+			StartBit:           int(start % 8),
+			DecompressedOffset: in.out + in.pos,
+		}
+		if err := in.metaBlock(&info); err != nil {
+			return err
+		}
+		if br.err != nil {
+			return br.err
+		}
+		info.CompressedBits = br.pos - start
+		in.onBlock(info)
+		if info.Last {
+			break
+		}
+	}
+	if !br.alignZero() {
+		return in.errorf("non-zero padding after the last meta-block")
+	}
+	in.out += in.pos
+	return br.err
+}
+
+func (in *inspector) windowBits() (int, error) {
+	br := &in.br
+	if br.bits(1) == 0 {
+		return 16, br.err
+	}
+	if n := int(br.bits(3)); n != 0 {
+		return 17 + n, br.err
+	}
+	n := int(br.bits(3))
+	switch {
+	case n == 1:
+		if !in.largeWindow || br.bits(1) != 0 {
+			return 0, in.errorf("invalid window bits")
+		}
+		wbits := int(br.bits(6))
+		if wbits < 10 || wbits > 30 {
+			return 0, in.errorf("invalid large window bits %d", wbits)
+		}
+		return wbits, br.err
+	case n != 0:
+		return 8 + n, br.err
+	}
+	return 17, br.err
+}
+
+// metaBlock decodes a meta-block and fills in its Type, Last and Length.
+func (in *inspector) metaBlock(info *BlockInfo) error {
+	br := &in.br
+	info.Last = br.bits(1) == 1
+	if info.Last && br.bits(1) == 1 {
+		info.Type = BlockEmpty
+		return nil
+	}
+	nibbles := int(br.bits(2)) + 4
+	if nibbles == 7 {
+		info.Type = BlockMetadata
+		if br.bits(1) != 0 {
+			return in.errorf("reserved bit set")
+		}
+		size := int(br.bits(2))
+		length := int64(0)
+		for i := 0; i < size; i++ {
+			b := int64(br.bits(8))
+			if i == size-1 && size > 1 && b == 0 {
+				return in.errorf("exuberant metadata length byte")
+			}
+			length |= b << (8 * i)
+		}
+		if size != 0 {
+			length++
+		}
+		info.Length = length
+		if !br.alignZero() {
+			return in.errorf("non-zero padding before metadata")
+		}
+		br.bytes(length, func([]byte) {})
+		return nil
+	}
+	length := int64(0)
+	for i := 0; i < nibbles; i++ {
+		b := int64(br.bits(4))
+		if i == nibbles-1 && nibbles > 4 && b == 0 {
+			return in.errorf("exuberant length nibble")
+		}
+		length |= b << (4 * i)
+	}
+	length++
+	info.Length = length
+	if !info.Last && br.bits(1) == 1 {
+		info.Type = BlockUncompressed
+		if !br.alignZero() {
+			return in.errorf("non-zero padding before uncompressed data")
+		}
+		br.bytes(length, func(p []byte) {
+			for _, b := range p {
+				in.put(b)
+			}
+		})
+		return nil
+	}
+	info.Type = BlockCompressed
+	return in.compressed(int(length))
+}
+
+func (in *inspector) put(b byte) {
+	if in.pos == int64(len(in.hist)) && len(in.hist) < in.window {
+		// The window has not wrapped around yet.
+		hist := make([]byte, 2*len(in.hist))
+		copy(hist, in.hist)
+		in.hist = hist
+	}
+	in.hist[in.pos&int64(len(in.hist)-1)] = b
+	in.pos++
+	in.p2, in.p1 = in.p1, b
+}
+
+// readHuffman reads a prefix code of an alphabet of alphabetLimit symbols;
+// alphabetMax determines the width of symbols in simple codes (RFC 7932,
+// section 3.4).
+func (in *inspector) readHuffman(alphabetMax, alphabetLimit int) (*huffman, error) {
+	br := &in.br
+	hskip := int(br.bits(2))
+	if hskip == 1 {
+		return in.readSimpleHuffman(alphabetMax, alphabetLimit)
+	}
+
+	// Code lengths of the code length alphabet, in a static prefix code.
+	var lengths [18]uint8
+	space, codes := 32, 0
+	for i := hskip; i < len(codeLengthCodeOrder) && space > 0; i++ {
+		var v uint8
+		switch br.bits(2) {
+		case 0:
+			v = 0
+		case 1:
+			v = 4
+		case 2:
+			v = 3
+		case 3:
+			if br.bits(1) == 0 {
+				v = 2
+			} else if br.bits(1) == 0 {
+				v = 1
+			} else {
+				v = 5
+			}
+		}
+		lengths[codeLengthCodeOrder[i]] = v
+		if v != 0 {
+			space -= 32 >> v
+			codes++
+		}
+	}
+	if codes != 1 && space != 0 {
+		return nil, in.errorf("invalid code length code")
+	}
+	var lengthCode *huffman
+	if codes == 1 {
+		for symbol, l := range lengths {
+			if l != 0 {
+				lengthCode = singleSymbol(symbol)
+			}
+		}
+	} else {
+		lengthCode = newHuffman(lengths[:])
+	}
+
+	// Code lengths of the alphabet (RFC 7932, section 3.5).
+	symbolLengths := make([]uint8, alphabetLimit)
+	symbol, prevLength, repeat, repeatLength := 0, uint8(8), 0, uint8(0)
+	space = 32768
+	for symbol < alphabetLimit && space > 0 && br.err == nil {
+		l := lengthCode.decode(br)
+		if l < 16 {
+			repeat = 0
+			if l != 0 {
+				symbolLengths[symbol] = uint8(l)
+				prevLength = uint8(l)
+				space -= 32768 >> l
+			}
+			symbol++
+			continue
+		}
+		extraBits, newLength := uint(3), uint8(0)
+		if l == 16 {
+			extraBits, newLength = 2, prevLength
+		}
+		delta := int(br.bits(extraBits))
+		if repeatLength != newLength {
+			repeat, repeatLength = 0, newLength
+		}
+		oldRepeat := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extraBits
+		}
+		repeat += delta + 3
+		delta = repeat - oldRepeat
+		if symbol+delta > alphabetLimit {
+			return nil, in.errorf("code length repeat exceeds the alphabet")
+		}
+		if repeatLength != 0 {
+			for i := 0; i < delta; i++ {
+				symbolLengths[symbol+i] = repeatLength
+			}
+			space -= delta << (15 - repeatLength)
+		}
+		symbol += delta
+	}
+	if br.err != nil {
+		return nil, br.err
+	}
+	if space != 0 {
+		return nil, in.errorf("incomplete or oversubscribed prefix code")
+	}
+	return newHuffman(symbolLengths), nil
+}
+
+func (in *inspector) readSimpleHuffman(alphabetMax, alphabetLimit int) (*huffman, error) {
+	br := &in.br
+	n := int(br.bits(2)) + 1
+	// Symbols are as wide as the largest one.
+	width := uint(bits.Len(uint(alphabetMax - 1)))
+	var symbols [4]int
+	for i := 0; i < n; i++ {
+		symbols[i] = int(br.bits(width))
+		if symbols[i] >= alphabetLimit {
+			return nil, in.errorf("simple prefix code symbol %d out of range", symbols[i])
+		}
+		for j := 0; j < i; j++ {
+			if symbols[j] == symbols[i] {
+				return nil, in.errorf("repeated simple prefix code symbol %d", symbols[i])
+			}
+		}
+	}
+	var lengths [4]uint8
+	switch n {
+	case 1:
+		return singleSymbol(symbols[0]), br.err
+	case 2:
+		lengths = [4]uint8{1, 1}
+	case 3:
+		lengths = [4]uint8{1, 2, 2}
+	case 4:
+		if br.bits(1) == 0 {
+			lengths = [4]uint8{2, 2, 2, 2}
+		} else {
+			lengths = [4]uint8{1, 2, 3, 3}
+		}
+	}
+	symbolLengths := make([]uint8, alphabetLimit)
+	for i := 0; i < n; i++ {
+		symbolLengths[symbols[i]] = lengths[i]
+	}
+	return newHuffman(symbolLengths), br.err
+}
+
+func (in *inspector) readTrees(alphabetMax, alphabetLimit, n int) ([]*huffman, error) {
+	trees := make([]*huffman, n)
+	for i := range trees {
+		var err error
+		if trees[i], err = in.readHuffman(alphabetMax, alphabetLimit); err != nil {
+			return nil, err
+		}
+	}
+	return trees, nil
+}
+
+// readContextMap reads a context map of size entries (RFC 7932, section 7.3)
+// and returns it with the number of trees it refers to.
+func (in *inspector) readContextMap(size int) ([]uint8, int, error) {
+	br := &in.br
+	trees := br.varLenUint8() + 1
+	contextMap := make([]uint8, size)
+	if trees <= 1 {
+		return contextMap, trees, br.err
+	}
+	rleMax := 0
+	if br.bits(1) == 1 {
+		rleMax = int(br.bits(4)) + 1
+	}
+	code, err := in.readHuffman(trees+rleMax, trees+rleMax)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i < size && br.err == nil; {
+		symbol := code.decode(br)
+		switch {
+		case symbol == 0:
+			i++
+		case symbol > rleMax:
+			contextMap[i] = uint8(symbol - rleMax)
+			i++
+		default:
+			run := 1<<symbol + int(br.bits(uint(symbol)))
+			if i+run > size {
+				return nil, 0, in.errorf("context map run exceeds the map")
+			}
+			i += run
+		}
+	}
+	if br.bits(1) == 1 {
+		// Inverse move-to-front transform.
+		var mtf [256]uint8
+		for i := range mtf {
+			mtf[i] = uint8(i)
+		}
+		for i, index := range contextMap {
+			v := mtf[index]
+			copy(mtf[1:index+1], mtf[:index])
+			mtf[0] = v
+			contextMap[i] = v
+		}
+	}
+	return contextMap, trees, br.err
+}
+
+// blockSwitch tracks the block types of a category (literals, commands or
+// distances) in a meta-block (RFC 7932, section 6).
+type blockSwitch struct {
+	types             int
+	typeCode, lenCode *huffman
+	current, previous int
+	length            int // remaining in the current block
+}
+
+func (in *inspector) readBlockSwitch(b *blockSwitch) error {
+	br := &in.br
+	b.types = br.varLenUint8() + 1
+	b.current, b.previous = 0, 1
+	if b.types < 2 {
+		b.length = 1 << 24
+		return br.err
+	}
+	var err error
+	if b.typeCode, err = in.readHuffman(b.types+2, b.types+2); err != nil {
+		return err
+	}
+	if b.lenCode, err = in.readHuffman(26, 26); err != nil {
+		return err
+	}
+	b.length = b.readLength(br)
+	return br.err
+}
+
+func (b *blockSwitch) readLength(br *bitReader) int {
+	p := blockLengthPrefix[b.lenCode.decode(br)]
+	return p.offset + int(br.bits(p.nbits))
+}
+
+// next switches to the next block.
+func (b *blockSwitch) next(in *inspector) error {
+	if b.types < 2 {
+		return in.errorf("block switch without block types")
+	}
+	br := &in.br
+	t := b.typeCode.decode(br)
+	b.length = b.readLength(br)
+	switch t {
+	case 0:
+		t = b.previous
+	case 1:
+		t = b.current + 1
+	default:
+		t -= 2
+	}
+	if t >= b.types {
+		t -= b.types
+	}
+	b.previous, b.current = b.current, t
+	return br.err
+}
+
+// distanceCodeLimit returns the size of the distance alphabet that codes
+// distances up to maxDistance; see BrotliCalculateDistanceCodeLimit.
+func distanceCodeLimit(maxDistance, npostfix, ndirect int) int {
+	if maxDistance <= ndirect {
+		return maxDistance + 16
+	}
+	offset := (maxDistance-ndirect)>>npostfix + 4
+	bits := 0
+	for tmp := offset / 2; tmp != 0; tmp >>= 1 {
+		bits++
+	}
+	bits--
+	half := (offset >> bits) & 1
+	group := (bits-1)<<1 | half
+	if group == 0 {
+		return ndirect + 16
+	}
+	group--
+	return (group<<npostfix | (1<<npostfix - 1)) + ndirect + 16 + 1
+}
+
+// compressed decodes the body of a compressed meta-block of length bytes
+// (RFC 7932, section 9.2 and 9.3).
+func (in *inspector) compressed(length int) error {
+	br := &in.br
+	var blocks [3]blockSwitch // literals, commands, distances
+	for i := range blocks {
+		if err := in.readBlockSwitch(&blocks[i]); err != nil {
+			return err
+		}
+	}
+	postfix := br.bits(6)
+	npostfix := uint(postfix & 3)
+	ndirect := int(postfix>>2) << npostfix
+	modes := make([]uint8, blocks[0].types)
+	for i := range modes {
+		modes[i] = uint8(br.bits(2))
+	}
+	literalMap, literalTrees, err := in.readContextMap(blocks[0].types << 6)
+	if err != nil {
+		return err
+	}
+	distanceMap, distanceTrees, err := in.readContextMap(blocks[2].types << 2)
+	if err != nil {
+		return err
+	}
+	literals, err := in.readTrees(256, 256, literalTrees)
+	if err != nil {
+		return err
+	}
+	insertCopy, err := in.readTrees(704, 704, blocks[1].types)
+	if err != nil {
+		return err
+	}
+	distanceMax := 16 + ndirect + 24<<(npostfix+1)
+	distanceLimit := distanceMax
+	if in.large {
+		distanceMax = 16 + ndirect + 62<<(npostfix+1)
+		distanceLimit = distanceCodeLimit(maxAllowedDistance, int(npostfix), ndirect)
+	}
+	distances, err := in.readTrees(distanceMax, distanceLimit, distanceTrees)
+	if err != nil {
+		return err
+	}
+
+	// Offsets and extra bits of distance codes, after the 16 short codes.
+	distanceOffsets := make([]int, distanceLimit)
+	distanceBits := make([]uint, distanceLimit)
+	i := 16
+	for j := 0; j < ndirect; j++ {
+		distanceOffsets[i] = j + 1
+		i++
+	}
+	for nbits, half := uint(1), 0; i < distanceLimit; {
+		base := ndirect + ((2+half)<<nbits-4)<<npostfix + 1
+		for j := 0; j < 1<<npostfix && i < distanceLimit; j++ {
+			distanceOffsets[i] = base + j
+			distanceBits[i] = nbits
+			i++
+		}
+		nbits += uint(half)
+		half ^= 1
+	}
+
+	literalType, commandType, distanceType := 0, 0, 0
+	mode := int(modes[0]&3) << 9
+	remaining := length
+	for remaining > 0 {
+		if br.err != nil {
+			return br.err
+		}
+		if blocks[1].length == 0 {
+			if err := blocks[1].next(in); err != nil {
+				return err
+			}
+			commandType = blocks[1].current
+		}
+		blocks[1].length--
+		cmd := commands[insertCopy[commandType].decode(br)]
+		insert := cmd.insertOffset + int(br.bits(cmd.insertBits))
+		copyLength := cmd.copyOffset + int(br.bits(cmd.copyBits))
+		if insert > remaining {
+			return in.errorf("insert length exceeds the meta-block")
+		}
+		remaining -= insert
+		for ; insert > 0; insert-- {
+			if blocks[0].length == 0 {
+				if err := blocks[0].next(in); err != nil {
+					return err
+				}
+				literalType = blocks[0].current
+				mode = int(modes[literalType]&3) << 9
+			}
+			blocks[0].length--
+			context := in.lut[mode+int(in.p1)] | in.lut[mode+256+int(in.p2)]
+			in.put(byte(literals[literalMap[literalType<<6+int(context)]].decode(br)))
+		}
+		if remaining == 0 {
+			break
+		}
+
+		distance, distanceContext := 0, cmd.context
+		if cmd.distance >= 0 {
+			// The last distance is reused; the dictionary case compensates
+			// for the decrement.
+			distanceContext = 1
+			in.distIdx--
+			distance = in.distRB[in.distIdx&3]
+		} else {
+			if blocks[2].length == 0 {
+				if err := blocks[2].next(in); err != nil {
+					return err
+				}
+				distanceType = blocks[2].current
+			}
+			blocks[2].length--
+			code := distances[distanceMap[distanceType<<2+distanceContext]].decode(br)
+			distanceContext = 0
+			switch {
+			case code <= 3:
+				distanceContext = 1 >> code
+				distance = in.distRB[(in.distIdx-(code-3))&3]
+				in.distIdx -= distanceContext
+			case code < 16:
+				base, indexDelta := code-10, 2
+				if code < 10 {
+					base, indexDelta = code-4, 3
+				}
+				distance = in.distRB[(in.distIdx+indexDelta)&3] + (0x605142>>(4*base))&0xF - 3
+				if distance <= 0 {
+					distance = 0x7FFFFFFF
+				}
+			default:
+				distance = distanceOffsets[code] + int(br.bits(distanceBits[code]))<<npostfix
+			}
+		}
+		if br.err != nil {
+			return br.err
+		}
+
+		maxDistance := int(min(in.pos, in.maxBackward))
+		if distance <= maxDistance {
+			if copyLength > remaining {
+				return in.errorf("copy length exceeds the meta-block")
+			}
+			in.distRB[in.distIdx&3] = distance
+			in.distIdx++
+			remaining -= copyLength
+			for ; copyLength > 0; copyLength-- {
+				in.put(in.hist[(in.pos-int64(distance))&int64(len(in.hist)-1)])
+			}
+			continue
+		}
+		if distance > maxAllowedDistance {
+			return in.errorf("invalid distance %d", distance)
+		}
+		if address := distance - maxDistance - 1; address < len(in.prefix) {
+			// A reference to the raw dictionaries, that precede the output.
+			address = len(in.prefix) - (distance - maxDistance)
+			if copyLength > len(in.prefix)-address || copyLength > remaining {
+				return in.errorf("invalid dictionary reference")
+			}
+			in.distRB[in.distIdx&3] = distance
+			in.distIdx++
+			remaining -= copyLength
+			for _, b := range in.prefix[address : address+copyLength] {
+				in.put(b)
+			}
+			continue
+		}
+		// A reference to the static dictionary.
+		if copyLength < minDictionaryWordLength || copyLength > maxDictionaryWordLength ||
+			in.dict.sizeBits[copyLength] == 0 {
+			return in.errorf("invalid static dictionary word length %d", copyLength)
+		}
+		shift := uint(in.dict.sizeBits[copyLength])
+		address := distance - maxDistance - 1 - len(in.prefix)
+		word, transform := address&(1<<shift-1), address>>shift
+		in.distIdx += distanceContext
+		if transform >= in.dict.numTransforms {
+			return in.errorf("invalid static dictionary transform %d", transform)
+		}
+		offset := int(in.dict.offsets[copyLength]) + word*copyLength
+		n := in.dict.transform(in.word[:], in.dict.data[offset:offset+copyLength], transform)
+		if n == 0 && distance <= 120 {
+			return in.errorf("empty transformed dictionary word")
+		}
+		if n > remaining {
+			return in.errorf("dictionary word exceeds the meta-block")
+		}
+		remaining -= n
+		for _, b := range in.word[:n] {
+			in.put(b)
+		}
+	}
+	return br.err
+}
+
+// InspectStream describes the meta-blocks of the Brotli stream data, in order.
+// It parses the stream in Go, without C-Brotli: all meta-blocks are decoded,
+// but only a window of output is kept. Large window streams are supported;
+// streams compressed with a shared dictionary are not.
+//
+// A malformed stream is reported with an *InspectError, and a truncated one
+// with ErrTruncated, along with the meta-blocks before the one that failed.
+// Bytes after the end of the stream are an error too.
+func InspectStream(data []byte) ([]BlockInfo, error) {
+	var blocks []BlockInfo
+	err := InspectReader(bytes.NewReader(data), func(info BlockInfo) { blocks = append(blocks, info) })
+	return blocks, err
+}
+
+// InspectReader is like InspectStream, but it reads the stream from src and
+// calls onBlock for each meta-block, as soon as it is parsed. Errors of src
+// are returned as is.
+func InspectReader(src io.Reader, onBlock func(BlockInfo)) error {
+	in := newInspector(src, onBlock)
+	in.largeWindow = true
+	if err := in.run(); err != nil {
+		return err
+	}
+	if in.br.more() {
+		return in.errorf("data after the end of the stream")
+	}
+	if err := in.br.srcErr; err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// blockTracker runs an inspector over the input consumed by the decoder of a
+// Reader, in a goroutine that works in lock step with the Reader: feed returns
+// once the inspector has processed the chunk and waits for the next one, so
+// blocks holds every meta-block completed by the chunks fed so far.
+type blockTracker struct {
+	in     chan []byte
+	idle   chan struct{}
+	quit   chan struct{}
+	done   chan struct{} // closed when the inspector returns
+	chunk  []byte        // being read by the inspector
+	fed    bool          // the inspector has received a chunk
+	blocks []BlockInfo   // completed and not reported yet
+	err    error         // of the inspector
+}
+
+var errTrackerClosed = errors.New("cbrotli: Reader is closed")
+
+func newBlockTracker(prefix []byte) *blockTracker {
+	t := &blockTracker{
+		in:   make(chan []byte),
+		idle: make(chan struct{}),
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	in := newInspector(t, func(info BlockInfo) { t.blocks = append(t.blocks, info) })
+	in.prefix = prefix
+	in.multistream = true
+	go func() {
+		defer close(t.done)
+		t.err = in.run()
+	}()
+	return t
+}
+
+// Read implements io.Reader for the inspector.
+func (t *blockTracker) Read(p []byte) (int, error) {
+	for len(t.chunk) == 0 {
+		if t.fed {
+			select {
+			case t.idle <- struct{}{}:
+			case <-t.quit:
+				return 0, errTrackerClosed
+			}
+		}
+		select {
+		case chunk, ok := <-t.in:
+			if !ok {
+				return 0, io.EOF
+			}
+			t.chunk, t.fed = chunk, true
+		case <-t.quit:
+			return 0, errTrackerClosed
+		}
+	}
+	n := copy(p, t.chunk)
+	t.chunk = t.chunk[n:]
+	return n, nil
+}
+
+// feed passes input consumed by the decoder to the inspector and waits until
+// it is processed. Nothing happens if the inspector has returned.
+func (t *blockTracker) feed(p []byte) {
+	select {
+	case t.in <- p:
+	case <-t.done:
+		return
+	}
+	select {
+	case <-t.idle:
+	case <-t.done:
+	}
+}
+
+// close stops the inspector.
+func (t *blockTracker) close() {
+	close(t.quit)
+	<-t.done
+}
@@ -0,0 +1,311 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+// Package conformance tests that a backend of the Brotli API behaves like
+// cbrotli: that it decodes the test streams of cbrotli to the same content,
+// that it fails on malformed input with the same kinds of errors, and that its
+// output round-trips through cbrotli. cbrotli runs it against itself, in builds
+// with and without cgo, and the dynbrotli module against the C-Brotli
+// libraries it loads.
+package conformance
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+// Writer is the streaming encoder of a backend.
+type Writer interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// Backend is the API under test.
+type Backend struct {
+	Decode                  func(encoded []byte) ([]byte, error)
+	DecodeWithRawDictionary func(encoded, dictionary []byte) ([]byte, error)
+	NewReader               func(src io.Reader) io.ReadCloser
+	// Encode and NewWriter compress with quality and lgwin (0 for the
+	// default window). A backend without an encoder fails with
+	// cbrotli.ErrNotSupported.
+	Encode    func(content []byte, quality, lgwin int) ([]byte, error)
+	NewWriter func(dst io.Writer, quality, lgwin int) Writer
+}
+
+// Run runs the tests against b; streams holds the files of the
+// testdata/streams directory of cbrotli.
+func Run(t *testing.T, b Backend, streams fs.FS) {
+	t.Run("Streams", func(t *testing.T) { testStreams(t, b, streams) })
+	t.Run("Dictionary", func(t *testing.T) { testDictionary(t, b, streams) })
+	t.Run("Errors", func(t *testing.T) { testErrors(t, b, streams) })
+	t.Run("RoundTrip", func(t *testing.T) { testRoundTrip(t, b) })
+	t.Run("Writer", func(t *testing.T) { testWriter(t, b) })
+}
+
+// WordSoup returns text made of random words, which compresses noticeably
+// better at higher qualities.
+func WordSoup(seed int64, size int) []byte {
+	words := []string{"alpha ", "beta ", "gamma ", "delta ", "epsilon ", "zeta ",
+		"eta ", "theta ", "iota ", "kappa ", "lambda ", "mu ", "nu ", "xi "}
+	src := rand.New(rand.NewSource(seed))
+	var buf bytes.Buffer
+	for buf.Len() < size {
+		buf.WriteString(words[src.Intn(len(words))])
+		if src.Intn(8) == 0 {
+			fmt.Fprintf(&buf, "%d\n", src.Intn(100000))
+		}
+	}
+	return buf.Bytes()[:size]
+}
+
+// kind classifies the result of decoding for comparison across backends,
+// whose error messages differ. The pure-Go decoder of cbrotli reports the
+// closest C-Brotli code for the corruption it finds, which is not always the
+// code of C-Brotli, so the codes only count when cbrotli uses C-Brotli.
+func kind(err error) string {
+	var de cbrotli.DecoderError
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, cbrotli.ErrTruncated):
+		return "truncated"
+	case errors.As(err, &de) && cbrotli.Version() != "":
+		return fmt.Sprintf("decoder error %d", de.Code)
+	case errors.As(err, &de):
+		return "decoder error"
+	}
+	return "other error"
+}
+
+// readAll decodes data with a Reader of b reading it byte by byte.
+func readAll(b Backend, data []byte) ([]byte, error) {
+	r := b.NewReader(iotest.OneByteReader(bytes.NewReader(data)))
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// checkDecode checks that b decodes encoded like cbrotli, with Decode and
+// with a Reader.
+func checkDecode(t *testing.T, b Backend, name string, encoded []byte) {
+	t.Helper()
+	want, wantErr := cbrotli.Decode(encoded)
+	got, err := b.Decode(encoded)
+	if kind(err) != kind(wantErr) || (wantErr == nil && !bytes.Equal(got, want)) {
+		t.Errorf("%s: Decode: %d bytes, %v; cbrotli: %d bytes, %v", name, len(got), err, len(want), wantErr)
+	}
+	got, err = readAll(b, encoded)
+	if kind(err) != kind(wantErr) || (wantErr == nil && !bytes.Equal(got, want)) {
+		t.Errorf("%s: Reader: %d bytes, %v; cbrotli: %d bytes, %v", name, len(got), err, len(want), wantErr)
+	}
+}
+
+func testStreams(t *testing.T, b Backend, streams fs.FS) {
+	files, err := fs.Glob(streams, "*.br")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no streams: %v", err)
+	}
+	for _, name := range files {
+		encoded, err := fs.ReadFile(streams, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkDecode(t, b, name, encoded)
+	}
+}
+
+func testDictionary(t *testing.T, b Backend, streams fs.FS) {
+	encoded, err := fs.ReadFile(streams, "dictionary.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// See testStreamEncoders in cbrotli.
+	dictionary := WordSoup(11, 100000)
+	got, err := b.DecodeWithRawDictionary(encoded, dictionary)
+	if errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Skip(err)
+	}
+	if err != nil || !bytes.Equal(got, dictionary[5000:25000]) {
+		t.Errorf("DecodeWithRawDictionary: %d bytes, %v", len(got), err)
+	}
+	// The decoder can not tell another dictionary of the same size, but the
+	// content differs.
+	other := bytes.ToUpper(dictionary)
+	if got, err := b.DecodeWithRawDictionary(encoded, other); err == nil && bytes.Equal(got, dictionary[5000:25000]) {
+		t.Error("DecodeWithRawDictionary with another dictionary: same content")
+	}
+}
+
+func testErrors(t *testing.T, b Backend, streams fs.FS) {
+	encoded, err := fs.ReadFile(streams, "source-errors.br")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cut := range []int{0, 1, 2, 10, len(encoded) / 3, len(encoded) / 2, len(encoded) - 1} {
+		checkDecode(t, b, fmt.Sprintf("cut at %d", cut), encoded[:cut])
+	}
+	// Bit flips of the header make distinct errors; later ones may go
+	// unnoticed until the end of the stream.
+	for _, pos := range []int{0, 1, 2, 3, 5, 8, 100, len(encoded) / 2, len(encoded) - 1} {
+		for bit := 0; bit < 8; bit += 3 {
+			corrupt := bytes.Clone(encoded)
+			corrupt[pos] ^= 1 << bit
+			checkDecode(t, b, fmt.Sprintf("bit %d of byte %d", bit, pos), corrupt)
+		}
+	}
+	checkDecode(t, b, "trailing byte", append(bytes.Clone(encoded), 0))
+	checkDecode(t, b, "trailing stream", append(bytes.Clone(encoded), encoded...))
+
+	// Errors of the source are returned after the data read before them,
+	// and are sticky.
+	want, err := cbrotli.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sentinel := errors.New("sentinel")
+	for _, cut := range []int{0, len(encoded) / 2, len(encoded)} {
+		r := b.NewReader(io.MultiReader(bytes.NewReader(encoded[:cut]), iotest.ErrReader(sentinel)))
+		got, err := io.ReadAll(r)
+		if !errors.Is(err, sentinel) {
+			t.Errorf("source error after %d bytes: %v", cut, err)
+		}
+		if !bytes.HasPrefix(want, got) || (cut == len(encoded) && len(got) != len(want)) {
+			t.Errorf("source error after %d bytes: decoded %d bytes of %d", cut, len(got), len(want))
+		}
+		if _, again := r.Read(make([]byte, 10)); again != err {
+			t.Errorf("source error after %d bytes: second Read: %v, want %v", cut, again, err)
+		}
+		if err := r.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	}
+}
+
+// encode calls b.Encode, and skips the test if b has no encoder.
+func encode(t *testing.T, b Backend, content []byte, quality, lgwin int) []byte {
+	t.Helper()
+	encoded, err := b.Encode(content, quality, lgwin)
+	if errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatalf("Encode(%d bytes, quality %d, lgwin %d): %v", len(content), quality, lgwin, err)
+	}
+	return encoded
+}
+
+func testRoundTrip(t *testing.T, b Backend) {
+	random := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(random)
+	inputs := map[string][]byte{
+		"empty":  nil,
+		"byte":   {'x'},
+		"short":  WordSoup(1, 1000),
+		"long":   WordSoup(2, 300000),
+		"random": random,
+	}
+	for name, input := range inputs {
+		for _, quality := range []int{0, 1, 5, 9, 11} {
+			for _, lgwin := range []int{0, 10, 18, 24} {
+				if quality == 11 && len(input) > 100000 && lgwin != 0 {
+					// Slow, and no different.
+					continue
+				}
+				encoded := encode(t, b, input, quality, lgwin)
+				if decoded, err := cbrotli.Decode(encoded); err != nil || !bytes.Equal(decoded, input) {
+					t.Errorf("%s, quality %d, lgwin %d: cbrotli.Decode: %d bytes, %v", name, quality, lgwin, len(decoded), err)
+				}
+				if decoded, err := b.Decode(encoded); err != nil || !bytes.Equal(decoded, input) {
+					t.Errorf("%s, quality %d, lgwin %d: Decode: %d bytes, %v", name, quality, lgwin, len(decoded), err)
+				}
+			}
+		}
+	}
+}
+
+// limitedWriter accepts at most limit bytes per Write call.
+type limitedWriter struct {
+	dst   io.Writer
+	limit int
+}
+
+func (w limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		p = p[:w.limit]
+	}
+	return w.dst.Write(p)
+}
+
+// failingWriter fails all writes.
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func testWriter(t *testing.T, b Backend) {
+	// Skips backends without an encoder.
+	encode(t, b, nil, 5, 0)
+	input := WordSoup(3, 100000)
+	var out bytes.Buffer
+	w := b.NewWriter(limitedWriter{&out, 7}, 5, 0)
+	if _, err := w.Write(input[:1000]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	// The flushed output decodes to all of the input written before. The
+	// pure-Go decoder of cbrotli loses the content of a truncated stream in
+	// its window, so the backend decodes it.
+	r := b.NewReader(bytes.NewReader(out.Bytes()))
+	if flushed, err := io.ReadAll(r); kind(err) != "truncated" || !bytes.Equal(flushed, input[:1000]) {
+		t.Errorf("flushed output: %d bytes, %v", len(flushed), err)
+	}
+	r.Close()
+	if _, err := w.Write(input[1000:]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	checkDecode(t, b, "Writer output", out.Bytes())
+	if decoded, err := b.Decode(out.Bytes()); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("Decode: %d bytes, %v", len(decoded), err)
+	}
+	if _, err := w.Write([]byte{0}); err != cbrotli.ErrWriterClosed {
+		t.Errorf("Write after Close: %v", err)
+	}
+	if err := w.Close(); err != cbrotli.ErrWriterClosed {
+		t.Errorf("second Close: %v", err)
+	}
+
+	// Errors of the destination are returned, and are sticky.
+	sentinel := errors.New("sentinel")
+	w = b.NewWriter(failingWriter{sentinel}, 5, 0)
+	w.Write(input)
+	if err := w.Flush(); err != sentinel {
+		t.Errorf("Flush to a failing destination: %v", err)
+	}
+	if _, err := w.Write(input); err != sentinel {
+		t.Errorf("Write after a destination error: %v", err)
+	}
+	if err := w.Close(); err != sentinel {
+		t.Errorf("Close after a destination error: %v", err)
+	}
+	w = b.NewWriter(limitedWriter{io.Discard, 0}, 5, 0)
+	if err := w.Close(); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Close with no progress: got %v, want %v", err, io.ErrShortWrite)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package decoder
+
+//go:generate sh update.sh
+
+import (
+	"io"
+	"unsafe"
+)
+
+// Result codes of Decoder.Decode; errors are negative. The values are those of
+// the Java decoder the code is generated from.
+const (
+	// Done means that the stream is complete and all of its content returned.
+	Done = 1
+	// TruncatedInput means that the input ends in the middle of the stream.
+	TruncatedInput = -16
+	// ReadAfterEnd means that the stream refers to bits past the end of the
+	// input, which is truncated too.
+	ReadAfterEnd = -13
+	// ReadFailed means that the source returned an error other than io.EOF.
+	ReadFailed = -14
+	// UnusedBytesAfterEnd means that the stream is complete, but the input
+	// has more bytes after it; see Decoder.Unused.
+	UnusedBytesAfterEnd = -17
+)
+
+// Decoder decodes a single Brotli stream read from an io.Reader. It reads
+// input ahead, in chunks of up to 4KiB, and blocks until a chunk is full or
+// the source reaches its end.
+type Decoder struct {
+	s            *_State
+	src          io.Reader
+	dictionaries [][]byte
+	started      bool
+}
+
+// New returns a Decoder of the stream read from src. A source returning no
+// data and no error is taken to be at its end.
+func New(src io.Reader) *Decoder {
+	d := &Decoder{s: makeState(), src: src}
+	d.s.input = &d.src
+	return d
+}
+
+// AttachDictionary adds a raw (LZ77 prefix) dictionary; it must be called
+// before Decode. The data must not be modified while the Decoder is in use.
+func (d *Decoder) AttachDictionary(data []byte) {
+	if len(data) != 0 {
+		d.dictionaries = append(d.dictionaries, data)
+	}
+}
+
+// Decode decodes the stream into p and returns the number of bytes written
+// and a result code: Done, 0 if p is full, or a negative error code. Errors
+// are sticky.
+func (d *Decoder) Decode(p []byte) (int, int32) {
+	if !d.started {
+		d.started = true
+		if code := initState(d.s); code < 0 {
+			return 0, code
+		}
+		for _, data := range d.dictionaries {
+			if code := attachDictionaryChunk(d.s, int8s(data)); code < 0 {
+				return 0, code
+			}
+		}
+	}
+	if len(p) == 0 {
+		return 0, 0
+	}
+	d.s.output = int8s(p)
+	d.s.outputOffset = 0
+	d.s.outputLength = int32(len(p))
+	d.s.outputUsed = 0
+	code := decompress(d.s)
+	if code > 0 && code != Done {
+		code = 0
+	}
+	return int(d.s.outputUsed), code
+}
+
+// Buffered returns the number of input bytes read from the source but not
+// consumed by the decoder yet.
+func (d *Decoder) Buffered() int {
+	s := d.s
+	if s.byteBuffer == nil {
+		return 0
+	}
+	valid := int32(4096)
+	if s.endOfStreamReached != 0 {
+		valid = s.tailBytes
+	}
+	consumed := (s.halfOffset << 2) + ((s.bitOffset + 7) >> 3) - 8
+	return int(max(0, valid-consumed))
+}
+
+// Overrun reports whether the decoder has read bits past the end of the
+// input. The decoder reads zeros there, so that the stream of a truncated
+// input may look malformed before the decoder notices the end.
+func (d *Decoder) Overrun() bool {
+	s := d.s
+	return s.endOfStreamReached != 0 && (s.halfOffset<<5)+s.bitOffset-64 > s.tailBytes<<3
+}
+
+// Unused returns a copy of the input bytes read from the source after the
+// end of the stream, once Decode has returned Done or UnusedBytesAfterEnd.
+func (d *Decoder) Unused() []byte {
+	n := d.Buffered()
+	if n == 0 {
+		return nil
+	}
+	s := d.s
+	end := int32(4096)
+	if s.endOfStreamReached != 0 {
+		end = s.tailBytes
+	}
+	unused := make([]byte, n)
+	copy(unused, uint8s(s.byteBuffer[end-int32(n):end]))
+	return unused
+}
+
+// Close releases the buffers of the Decoder.
+func (d *Decoder) Close() {
+	closeInput(d.s)
+	d.s = makeState()
+	d.src = nil
+	d.dictionaries = nil
+}
+
+// Dictionary returns the built-in static dictionary (RFC 7932, Appendix A):
+// its data, and the offset and the base 2 logarithm of the number of its
+// words of each length. The data must not be modified.
+func Dictionary() (dictionary []byte, wordOffsets, wordSizeBits [32]int32) {
+	copy(wordOffsets[:], offsets)
+	copy(wordSizeBits[:], sizeBits)
+	return uint8s(data), wordOffsets, wordSizeBits
+}
+
+// NumTransforms is the number of built-in transforms (RFC 7932, Appendix B).
+func NumTransforms() int {
+	return int(rfcTransforms.numTransforms)
+}
+
+// Transform writes word transformed with the built-in transform idx to dst,
+// which must have room for the longest prefix and suffix, and returns the
+// length of the result.
+func Transform(dst, word []byte, idx int) int {
+	return int(transformDictionaryWord(int8s(dst), 0, int8s(word), 0, int32(len(word)), rfcTransforms, int32(idx)))
+}
+
+// ContextLookupTable returns the literal context lookup table of RFC 7932,
+// section 7.1, laid out as _kBrotliContextLookupTable of C-Brotli.
+func ContextLookupTable() []byte {
+	table := make([]byte, len(lookup))
+	for i, v := range lookup {
+		table[i] = byte(v)
+	}
+	return table
+}
+
+func int8s(p []byte) []int8 {
+	return unsafe.Slice((*int8)(unsafe.Pointer(unsafe.SliceData(p))), len(p))
+}
+
+func uint8s(p []int8) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(p))), len(p))
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package decoder
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerated(t *testing.T) {
+	upstream, err := os.ReadFile("../../../brotli/decode.go")
+	if err != nil {
+		t.Skip(err)
+	}
+	generated, err := os.ReadFile("decode.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.NewReplacer(
+		"// Package brotli implements the Brotli decompression algorithm.\npackage brotli\n",
+		"// Code generated by update.sh from go/brotli/decode.go. DO NOT EDIT.\n\n"+
+			"// Package decoder is the Brotli decoder of the pure-Go brotli module,\n"+
+			"// adapted to cbrotli by decoder.go.\npackage decoder\n",
+	).Replace(string(upstream))
+	if string(generated) != want {
+		t.Error("decode.go is stale; run go generate")
+	}
+}
@@ -0,0 +1,19 @@
+#!/bin/sh
+# Copyright 2025 Google Inc. All Rights Reserved.
+#
+# Distributed under MIT license.
+# See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+# Copies the decoder of the pure-Go brotli module into this package, which
+# cbrotli uses when it is built without cgo; only the package clause and the
+# package comment change, so fixes belong in go/brotli/decode.go.
+# Run it from go/cbrotli/internal/decoder, through go generate, whenever
+# go/brotli/decode.go changes.
+set -e
+
+sed -e 's|^// Package brotli implements the Brotli decompression algorithm.$|// Code generated by update.sh from go/brotli/decode.go. DO NOT EDIT.\
+\
+// Package decoder is the Brotli decoder of the pure-Go brotli module,\
+// adapted to cbrotli by decoder.go.|' \
+  -e 's|^package brotli$|package decoder|' \
+  ../../../brotli/decode.go > decode.go
@@ -0,0 +1,61 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// JoinStreams writes parts, which must be complete Brotli streams, to w one
+// after another, so that the result decodes to the concatenation of the
+// decoded parts by a Reader in Multistream mode (see ReaderOptions).
+//
+// Brotli streams can not be spliced at bit level in general: a backward
+// distance that reaches beyond the start of a stream refers to the static
+// dictionary, but would refer to the preceding part after splicing. The parts
+// are thus kept as separate streams; each of them keeps its own window size,
+// so parts with different windows can be joined. Decoders that do not support
+// concatenated streams (e.g. web browsers) fail on the bytes that follow the
+// first part; to assemble a single stream, produce the parts with Writers
+// chained by CloseAppendable and StreamOffset instead.
+//
+// All parts are decoded to validate them before anything is written to w.
+// Writers that accept only a part of the data are retried with the remainder;
+// a write that makes no progress without reporting an error is turned into
+// io.ErrShortWrite.
+func JoinStreams(w io.Writer, parts ...[]byte) error {
+	if len(parts) == 0 {
+		return fmt.Errorf("cbrotli: no streams to join")
+	}
+	for i, part := range parts {
+		if err := validateStream(part); err != nil {
+			return fmt.Errorf("cbrotli: part %d: %w", i, err)
+		}
+	}
+	for _, part := range parts {
+		for len(part) > 0 {
+			m, err := w.Write(part)
+			if err != nil {
+				return err
+			}
+			if m <= 0 || m > len(part) {
+				return io.ErrShortWrite
+			}
+			part = part[m:]
+		}
+	}
+	return nil
+}
+
+// validateStream checks that stream is a single complete Brotli stream.
+func validateStream(stream []byte) error {
+	r := NewReader(bytes.NewReader(stream))
+	defer r.Close()
+	_, err := io.Copy(io.Discard, r)
+	return err
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+var logger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger of conditions that the package tolerates but that
+// may reveal problems: trailing input after a stream (Warn), sources that
+// return no data and no error (Debug), and Writers reset after a failure
+// (Debug). Records carry stream offsets and errors as attributes. The default,
+// nil, disables logging. SetLogger is safe to call concurrently with the use
+// of Readers and Writers; the logger is never called with internal locks held.
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// logEvent emits a record if a logger is set.
+func logEvent(level slog.Level, msg string, args ...any) {
+	if l := logger.Load(); l != nil {
+		l.Log(context.Background(), level, msg, args...)
+	}
+}
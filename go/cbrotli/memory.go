@@ -0,0 +1,234 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+#include <brotli/decode.h>
+#include <brotli/encode.h>
+
+typedef struct {
+  size_t current;
+  size_t peak;
+} MemoryTracker;
+
+// Allocations are prefixed with their size, so that free can account for it.
+#define TRACKER_HEADER_SIZE 16
+
+static void* TrackedAlloc(void* opaque, size_t size) {
+  MemoryTracker* tracker = (MemoryTracker*)opaque;
+  size_t* block = (size_t*)malloc(size + TRACKER_HEADER_SIZE);
+  if (!block) return 0;
+  block[0] = size;
+  tracker->current += size;
+  if (tracker->current > tracker->peak) tracker->peak = tracker->current;
+  return (uint8_t*)block + TRACKER_HEADER_SIZE;
+}
+
+static void TrackedFree(void* opaque, void* address) {
+  MemoryTracker* tracker = (MemoryTracker*)opaque;
+  size_t* block;
+  if (!address) return;
+  block = (size_t*)((uint8_t*)address - TRACKER_HEADER_SIZE);
+  tracker->current -= block[0];
+  free(block);
+}
+
+static BrotliDecoderState* CreateTrackedDecoder(MemoryTracker* tracker) {
+  return BrotliDecoderCreateInstance(TrackedAlloc, TrackedFree, tracker);
+}
+
+static BrotliEncoderState* CreateTrackedEncoder(MemoryTracker* tracker) {
+  return BrotliEncoderCreateInstance(TrackedAlloc, TrackedFree, tracker);
+}
+
+// Returns the memory held by an encoder after the first input byte is
+// processed; at this point the state and the hasher are allocated.
+static size_t MeasureEncoderBase(int quality, int lgwin) {
+  MemoryTracker tracker = {0, 0};
+  uint8_t input = 0;
+  size_t available_in = 1;
+  const uint8_t* next_in = &input;
+  size_t available_out = 0;
+  size_t result;
+  BrotliEncoderState* s = CreateTrackedEncoder(&tracker);
+  if (!s) return 0;
+  BrotliEncoderSetParameter(s, BROTLI_PARAM_QUALITY, (uint32_t)quality);
+  BrotliEncoderSetParameter(s, BROTLI_PARAM_LGWIN, (uint32_t)lgwin);
+  BrotliEncoderCompressStream(s, BROTLI_OPERATION_FLUSH,
+      &available_in, &next_in, &available_out, NULL, NULL);
+  result = tracker.current;
+  BrotliEncoderDestroyInstance(s);
+  return result;
+}
+*/
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// memoryTracker counts bytes allocated by native instances created with it.
+// It lives in C memory, because C code keeps a pointer to it.
+type memoryTracker struct {
+	c *C.MemoryTracker
+}
+
+func newMemoryTracker() memoryTracker {
+	c := (*C.MemoryTracker)(C.calloc(1, C.size_t(unsafe.Sizeof(C.MemoryTracker{}))))
+	return memoryTracker{c: c}
+}
+
+func (t memoryTracker) current() int64 { return int64(t.c.current) }
+func (t memoryTracker) peak() int64    { return int64(t.c.peak) }
+
+// free releases the tracker; instances created with it must be destroyed
+// beforehand.
+func (t memoryTracker) free() { C.free(unsafe.Pointer(t.c)) }
+
+func (t memoryTracker) newDecoder() *C.BrotliDecoderState {
+	return C.CreateTrackedDecoder(t.c)
+}
+
+func (t memoryTracker) newEncoder() *C.BrotliEncoderState {
+	return C.CreateTrackedEncoder(t.c)
+}
+
+// decoderStateSize is the amount of memory allocated by a freshly created
+// decoder instance.
+var decoderStateSize = func() int64 {
+	t := newMemoryTracker()
+	defer t.free()
+	s := t.newDecoder()
+	size := t.current()
+	C.BrotliDecoderDestroyInstance(s)
+	return size
+}()
+
+const (
+	// ringBufferSlack is the amount of extra memory the decoder allocates
+	// after the ring buffer (kRingBufferWriteAheadSlack in C-Brotli).
+	ringBufferSlack = 542
+	// decoderTables is the memory taken by Huffman tables and context maps
+	// of a meta-block with the maximal number (256) of literal trees, which
+	// C-Brotli produces at qualities 5 to 9 with windows of 256KiB or more.
+	decoderTables = 650 << 10
+)
+
+var encoderBaseCache sync.Map // encoderParams -> int64
+
+type encoderParams struct {
+	quality, lgwin int
+}
+
+// encoderBase returns the memory used by the state and hasher of an encoder;
+// measured once per parameter set with a throwaway instance.
+func encoderBase(p encoderParams) int64 {
+	if v, ok := encoderBaseCache.Load(p); ok {
+		return v.(int64)
+	}
+	v := int64(C.MeasureEncoderBase(C.int(p.quality), C.int(p.lgwin)))
+	encoderBaseCache.Store(p, v)
+	return v
+}
+
+// EstimateEncoderMemory returns the estimated peak amount of native memory, in
+// bytes, used by a Writer configured with options, not counting the memory
+// held by Go (input and output buffers).
+//
+// The state and the hasher are measured exactly by instrumenting a throwaway
+// encoder instance (the result is cached per quality and window size). The
+// ring buffer and the per-meta-block buffers are computed from the window and
+// input block sizes that C-Brotli derives from quality and window size.
+// The latter depend on the content; for text-like streams longer than the
+// window the estimate is within 25% of measured usage, and it is usually an
+// overestimate for poorly compressible content. Shorter streams use less.
+//
+// The memory used by an attached dictionary is not included.
+func EstimateEncoderMemory(options WriterOptions) int64 {
+	q := options.Quality
+	if q < 0 {
+		q = 0
+	} else if q > 11 {
+		q = 11
+	}
+	lgwin := options.windowBits()
+	if lgwin < C.BROTLI_MIN_WINDOW_BITS {
+		lgwin = C.BROTLI_MIN_WINDOW_BITS
+	} else if lgwin > C.BROTLI_MAX_WINDOW_BITS {
+		lgwin = C.BROTLI_MAX_WINDOW_BITS
+	}
+	base := encoderBase(encoderParams{q, lgwin})
+	window := int64(1) << uint(lgwin)
+
+	// Qualities 0 and 1 keep the window twice and use a hash table that is
+	// allocated on first input; quality 1 adds command and literal buffers.
+	switch q {
+	case 0:
+		return base + 2*window + 4*min(1<<15, window)
+	case 1:
+		return base + 2*window + 9*min(1<<17, window)
+	}
+
+	// See ComputeLgBlock, ComputeRbBits and MaxMetablockSize in C-Brotli.
+	lgblock := 16
+	if q < 4 {
+		lgblock = 14
+	} else if q >= 9 && lgwin > 16 {
+		lgblock = min(18, lgwin)
+	}
+	rbBits := 1 + max(lgwin, lgblock)
+	block := int64(1) << uint(lgblock)
+	ringBuffer := int64(1)<<uint(rbBits) + block
+	metaBlock := int64(1) << uint(min(rbBits, 24))
+
+	var buffers int64
+	switch {
+	case q < 4:
+		// Commands are emitted per input block.
+		buffers = 20 * block
+	case q == 4:
+		buffers = 2*metaBlock + (4500 << 10)
+	case q < 10:
+		// Commands, storage and block splitter histograms.
+		buffers = 2*metaBlock + (1300 << 10)
+	default:
+		// Zopfli nodes for the current input block peak separately from
+		// meta-block commands and histograms.
+		zopfli := 32 * block
+		if q == 11 {
+			zopfli = 64 * block
+		}
+		buffers = max(7*metaBlock/2, zopfli+metaBlock)
+	}
+	return base + ringBuffer + buffers
+}
+
+// EstimateDecoderMemory returns the estimated peak amount of native memory, in
+// bytes, used by a decoder for a stream with the given window size, not
+// counting the memory held by Go (input and output buffers).
+//
+// The bulk of decoder memory is the ring buffer, which is 2^windowBits bytes
+// for streams longer than the window; shorter streams use less. The rest
+// depends on the encoder and ranges from 20KiB to a few MiB; the estimate
+// allows 650KiB for it. For windows of 1MiB or more the estimate is within 25%
+// of measured usage for streams produced by C-Brotli; for smaller windows it
+// is usually an overestimate. windowBits is clamped to the range of large
+// windows, 10 to 30.
+func EstimateDecoderMemory(windowBits int) int64 {
+	if windowBits < C.BROTLI_MIN_WINDOW_BITS {
+		windowBits = C.BROTLI_MIN_WINDOW_BITS
+	} else if windowBits > C.BROTLI_LARGE_MAX_WINDOW_BITS {
+		windowBits = C.BROTLI_LARGE_MAX_WINDOW_BITS
+	}
+	return decoderStateSize + (int64(1) << uint(windowBits)) + ringBufferSlack +
+		decoderTables
+}
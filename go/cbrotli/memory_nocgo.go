@@ -0,0 +1,36 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !cgo
+
+package cbrotli
+
+// EstimateEncoderMemory returns 0: without cgo, there is no encoder.
+func EstimateEncoderMemory(options WriterOptions) int64 {
+	return 0
+}
+
+const (
+	// decoderBuffers is the memory taken by the input buffers and the
+	// distance tables of the pure-Go decoder.
+	decoderBuffers = 80 << 10
+	// decoderTables is the memory taken by Huffman tables and context maps
+	// of a meta-block with the maximal number (256) of literal trees.
+	decoderTables = 650 << 10
+
+	// The range of window sizes, including large windows.
+	decoderMinWindowBits = 10
+	decoderMaxWindowBits = 30
+)
+
+// EstimateDecoderMemory returns the estimated peak amount of memory, in bytes,
+// used by the pure-Go decoder for a stream with the given window size, not
+// counting the buffers of the Reader: the ring buffer, which is 2^windowBits
+// bytes for streams longer than the window, and an allowance for the rest.
+// windowBits is clamped to the range of large windows, 10 to 30.
+func EstimateDecoderMemory(windowBits int) int64 {
+	windowBits = min(max(windowBits, decoderMinWindowBits), decoderMaxWindowBits)
+	return (int64(1) << uint(windowBits)) + decoderBuffers + decoderTables
+}
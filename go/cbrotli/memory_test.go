@@ -0,0 +1,94 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+// textLikeData returns compressible data with some noise.
+func textLikeData(size int) []byte {
+	rng := rand.New(rand.NewSource(1))
+	words := []string{"hello ", "world ", "brotli ", "compression ", "<html>",
+		"</div>", "lorem ", "ipsum "}
+	var buf bytes.Buffer
+	for buf.Len() < size {
+		if rng.Intn(10) == 0 {
+			b := make([]byte, 20)
+			rng.Read(b)
+			buf.Write(b)
+		}
+		buf.WriteString(words[rng.Intn(len(words))])
+	}
+	return buf.Bytes()[:size]
+}
+
+func checkEstimate(t *testing.T, what string, measured, estimated int64) {
+	t.Helper()
+	ratio := float64(estimated) / float64(measured)
+	if ratio < 0.75 || ratio > 1.25 {
+		t.Errorf("%s: measured %d, estimated %d (ratio %.2f)", what, measured,
+			estimated, ratio)
+	}
+}
+
+func TestEstimateEncoderMemory(t *testing.T) {
+	for _, lgwin := range []int{18, 20} {
+		input := textLikeData(4 << uint(lgwin))
+		for _, quality := range []int{0, 1, 2, 4, 5, 9, 10} {
+			if quality >= 10 && lgwin > 18 {
+				continue // too slow
+			}
+			options := WriterOptions{Quality: quality, LGWin: lgwin}
+			tracker := newMemoryTracker()
+			w := &Writer{}
+			if err := w.init(tracker.newEncoder(), io.Discard, options); err != nil {
+				t.Fatalf("init: %v", err)
+			}
+			if _, err := w.Write(input); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+			checkEstimate(t, fmt.Sprintf("encoder %+v", options), tracker.peak(),
+				EstimateEncoderMemory(options))
+			tracker.free()
+		}
+	}
+}
+
+func TestEstimateDecoderMemory(t *testing.T) {
+	for _, lgwin := range []int{20, 22} {
+		input := textLikeData(4 << uint(lgwin))
+		for _, quality := range []int{1, 5, 9} {
+			options := WriterOptions{Quality: quality, LGWin: lgwin}
+			encoded, err := Encode(input, options)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			tracker := newMemoryTracker()
+			r := &Reader{
+				src:   bytes.NewReader(encoded),
+				state: tracker.newDecoder(),
+				buf:   make([]byte, readBufSize),
+			}
+			if _, err := io.Copy(io.Discard, r); err != nil {
+				t.Fatalf("Copy: %v", err)
+			}
+			r.Close()
+			checkEstimate(t, fmt.Sprintf("decoder %+v", options), tracker.peak(),
+				EstimateDecoderMemory(lgwin))
+			tracker.free()
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import "sync/atomic"
+
+// MetricsSnapshot holds the package counters; see EnableMetrics. Counters only
+// cover activity since metrics were enabled.
+type MetricsSnapshot struct {
+	// ReadersOpened and ReadersClosed count Readers, including those used by
+	// the Decode functions.
+	ReadersOpened, ReadersClosed int64
+	// WriterStreamsStarted and WriterStreamsEnded count encoder instances of
+	// Writers: each Writer and each reset starts a stream, and Close or a
+	// reset ends it.
+	WriterStreamsStarted, WriterStreamsEnded int64
+	// DecoderBytesIn and DecoderBytesOut are the compressed bytes consumed and
+	// the decompressed bytes produced by decoders.
+	DecoderBytesIn, DecoderBytesOut int64
+	// EncoderBytesIn and EncoderBytesOut are the uncompressed bytes consumed
+	// and the compressed bytes produced by encoders, including one-shot
+	// encoding by Encode.
+	EncoderBytesIn, EncoderBytesOut int64
+	// Decoding errors by category, counted once per Reader: ErrTruncated,
+	// DecoderError, errors of the source, and other failures (e.g. trailing
+	// input or failed dictionary resolution).
+	TruncatedErrors, DecoderErrors, SourceErrors, OtherReaderErrors int64
+	// Encoding errors, counted once per stream: EncoderError and errors of
+	// the destination.
+	EncoderErrors, DestinationErrors int64
+	// PoolHits and PoolMisses count the reuses and the allocations of the
+	// Writers pooled by Encode and Encoder, and of the Readers of Decoder.
+	PoolHits, PoolMisses int64
+}
+
+var (
+	metricsEnabled atomic.Bool
+	metrics        struct {
+		readersOpened, readersClosed                                    atomic.Int64
+		writerStreamsStarted, writerStreamsEnded                        atomic.Int64
+		decoderBytesIn, decoderBytesOut                                 atomic.Int64
+		encoderBytesIn, encoderBytesOut                                 atomic.Int64
+		truncatedErrors, decoderErrors, sourceErrors, otherReaderErrors atomic.Int64
+		encoderErrors, destinationErrors                                atomic.Int64
+		poolHits, poolMisses                                            atomic.Int64
+	}
+)
+
+// EnableMetrics starts maintaining the package counters returned by Metrics.
+// They are disabled by default, and then cost a single atomic load per update
+// site; once enabled, they stay enabled. Counters are updated with atomic
+// operations only, so they are cheap enough for production use.
+func EnableMetrics() {
+	metricsEnabled.Store(true)
+}
+
+// Metrics returns the current values of the package counters. The counters are
+// read one by one, so a snapshot taken while streams are active may be
+// slightly inconsistent.
+func Metrics() MetricsSnapshot {
+	return MetricsSnapshot{
+		ReadersOpened:        metrics.readersOpened.Load(),
+		ReadersClosed:        metrics.readersClosed.Load(),
+		WriterStreamsStarted: metrics.writerStreamsStarted.Load(),
+		WriterStreamsEnded:   metrics.writerStreamsEnded.Load(),
+		DecoderBytesIn:       metrics.decoderBytesIn.Load(),
+		DecoderBytesOut:      metrics.decoderBytesOut.Load(),
+		EncoderBytesIn:       metrics.encoderBytesIn.Load(),
+		EncoderBytesOut:      metrics.encoderBytesOut.Load(),
+		TruncatedErrors:      metrics.truncatedErrors.Load(),
+		DecoderErrors:        metrics.decoderErrors.Load(),
+		SourceErrors:         metrics.sourceErrors.Load(),
+		OtherReaderErrors:    metrics.otherReaderErrors.Load(),
+		EncoderErrors:        metrics.encoderErrors.Load(),
+		DestinationErrors:    metrics.destinationErrors.Load(),
+		PoolHits:             metrics.poolHits.Load(),
+		PoolMisses:           metrics.poolMisses.Load(),
+	}
+}
+
+// count adds delta to counter if metrics are enabled.
+func count(counter *atomic.Int64, delta int64) {
+	if metricsEnabled.Load() {
+		counter.Add(delta)
+	}
+}
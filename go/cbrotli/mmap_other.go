@@ -0,0 +1,20 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !unix
+
+package cbrotli
+
+import "errors"
+
+var errMapUnsupported = errors.New("cbrotli: memory-mapped dictionaries are not supported on this platform")
+
+func mapFile(path string) ([]byte, error) {
+	return nil, errMapUnsupported
+}
+
+func unmapFile(data []byte) error {
+	return nil
+}
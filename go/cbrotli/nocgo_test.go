@@ -0,0 +1,114 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !cgo
+
+package cbrotli_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/brotli/go/cbrotli"
+)
+
+func TestNotSupported(t *testing.T) {
+	if _, err := cbrotli.Encode([]byte("hello"), cbrotli.WriterOptions{Quality: 5}); err != cbrotli.ErrNotSupported {
+		t.Errorf("Encode: %v", err)
+	}
+	if _, err := cbrotli.Encode(nil, cbrotli.WriterOptions{Quality: 12}); err == nil || err == cbrotli.ErrNotSupported {
+		t.Errorf("Encode with an invalid quality: %v", err)
+	}
+	var buf bytes.Buffer
+	w := cbrotli.NewWriter(&buf, cbrotli.WriterOptions{Quality: 5})
+	if _, err := w.Write([]byte("hello")); err != cbrotli.ErrNotSupported {
+		t.Errorf("Write: %v", err)
+	}
+	if err := w.Close(); err != cbrotli.ErrNotSupported {
+		t.Errorf("Close: %v", err)
+	}
+	if _, err := w.Write(nil); err != cbrotli.ErrWriterClosed {
+		t.Errorf("Write after Close: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Writer wrote %d bytes", buf.Len())
+	}
+	if v := cbrotli.Version(); v != "" {
+		t.Errorf("Version() = %q", v)
+	}
+}
+
+func TestSerializedDictionaryNoCgo(t *testing.T) {
+	dictionary := wordSoup(11, 100000)
+	input := dictionary[5000:25000]
+	encoded := testStream(t, "dictionary.br")
+	serialized, err := cbrotli.BuildSerializedDictionary(dictionary, cbrotli.SerializedDictionaryOptions{})
+	if err != nil {
+		t.Fatalf("BuildSerializedDictionary: %v", err)
+	}
+
+	// Without custom words, the serialized dictionary decodes like its raw
+	// dictionary.
+	d, err := cbrotli.NewSerializedDecoderDictionary(serialized)
+	if err != nil {
+		t.Fatalf("NewSerializedDecoderDictionary: %v", err)
+	}
+	defer d.Close()
+	if d.ID() != cbrotli.DictionaryID(serialized) {
+		t.Error("ID is not that of the serialized data")
+	}
+	r := cbrotli.NewReaderWithDecoderDictionary(bytes.NewReader(encoded), d)
+	if decoded, err := io.ReadAll(r); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("NewReaderWithDecoderDictionary: decoded %d bytes, %v", len(decoded), err)
+	}
+	r.Close()
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Dictionaries: []cbrotli.Dictionary{{Data: serialized, Type: cbrotli.DtSerialized}},
+	})
+	if decoded, err := io.ReadAll(r); err != nil || !bytes.Equal(decoded, input) {
+		t.Errorf("Dictionaries: decoded %d bytes, %v", len(decoded), err)
+	}
+	r.Close()
+
+	withWords, err := cbrotli.BuildSerializedDictionary(dictionary, cbrotli.SerializedDictionaryOptions{
+		Words: [][]byte{[]byte("zyxwvutsrq")},
+	})
+	if err != nil {
+		t.Fatalf("BuildSerializedDictionary with words: %v", err)
+	}
+	if _, err := cbrotli.NewSerializedDecoderDictionary(withWords); !errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Errorf("NewSerializedDecoderDictionary with words: %v", err)
+	}
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Dictionaries: []cbrotli.Dictionary{{Data: withWords, Type: cbrotli.DtSerialized}},
+	})
+	if _, err := r.Read(make([]byte, 10)); !errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Errorf("Dictionaries with words: %v", err)
+	}
+	r.Close()
+
+	// Malformed data fails as with cgo.
+	if _, err := cbrotli.NewSerializedDecoderDictionary([]byte("dictionary")); err == nil || errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Errorf("NewSerializedDecoderDictionary of malformed data: %v", err)
+	}
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Dictionaries: []cbrotli.Dictionary{{Data: []byte("dictionary"), Type: cbrotli.DtSerialized}},
+	})
+	if _, err := r.Read(make([]byte, 10)); err == nil || errors.Is(err, cbrotli.ErrNotSupported) {
+		t.Errorf("Dictionaries with malformed data: %v", err)
+	}
+	r.Close()
+
+	r = cbrotli.NewReaderWithOptions(bytes.NewReader(encoded), cbrotli.ReaderOptions{
+		Dictionary:      d,
+		OnBlockBoundary: func(int64, int64, cbrotli.BlockInfo) {},
+	})
+	if _, err := r.Read(make([]byte, 10)); err == nil {
+		t.Error("OnBlockBoundary accepted a serialized dictionary")
+	}
+	r.Close()
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"io"
+	"runtime"
+)
+
+// defaultChunkSize is the ParallelWriter chunk size if ChunkSize is not set;
+// it is raised to the window size for large windows.
+const defaultChunkSize = 4 << 20
+
+// ParallelWriter implements io.WriteCloser like Writer, but compresses chunks of
+// input concurrently.
+//
+// Chunks are encoded by independent encoder instances and stitched into a
+// single stream with the StreamOffset mechanism (see CloseAppendable), so the
+// output is an ordinary Brotli stream decodable by Reader. The price is that
+// matches across chunk boundaries are lost, as if the window were emptied at
+// the start of each chunk; with chunks of several windows this typically costs
+// a few percent of ratio. Chunk size is set with WriterOptions.ChunkSize.
+//
+// WriterOptions.FlushInterval, WriterOptions.WriteBufferSize,
+// WriterOptions.SelectDictionary, WriterOptions.MaxOutputBytes and
+// WriterOptions.Progress are ignored.
+type ParallelWriter struct {
+	dst     io.Writer
+	options WriterOptions
+	chunk   int
+	offset  int64 // uncompressed offset of buf within the stream
+	buf     []byte
+	sem     chan struct{}
+	pending []*parallelChunk // submitted chunks, in stream order
+	err     error            // first error; sticky
+	closed  bool
+}
+
+type parallelChunk struct {
+	done chan struct{}
+	out  []byte
+	err  error
+}
+
+// NewParallelWriter initializes new ParallelWriter instance that encodes with
+// up to concurrency goroutines; if concurrency is not positive, GOMAXPROCS is
+// used. Close MUST be called to wait for the workers.
+func NewParallelWriter(dst io.Writer, options WriterOptions, concurrency int) *ParallelWriter {
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	p := &ParallelWriter{dst: dst, options: options}
+	if p.err = options.validate(); p.err != nil {
+		return p
+	}
+	// All parts of a stream must use the same window.
+	p.options.LGWin = options.windowBits()
+	p.options.FlushInterval = 0
+	p.options.WriteBufferSize = 0
+	p.options.PipelineDepth = 0
+	p.options.MaxOutputBytes = 0
+	p.options.SelectDictionary = nil
+	p.options.Progress = nil
+	p.chunk = options.ChunkSize
+	if p.chunk == 0 {
+		p.chunk = max(defaultChunkSize, 1<<uint(p.options.LGWin))
+	}
+	p.offset = options.StreamOffset
+	p.sem = make(chan struct{}, concurrency)
+	return p
+}
+
+// Write implements io.Writer. Full chunks are handed to workers; Flush or
+// Close must be called to ensure that the encoded bytes are actually written
+// to the underlying Writer.
+func (p *ParallelWriter) Write(data []byte) (n int, err error) {
+	if p.closed {
+		return 0, ErrWriterClosed
+	}
+	if p.err != nil {
+		return 0, p.err
+	}
+	for len(data) > 0 {
+		if p.buf == nil {
+			p.buf = make([]byte, 0, p.chunk)
+		}
+		m := min(len(data), p.chunk-len(p.buf))
+		p.buf = append(p.buf, data[:m]...)
+		data = data[m:]
+		n += m
+		if len(p.buf) == p.chunk {
+			if err = p.submit(false); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// submit starts encoding of the buffered data; the last chunk finishes the
+// stream. While more chunks than workers are waiting for output, completed
+// chunks are written to dst.
+func (p *ParallelWriter) submit(last bool) error {
+	c := &parallelChunk{done: make(chan struct{})}
+	options := p.options
+	options.StreamOffset = p.offset
+	options.SizeHint = len(p.buf)
+	input := p.buf
+	p.offset += int64(len(input))
+	p.buf = nil
+	p.sem <- struct{}{}
+	go func() {
+		defer func() { <-p.sem }()
+		c.out, c.err = encodeChunk(input, options, last)
+		close(c.done)
+	}()
+	p.pending = append(p.pending, c)
+	// Keep memory bounded: at most one finished chunk per worker waits.
+	return p.drain(cap(p.sem))
+}
+
+// encodeChunk encodes a part of a stream that starts at options.StreamOffset.
+func encodeChunk(input []byte, options WriterOptions, last bool) ([]byte, error) {
+	b := NewBufferWriter(options)
+	_, err := b.Write(input)
+	var closeErr error
+	if last {
+		closeErr = b.Close()
+	} else {
+		closeErr = b.w.CloseAppendable()
+	}
+	if err == nil {
+		err = closeErr
+	}
+	return b.out.buf, err
+}
+
+// drain writes the output of completed chunks to dst, in order, until at most
+// keep chunks are pending.
+func (p *ParallelWriter) drain(keep int) error {
+	for len(p.pending) > keep {
+		c := p.pending[0]
+		<-c.done
+		p.pending[0] = nil
+		p.pending = p.pending[1:]
+		if p.err != nil {
+			continue
+		}
+		if c.err != nil {
+			p.err = c.err
+			continue
+		}
+		p.err = p.writeOutput(c.out)
+	}
+	return p.err
+}
+
+// writeOutput writes the output of a chunk to dst, as Writer.writeOutput does.
+func (p *ParallelWriter) writeOutput(output []byte) error {
+	for len(output) > 0 {
+		m, err := p.dst.Write(output)
+		if err != nil {
+			return err
+		}
+		if m <= 0 || m > len(output) {
+			return io.ErrShortWrite
+		}
+		output = output[m:]
+	}
+	return nil
+}
+
+// Flush encodes the buffered input and waits for all chunks to be written to
+// the underlying Writer. The resulting output can be decoded to match all input
+// before Flush, but the stream is not yet complete.
+func (p *ParallelWriter) Flush() error {
+	if p.closed {
+		return ErrWriterClosed
+	}
+	if p.err != nil {
+		return p.err
+	}
+	if len(p.buf) != 0 {
+		if err := p.submit(false); err != nil {
+			return err
+		}
+	}
+	return p.drain(0)
+}
+
+// Close completes the stream and waits for the workers. It returns the first
+// error reported by a worker or by the underlying Writer.
+func (p *ParallelWriter) Close() error {
+	if p.closed {
+		return ErrWriterClosed
+	}
+	p.closed = true
+	if p.err == nil {
+		// The last chunk can be empty; it only carries the end of the stream.
+		p.submit(true)
+	}
+	return p.drain(0)
+}
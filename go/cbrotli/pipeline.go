@@ -0,0 +1,116 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import "sync"
+
+// pipeline runs the encoder of a Writer in a background goroutine, for
+// WriterOptions.PipelineDepth. While writes are pending, the goroutine owns
+// the state of the Writer; the methods holding w.mu only enqueue writes, and
+// wait for the queue to drain before they touch anything else.
+type pipeline struct {
+	queue   chan []byte // copies of pending writes
+	free    chan []byte // buffers of processed writes, for reuse
+	pending sync.WaitGroup
+	done    chan struct{} // closed when the goroutine returns; nil until started
+
+	mu  sync.Mutex
+	err error // first error of the goroutine; later writes are dropped
+}
+
+func newPipeline(depth int) *pipeline {
+	return &pipeline{
+		queue: make(chan []byte, depth),
+		// The queue, the write being encoded and the one being copied.
+		free: make(chan []byte, depth+2),
+	}
+}
+
+func (pipe *pipeline) failure() error {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+	return pipe.err
+}
+
+func (pipe *pipeline) fail(err error) {
+	pipe.mu.Lock()
+	defer pipe.mu.Unlock()
+	if pipe.err == nil {
+		pipe.err = err
+	}
+}
+
+// enqueue queues a copy of p for the goroutine, starting it if needed; it
+// blocks while the queue is full. The caller holds w.mu.
+func (w *Writer) enqueue(p []byte) (n int, err error) {
+	pipe := w.pipe
+	if err := pipe.failure(); err != nil {
+		return 0, err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if pipe.done == nil {
+		pipe.done = make(chan struct{})
+		go w.runPipeline(pipe)
+	}
+	var buf []byte
+	select {
+	case buf = <-pipe.free:
+	default:
+	}
+	buf = append(buf[:0], p...)
+	pipe.pending.Add(1)
+	pipe.queue <- buf
+	return len(p), nil
+}
+
+// runPipeline encodes the queued writes until the queue is closed.
+func (w *Writer) runPipeline(pipe *pipeline) {
+	defer close(pipe.done)
+	for p := range pipe.queue {
+		if pipe.failure() == nil {
+			n, err := w.consume(p)
+			if n > 0 {
+				// A restart of the encoder may have flushed the stream.
+				w.unflushed.Store(true)
+			}
+			if err != nil {
+				pipe.fail(err)
+			}
+		}
+		pipe.free <- p
+		pipe.pending.Done()
+	}
+}
+
+// syncPipeline waits until the queued writes are encoded, and returns the
+// error of the goroutine, if any. Afterwards the caller, which holds w.mu, has
+// the state of the Writer to itself.
+func (w *Writer) syncPipeline() error {
+	if w.pipe == nil {
+		return nil
+	}
+	w.pipe.pending.Wait()
+	return w.pipe.failure()
+}
+
+// stopPipeline waits until the queued writes are encoded, stops the goroutine
+// and returns its error; the Writer then works synchronously.
+func (w *Writer) stopPipeline() error {
+	pipe := w.pipe
+	if pipe == nil {
+		return nil
+	}
+	w.pipe = nil
+	close(pipe.queue)
+	if pipe.done != nil {
+		<-pipe.done
+	}
+	return pipe.failure()
+}
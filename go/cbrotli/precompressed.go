@@ -0,0 +1,227 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// PrecompressedOptions configures NewPrecompressedHandler.
+type PrecompressedOptions struct {
+	// Encodings lists the content codings of the sidecar files, in the order
+	// of preference among those the client gives the same weight. The
+	// supported codings are "br" (name.br), "gzip" (name.gz) and "zstd"
+	// (name.zst); nil means "br" and "gzip".
+	Encodings []string
+}
+
+var defaultPrecompressedEncodings = []string{"br", "gzip"}
+
+// sidecarSuffixes maps the content codings of NewPrecompressedHandler to the
+// suffixes of their files.
+var sidecarSuffixes = map[string]string{
+	"br":   ".br",
+	"gzip": ".gz",
+	"zstd": ".zst",
+}
+
+// NewPrecompressedHandler returns a handler that serves the files of root
+// like http.FileServer(http.FS(root)), but with the sidecar files compressed
+// ahead of time, e.g. app.js.br and app.js.gz for app.js, to the clients that
+// accept their content coding, as negotiated by NegotiateContentEncoding.
+// The identity file is served when there is no acceptable sidecar, and also
+// when the client refuses all the available codings, including identity;
+// without identity file, such clients get 406 Not Acceptable instead.
+// Index files of directories (index.html) have sidecars too; directory
+// listings and redirects are left to http.FileServer.
+//
+// Responses for files with sidecars have "Vary: Accept-Encoding". A
+// compressed variant has the Content-Type of the identity file, by extension
+// or sniffed from it, and its own strong ETag, so that caches and
+// conditional requests never mix the variants; identity files get ETags as
+// well. Range requests are served per RFC 9110: a range of a compressed
+// variant selects bytes of the compressed file, as Content-Range states.
+//
+// Sidecar files are looked up with the cleaned path that http.FileServer
+// serves, so the handler opens no file that http.FileServer would not.
+//
+// NewPrecompressedHandler panics if options.Encodings lists an unsupported
+// coding.
+func NewPrecompressedHandler(root fs.FS, options PrecompressedOptions) http.Handler {
+	encodings := options.Encodings
+	if encodings == nil {
+		encodings = defaultPrecompressedEncodings
+	}
+	for _, e := range encodings {
+		if _, ok := sidecarSuffixes[e]; !ok {
+			panic(fmt.Sprintf("cbrotli: unsupported sidecar content coding %q", e))
+		}
+	}
+	return &precompressedHandler{
+		root:      root,
+		files:     http.FileServer(http.FS(root)),
+		encodings: encodings,
+	}
+}
+
+type precompressedHandler struct {
+	root      fs.FS
+	files     http.Handler
+	encodings []string
+	digests   sync.Map // name → ETag of files without modification time
+}
+
+func (h *precompressedHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	upath := req.URL.Path
+	if !strings.HasPrefix(upath, "/") {
+		upath = "/" + upath
+	}
+	// http.FileServer redirects these to the directory.
+	if strings.HasSuffix(upath, "/index.html") {
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+	name := strings.TrimPrefix(path.Clean(upath), "/")
+	if name == "" {
+		name = "."
+	}
+	if !fs.ValidPath(name) {
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+	info, err := fs.Stat(h.root, name)
+	switch {
+	case err != nil:
+		info = nil
+	case info.IsDir():
+		if !strings.HasSuffix(upath, "/") {
+			h.files.ServeHTTP(rw, req)
+			return
+		}
+		name = path.Join(name, "index.html")
+		if info, err = fs.Stat(h.root, name); err != nil {
+			info = nil
+		}
+	case strings.HasSuffix(upath, "/"):
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+	if info != nil && !info.Mode().IsRegular() {
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+
+	var offered []string
+	for _, e := range h.encodings {
+		if si, err := fs.Stat(h.root, name+sidecarSuffixes[e]); err == nil && si.Mode().IsRegular() {
+			offered = append(offered, e)
+		}
+	}
+	if len(offered) == 0 {
+		if info != nil {
+			h.setETag(rw, name, info, "")
+		}
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+	rw.Header().Add("Vary", "Accept-Encoding")
+	encoding := NegotiateContentEncoding(req, offered)
+	if encoding == "" || encoding == "identity" {
+		if info == nil {
+			// No identity file to fall back to.
+			http.Error(rw, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+			return
+		}
+		h.setETag(rw, name, info, "")
+		h.files.ServeHTTP(rw, req)
+		return
+	}
+	h.serveSidecar(rw, req, name, encoding, info)
+}
+
+// serveSidecar serves the sidecar of name compressed with encoding; info is
+// the FileInfo of the identity file, nil if there is none.
+func (h *precompressedHandler) serveSidecar(rw http.ResponseWriter, req *http.Request, name, encoding string, info fs.FileInfo) {
+	sidecar := name + sidecarSuffixes[encoding]
+	f, err := h.root.Open(sidecar)
+	if err != nil {
+		http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	si, err := f.Stat()
+	if err != nil {
+		http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		http.Error(rw, "cbrotli: sidecar file is not seekable", http.StatusInternalServerError)
+		return
+	}
+	header := rw.Header()
+	header.Set("Content-Type", h.contentType(name, info))
+	header.Set("Content-Encoding", encoding)
+	h.setETag(rw, sidecar, si, encoding)
+	http.ServeContent(rw, req, name, si.ModTime(), content)
+}
+
+// contentType returns the media type of the identity file name, by its
+// extension or sniffed from its content as http.ServeContent would do.
+func (h *precompressedHandler) contentType(name string, info fs.FileInfo) string {
+	if ctype := mime.TypeByExtension(path.Ext(name)); ctype != "" {
+		return ctype
+	}
+	if info != nil {
+		if f, err := h.root.Open(name); err == nil {
+			defer f.Close()
+			var buf [512]byte
+			n, _ := io.ReadFull(f, buf[:])
+			return http.DetectContentType(buf[:n])
+		}
+	}
+	return "application/octet-stream"
+}
+
+// setETag sets the strong ETag of the file name, a variant if encoding is not
+// empty, unless the file cannot be read. The ETag derives from the
+// modification time and the size of the file or, if the file has no
+// modification time (e.g. in an embed.FS), from its SHA-256 digest, which is
+// computed once.
+func (h *precompressedHandler) setETag(rw http.ResponseWriter, name string, info fs.FileInfo, encoding string) {
+	var tag string
+	if mtime := info.ModTime(); !mtime.IsZero() {
+		tag = strconv.FormatInt(mtime.UnixNano(), 36) + "-" + strconv.FormatInt(info.Size(), 36)
+	} else if v, ok := h.digests.Load(name); ok {
+		tag = v.(string)
+	} else {
+		f, err := h.root.Open(name)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		d := sha256.New()
+		if _, err := io.Copy(d, f); err != nil {
+			return
+		}
+		tag = base64.RawURLEncoding.EncodeToString(d.Sum(nil)[:12])
+		h.digests.Store(name, tag)
+	}
+	if encoding != "" {
+		tag += "-" + encoding
+	}
+	rw.Header().Set("Etag", `"`+tag+`"`)
+}
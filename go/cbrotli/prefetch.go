@@ -0,0 +1,106 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import "io"
+
+// prefetcher reads src in a background goroutine into a ring of buffers, for
+// ReaderOptions.PrefetchBuffers. Read returns the data and the errors of src
+// in the order src returned them.
+type prefetcher struct {
+	src   io.Reader
+	full  chan prefetchChunk // chunks read from src, in order
+	free  chan []byte        // buffers consumed by Read
+	quit  chan struct{}      // closed by close
+	chunk prefetchChunk      // the chunk being consumed by Read
+}
+
+type prefetchChunk struct {
+	buf  []byte // the buffer holding data
+	data []byte // the rest of the data read from src
+	err  error  // the error returned with the data
+}
+
+func newPrefetcher(src io.Reader, count, size int) *prefetcher {
+	f := &prefetcher{
+		src:  src,
+		full: make(chan prefetchChunk, count),
+		free: make(chan []byte, count),
+		quit: make(chan struct{}),
+	}
+	for i := 0; i < count; i++ {
+		f.free <- make([]byte, size)
+	}
+	go f.run()
+	return f
+}
+
+// run reads src until it fails (or returns io.EOF), or close is called.
+func (f *prefetcher) run() {
+	for {
+		var buf []byte
+		select {
+		case buf = <-f.free:
+		case <-f.quit:
+			return
+		}
+		n, err := f.src.Read(buf)
+		select {
+		case f.full <- prefetchChunk{buf: buf, data: buf[:n], err: err}:
+		case <-f.quit:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Read implements io.Reader. The error of src is returned once its data is
+// consumed, and then by all calls.
+func (f *prefetcher) Read(p []byte) (int, error) {
+	if len(f.chunk.data) == 0 && f.chunk.err == nil {
+		if f.chunk.buf != nil {
+			// There is room: the goroutine has taken the buffer from free.
+			f.free <- f.chunk.buf
+		}
+		f.chunk = <-f.full
+	}
+	n := copy(p, f.chunk.data)
+	f.chunk.data = f.chunk.data[n:]
+	if len(f.chunk.data) == 0 {
+		return n, f.chunk.err
+	}
+	return n, nil
+}
+
+// close stops the goroutine; a read of src in progress is not interrupted, but
+// its result is dropped.
+func (f *prefetcher) close() {
+	close(f.quit)
+}
+
+// startPrefetch makes the Reader read src through a prefetcher, if
+// options.PrefetchBuffers is set.
+func (r *Reader) startPrefetch() {
+	if r.options.PrefetchBuffers <= 0 {
+		return
+	}
+	size := r.options.PrefetchBufferSize
+	if size <= 0 {
+		size = readBufSize
+	}
+	r.prefetch = newPrefetcher(r.src, r.options.PrefetchBuffers, size)
+	r.src = r.prefetch
+}
+
+// stopPrefetch stops the prefetcher, if any.
+func (r *Reader) stopPrefetch() {
+	if r.prefetch != nil {
+		r.prefetch.close()
+		r.prefetch = nil
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ProxyOptions configures RecompressResponse and NewRecompressingTransport.
+type ProxyOptions struct {
+	// Writer configures the compression of responses.
+	Writer WriterOptions
+	// MinSize is the size of the smallest uncompressed response with a
+	// Content-Length that is compressed; 0 means 1KiB. gzip responses and
+	// those of unknown length are always recompressed.
+	MinSize int64
+	// ContentTypes lists the media types of the responses to compress, as
+	// HTTPOptions.ContentTypes does; nil means the same default. Responses
+	// without Content-Type are passed through.
+	ContentTypes []string
+}
+
+// RecompressResponse returns a function for the ModifyResponse field of
+// httputil.ReverseProxy that upgrades the responses of upstreams that only
+// emit gzip or identity to Brotli, for clients that accept "br": the
+// Accept-Encoding of the client is forwarded upstream as it is, and a
+// response with Content-Encoding "gzip" or none, and a Content-Type listed in
+// options.ContentTypes, gets a Body that decodes the gzip data, if any, and
+// compresses the content with Brotli. The response then has Content-Encoding
+// "br", no Content-Length, Vary listing Accept-Encoding once, and its ETag,
+// if strong, made weak, as the new representation has other bytes.
+//
+// The body is transcoded while it is read, never buffered whole: the
+// compressed bytes of each read of the upstream body are flushed, so that
+// ReverseProxy delivers a streamed upstream response (e.g. server-sent
+// events) as incrementally as it arrives. Trailers are kept: the upstream
+// body is read to its end before the Brotli stream ends. Invalid gzip data
+// fails the body with a GzipError.
+//
+// Other responses, including those to HEAD requests, partial content and
+// those without body, are passed through. RecompressResponse panics if
+// options.Writer is invalid.
+func RecompressResponse(options ProxyOptions) func(*http.Response) error {
+	if err := options.Writer.validate(); err != nil {
+		panic(err)
+	}
+	if options.MinSize <= 0 {
+		options.MinSize = defaultHTTPMinSize
+	}
+	if options.ContentTypes == nil {
+		options.ContentTypes = defaultHTTPContentTypes
+	}
+	return func(resp *http.Response) error {
+		recompress(resp, &options)
+		return nil
+	}
+}
+
+// NewRecompressingTransport returns an http.RoundTripper that sends requests
+// through base (nil means http.DefaultTransport) and modifies the responses
+// like RecompressResponse, for the Transport field of httputil.ReverseProxy
+// when ModifyResponse is taken. It panics if options.Writer is invalid.
+func NewRecompressingTransport(base http.RoundTripper, options ProxyOptions) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	modify := RecompressResponse(options)
+	return roundTripper(func(req *http.Request) (*http.Response, error) {
+		resp, err := base.RoundTrip(req)
+		if err == nil {
+			modify(resp)
+		}
+		return resp, err
+	})
+}
+
+// roundTripper adapts a function to http.RoundTripper.
+type roundTripper func(*http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// recompress replaces the body of resp with its Brotli recompression, if it
+// qualifies; see RecompressResponse.
+func recompress(resp *http.Response, options *ProxyOptions) {
+	req := resp.Request
+	if req == nil || req.Method == http.MethodHead || !bodyAllowed(resp.StatusCode) ||
+		resp.StatusCode == http.StatusPartialContent || resp.Header.Get("Content-Range") != "" {
+		return
+	}
+	if !WantsBrotli(req) {
+		return
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType == "" || !matchContentType(contentType, options.ContentTypes) {
+		return
+	}
+	var gzipped bool
+	switch encodings := resp.Header.Values("Content-Encoding"); {
+	case len(encodings) == 0 || len(encodings) == 1 && strings.EqualFold(strings.TrimSpace(encodings[0]), "identity"):
+		if resp.ContentLength >= 0 && resp.ContentLength < options.MinSize {
+			return
+		}
+	case len(encodings) == 1 && (strings.EqualFold(strings.TrimSpace(encodings[0]), "gzip") ||
+		strings.EqualFold(strings.TrimSpace(encodings[0]), "x-gzip")):
+		gzipped = true
+	default:
+		return
+	}
+
+	resp.Body = &recompressingBody{
+		body:    resp.Body,
+		gzipped: gzipped,
+		options: options,
+	}
+	header := resp.Header
+	header.Del("Content-Length")
+	resp.ContentLength = -1
+	header.Set("Content-Encoding", "br")
+	addVary(header, "Accept-Encoding")
+	if etag := header.Get("Etag"); strings.HasPrefix(etag, `"`) {
+		header.Set("Etag", "W/"+etag)
+	}
+}
+
+// addVary adds name to the Vary header, unless it is listed already, or Vary
+// is "*".
+func addVary(header http.Header, name string) {
+	for _, value := range header.Values("Vary") {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "*" || strings.EqualFold(field, name) {
+				return
+			}
+		}
+	}
+	header.Add("Vary", name)
+}
+
+// recompressingBody reads an upstream body, gzipped or not, compressed with
+// Brotli.
+type recompressingBody struct {
+	body    io.ReadCloser
+	gzipped bool
+	options *ProxyOptions
+
+	src io.Reader // content of body, once started
+	w   *Writer
+	out bytes.Buffer // compressed bytes not read yet
+	buf []byte
+	err error // once out is drained
+}
+
+func (b *recompressingBody) Read(p []byte) (int, error) {
+	for b.out.Len() == 0 {
+		if b.err != nil {
+			return 0, b.err
+		}
+		b.fill()
+	}
+	return b.out.Read(p)
+}
+
+// fill compresses the next read of the upstream body, and flushes it.
+func (b *recompressingBody) fill() {
+	if b.src == nil {
+		if err := b.start(); err != nil {
+			b.err = err
+			return
+		}
+	}
+	n, err := b.src.Read(b.buf)
+	if n > 0 {
+		if _, werr := b.w.Write(b.buf[:n]); werr != nil {
+			b.err = werr
+			return
+		}
+	}
+	switch {
+	case err == io.EOF:
+		if cerr := b.w.Close(); cerr != nil {
+			b.err = cerr
+			return
+		}
+		httpWriterPools[b.options.Writer.Quality].Put(b.w)
+		b.w = nil
+		b.err = io.EOF
+	case err != nil:
+		b.err = err
+	case n > 0:
+		b.err = b.w.Flush()
+	}
+}
+
+// start sets up the decoding of the upstream body on the first read, as a
+// gzip header may not have arrived when the response is modified.
+func (b *recompressingBody) start() error {
+	b.src = b.body
+	if b.gzipped {
+		in := &sourceReader{r: b.body}
+		zr, err := gzip.NewReader(in)
+		if err == io.EOF {
+			return GzipError{io.ErrUnexpectedEOF}
+		}
+		if err != nil {
+			return in.wrap(err)
+		}
+		b.src = gzipContent{zr, in}
+	}
+	b.buf = make([]byte, readBufSize)
+	b.w = getHTTPWriter(&b.out, b.options.Writer)
+	return nil
+}
+
+func (b *recompressingBody) Close() error {
+	if b.w != nil {
+		if b.w.Close() == nil {
+			httpWriterPools[b.options.Writer.Quality].Put(b.w)
+		}
+		b.w = nil
+	}
+	b.out.Reset()
+	b.err = http.ErrBodyReadAfterClose
+	return b.body.Close()
+}
@@ -3,12 +3,15 @@
 // Distributed under MIT license.
 // See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
 
-// Package cbrotli compresses and decompresses data with C-Brotli library.
+//go:build cgo
+
 package cbrotli
 
 /*
 #include <stddef.h>
 #include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
 
 #include <brotli/decode.h>
 
@@ -30,62 +33,331 @@ import "C"
 
 import (
 	"bytes"
-	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"runtime"
+	"sync"
+	"unsafe"
 )
 
-type decodeError C.BrotliDecoderErrorCode
-
-func (err decodeError) Error() string {
+func (err DecoderError) Error() string {
 	return "cbrotli: " +
-		C.GoString(C.BrotliDecoderErrorString(C.BrotliDecoderErrorCode(err)))
+		C.GoString(C.BrotliDecoderErrorString(C.BrotliDecoderErrorCode(err.Code)))
+}
+
+// nativeVersion returns the version of C-Brotli, as BrotliDecoderVersion.
+func nativeVersion() uint32 {
+	return uint32(C.BrotliDecoderVersion())
+}
+
+// DecoderDictionary is a shared dictionary kept in C memory, so that it can be
+// attached to many decoders without pinning; it is safe for concurrent use by
+// many Readers.
+type DecoderDictionary struct {
+	mu     sync.Mutex
+	kind   C.BrotliSharedDictionaryType
+	data   *C.uint8_t
+	size   C.size_t
+	free   func() // releases data
+	users  int    // open Readers
+	closed bool
+	id     [32]byte
+	// prefixes is the number of raw dictionaries, see MaxDictionaries.
+	prefixes int
+}
+
+// NewDecoderDictionary copies raw (LZ77 prefix) dictionary data to a new
+// DecoderDictionary.
+// Close MUST be called to free resources.
+func NewDecoderDictionary(data []byte) (*DecoderDictionary, error) {
+	return newDecoderDictionary(data, DtRaw)
+}
+
+// NewSerializedDecoderDictionary copies a serialized shared dictionary (e.g.
+// made by BuildSerializedDictionary) to a new DecoderDictionary. It fails if
+// data is malformed, or if C-Brotli is compiled without BROTLI_EXPERIMENTAL.
+// Close MUST be called to free resources.
+func NewSerializedDecoderDictionary(data []byte) (*DecoderDictionary, error) {
+	d, err := newDecoderDictionary(data, DtSerialized)
+	if err != nil {
+		return nil, err
+	}
+	// Parse it once with a throwaway decoder, so that Readers do not fail
+	// later.
+	s := C.BrotliDecoderCreateInstance(nil, nil, nil)
+	ok := C.BrotliDecoderAttachDictionary(s, d.kind, d.size, d.data)
+	C.BrotliDecoderDestroyInstance(s)
+	if ok == 0 {
+		d.Close()
+		return nil, errInvalidDictionary
+	}
+	return d, nil
+}
+
+func newDecoderDictionary(data []byte, dictionaryType DictionaryType) (*DecoderDictionary, error) {
+	if err := checkDictionarySize(len(data), dictionaryType); err != nil {
+		return nil, err
+	}
+	d := &DecoderDictionary{
+		kind: C.BrotliSharedDictionaryType(dictionaryType),
+		data: (*C.uint8_t)(C.malloc(C.size_t(len(data)))),
+		size: C.size_t(len(data)),
+	}
+	d.free = func() { C.free(unsafe.Pointer(d.data)) }
+	C.memcpy(unsafe.Pointer(d.data), unsafe.Pointer(&data[0]), d.size)
+	d.id = DictionaryID(data)
+	d.prefixes = prefixCount(data, dictionaryType)
+	return d, nil
+}
+
+// OpenDictionaryFile maps a raw (LZ77 prefix) dictionary file into memory
+// read-only, and attaches the mapped memory to decoders directly. Processes
+// mapping the same file share its pages, so that a large dictionary takes
+// memory once per host rather than once per process. The file must not be
+// modified while it is mapped.
+//
+// Close unmaps the file; it fails while Readers using the dictionary are open.
+// Memory mapping is not supported on all platforms.
+func OpenDictionaryFile(path string) (*DecoderDictionary, error) {
+	data, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), DtRaw); err != nil {
+		unmapFile(data)
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	return &DecoderDictionary{
+		kind:     C.BrotliSharedDictionaryType(DtRaw),
+		data:     (*C.uint8_t)(unsafe.Pointer(&data[0])),
+		size:     C.size_t(len(data)),
+		free:     func() { unmapFile(data) },
+		id:       DictionaryID(data),
+		prefixes: 1,
+	}, nil
+}
+
+// ID returns the DictionaryID of the dictionary data, computed when the
+// dictionary was created.
+func (d *DecoderDictionary) ID() [32]byte { return d.id }
+
+// Close frees C resources. It fails if some Readers using the dictionary are
+// not closed yet.
+func (d *DecoderDictionary) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return errDictionaryClosed
+	}
+	if d.users != 0 {
+		return errDictionaryInUse
+	}
+	d.closed = true
+	d.free()
+	d.data = nil
+	return nil
+}
+
+// acquire registers a Reader using the dictionary; it fails if the dictionary
+// is closed, which may happen concurrently.
+func (d *DecoderDictionary) acquire() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return errDictionaryClosed
+	}
+	d.users++
+	return nil
 }
 
-var errExcessiveInput = errors.New("cbrotli: excessive input")
-var errInvalidState = errors.New("cbrotli: invalid state")
-var errReaderClosed = errors.New("cbrotli: Reader is closed")
+func (d *DecoderDictionary) release() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.users--
+}
 
 // Reader implements io.ReadCloser by reading Brotli-encoded data from an
 // underlying Reader.
 type Reader struct {
-	src    io.Reader
-	state  *C.BrotliDecoderState
-	buf    []byte          // scratch space for reading from src
-	in     []byte          // current chunk to decode; usually aliases buf
-	pinner *runtime.Pinner // raw dictionary pinner
+	src     io.Reader
+	state   *C.BrotliDecoderState
+	buf     []byte          // scratch space for reading from src
+	in      []byte          // current chunk to decode; usually aliases buf
+	pinner  *runtime.Pinner // dictionary data pinner
+	options ReaderOptions
+	err     error // invalid options or dictionary resolution failure; sticky
+	// optionsErr is the error of invalid options, which Reset keeps.
+	optionsErr error
+	id         string
+	started    bool // Read has been called
+	// srcErr is the first error (other than io.EOF) of src, wrapped; it is
+	// sticky, but data read along with it is decoded first. It is also set
+	// by a failure of options.TeeCompressed, whose data is not decoded.
+	srcErr error
+	// consumed and produced are the decoder counters; reported are their
+	// values at the last call of options.Progress.
+	consumed, produced int64
+	reported           [2]int64
+	failed             bool // an error has been counted in metrics
+	// blocks finds meta-blocks for options.OnBlockBoundary; it is started by
+	// the first Read.
+	blocks       *blockTracker
+	blocksFailed bool // the failure of blocks has been logged
+	// prefetch reads src ahead, see ReaderOptions.PrefetchBuffers; it is
+	// started by the first Read.
+	prefetch *prefetcher
 }
 
-// readBufSize is a "good" buffer size that avoids excessive round-trips
-// between C and Go but doesn't waste too much memory on buffering.
-// It is arbitrarily chosen to be equal to the constant used in io.Copy.
-const readBufSize = 32 * 1024
-
-// NewReader initializes new Reader instance.
+// NewReaderWithOptions initializes new Reader instance with given options.
 // Close MUST be called to free resources.
-func NewReader(src io.Reader) *Reader {
-	return NewReaderWithRawDictionary(src, nil)
+func NewReaderWithOptions(src io.Reader, options ReaderOptions) *Reader {
+	r := &Reader{
+		src:     src,
+		buf:     make([]byte, readBufSize),
+		options: options,
+	}
+	r.err = options.validate()
+	if r.err == nil && options.Dictionary != nil {
+		r.err = options.Dictionary.acquire()
+	}
+	if r.err != nil {
+		r.optionsErr = r.err
+		// Do not attach anything.
+		r.options = ReaderOptions{
+			Multistream:       options.Multistream,
+			ResolveDictionary: options.ResolveDictionary,
+			Progress:          options.Progress,
+			CompressedSize:    options.CompressedSize,
+		}
+		r.state = r.newState()
+		count(&metrics.readersOpened, 1)
+		return r
+	}
+	if options.Dictionary != nil {
+		r.options.RawDictionary = nil
+	} else if dictionary := options.RawDictionary; dictionary != nil {
+		r.pin(dictionary)
+	}
+	for _, d := range options.Dictionaries {
+		r.pin(d.Data)
+	}
+	r.state = r.newState()
+	count(&metrics.readersOpened, 1)
+	return r
 }
 
-// NewReaderWithRawDictionary initializes new Reader instance with shared dictionary.
-// Close MUST be called to free resources.
-func NewReaderWithRawDictionary(src io.Reader, dictionary []byte) *Reader {
+// resolveDictionary attaches the dictionary named by SetDictionaryID to a
+// fresh decoder instance; decoding has not started yet.
+func (r *Reader) resolveDictionary() error {
+	d, err := r.options.ResolveDictionary(r.id)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		return nil
+	}
+	if err := d.acquire(); err != nil {
+		return err
+	}
+	if r.options.Dictionary != nil {
+		r.options.Dictionary.release()
+	}
+	r.options.Dictionary = d
+	r.options.RawDictionary = nil
+	C.BrotliDecoderDestroyInstance(r.state)
+	r.state = r.newState()
+	return nil
+}
+
+// pin keeps dictionary data in place while decoders refer to it.
+func (r *Reader) pin(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	if r.pinner == nil {
+		r.pinner = new(runtime.Pinner)
+	}
+	r.pinner.Pin(&data[0])
+}
+
+// newState creates a decoder instance with the dictionaries attached.
+func (r *Reader) newState() *C.BrotliDecoderState {
 	s := C.BrotliDecoderCreateInstance(nil, nil, nil)
-	var p *runtime.Pinner
-	if dictionary != nil {
-		p = new(runtime.Pinner)
-		p.Pin(&dictionary[0])
-		// TODO(eustas): use return value
-		C.BrotliDecoderAttachDictionary(s, C.BrotliSharedDictionaryType( /* RAW */ 0),
-			C.size_t(len(dictionary)), (*C.uint8_t)(&dictionary[0]))
+	ok := true
+	if d := r.options.Dictionary; d != nil {
+		ok = C.BrotliDecoderAttachDictionary(s, d.kind, d.size, d.data) != 0
+	} else if dictionary := r.options.RawDictionary; dictionary != nil {
+		ok = C.BrotliDecoderAttachDictionary(s, C.BrotliSharedDictionaryType( /* RAW */ 0),
+			C.size_t(len(dictionary)), (*C.uint8_t)(&dictionary[0])) != 0
 	}
-	return &Reader{
-		src:    src,
-		state:  s,
-		buf:    make([]byte, readBufSize),
-		pinner: p,
+	for _, d := range r.options.Dictionaries {
+		if len(d.Data) != 0 && C.BrotliDecoderAttachDictionary(s, C.BrotliSharedDictionaryType(d.Type),
+			C.size_t(len(d.Data)), (*C.uint8_t)(&d.Data[0])) == 0 {
+			ok = false
+		}
+	}
+	if !ok && r.err == nil {
+		r.err = errAttachDictionary
+	}
+	return s
+}
+
+// readSource reads from src into r.buf. Errors of src other than io.EOF are
+// wrapped, so that they are distinguished from decoding errors, and sticky.
+func (r *Reader) readSource() (int, error) {
+	if r.srcErr != nil {
+		return 0, r.srcErr
+	}
+	n, err := r.src.Read(r.buf)
+	if n > 0 && r.options.TeeCompressed != nil {
+		if teeErr := r.teeInput(r.buf[:n]); teeErr != nil {
+			return 0, teeErr
+		}
+	}
+	if n == 0 && err == nil {
+		logEvent(slog.LevelDebug, "cbrotli: source returned no data and no error",
+			"offset", r.consumed+int64(len(r.in)))
+	}
+	if err != nil && err != io.EOF {
+		r.srcErr = fmt.Errorf("cbrotli: reading source: %w", err)
+		return n, r.srcErr
 	}
+	return n, err
+}
+
+// trailingInput handles the input r.in left after the end of a stream: it is
+// skipped if it is zero padding accepted by ReaderOptions.IgnoreTrailingZeros,
+// and then io.EOF is returned; otherwise the next stream starts in
+// Multistream mode, and the input is an error if not.
+func (r *Reader) trailingInput() error {
+	if r.options.IgnoreTrailingZeros {
+		padding, err := r.skipTrailingZeros(func() ([]byte, error) {
+			m, err := r.readSource()
+			return r.buf[:m], err
+		})
+		if err != nil {
+			return err
+		}
+		if padding {
+			return io.EOF
+		}
+	}
+	if !r.options.Multistream {
+		logEvent(slog.LevelWarn, "cbrotli: trailing input after the end of the stream",
+			"offset", r.consumed, "buffered", len(r.in))
+		return errExcessiveInput
+	}
+	r.nextStream()
+	return nil
+}
+
+// nextStream replaces the decoder instance that has finished a stream with a
+// fresh one, in Multistream mode.
+func (r *Reader) nextStream() {
+	C.BrotliDecoderDestroyInstance(r.state)
+	r.state = r.newState()
 }
 
 // Close implements io.Closer. Close MUST be invoked to free native resources.
@@ -96,34 +368,131 @@ func (r *Reader) Close() error {
 	// Close despite the state; i.e. there might be some unread decoded data.
 	C.BrotliDecoderDestroyInstance(r.state)
 	r.state = nil
+	count(&metrics.readersClosed, 1)
+	r.stopPrefetch()
+	if r.blocks != nil {
+		r.blocks.close()
+		r.blocks = nil
+	}
 	if r.pinner != nil {
 		r.pinner.Unpin()
 		r.pinner = nil
 	}
+	if r.options.Dictionary != nil {
+		r.options.Dictionary.release()
+	}
 	return nil
 }
 
-func (r *Reader) Read(p []byte) (n int, err error) {
+// Reset discards the state of the Reader and makes it decode src with the same
+// options, so that a Reader (and its buffer and pinned dictionaries) can be
+// reused, e.g. via sync.Pool. The id set by SetDictionaryID is forgotten, but
+// a dictionary resolved for it stays attached until another one is resolved.
+// Any data not yet read from the previous source is discarded.
+func (r *Reader) Reset(src io.Reader) error {
+	if r.state == nil {
+		return errReaderClosed
+	}
+	r.stopPrefetch()
+	if r.blocks != nil {
+		r.blocks.close()
+		r.blocks = nil
+	}
+	// newState records a failure to attach the dictionaries.
+	r.err = r.optionsErr
+	C.BrotliDecoderDestroyInstance(r.state)
+	r.state = r.newState()
+	r.src = src
+	r.in = nil
+	r.id = ""
+	r.started = false
+	r.srcErr = nil
+	r.consumed, r.produced = 0, 0
+	r.reported = [2]int64{}
+	r.failed = false
+	r.blocksFailed = false
+	return nil
+}
+
+// finished reports whether the decoder has reached the end of the stream and
+// returned all of its content.
+func (r *Reader) finished() bool {
+	return r.state != nil && r.err == nil && len(r.in) == 0 &&
+		int(C.BrotliDecoderIsFinished(r.state)) != 0 &&
+		int(C.BrotliDecoderHasMoreOutput(r.state)) == 0
+}
+
+// startBlocks starts finding meta-blocks for options.OnBlockBoundary, once
+// the dictionaries are known.
+func (r *Reader) startBlocks() error {
+	var prefix []byte
+	if d := r.options.Dictionary; d != nil {
+		if d.kind != C.BrotliSharedDictionaryType(DtRaw) {
+			return errBlockBoundaryDictionary
+		}
+		prefix = append(prefix, unsafe.Slice((*byte)(unsafe.Pointer(d.data)), int(d.size))...)
+	} else {
+		prefix = append(prefix, r.options.RawDictionary...)
+	}
+	for _, d := range r.options.Dictionaries {
+		if d.Type != DtRaw {
+			return errBlockBoundaryDictionary
+		}
+		prefix = append(prefix, d.Data...)
+	}
+	r.blocks = newBlockTracker(prefix)
+	return nil
+}
+
+func (r *Reader) read(p []byte) (n int, err error) {
 	if r.state == nil {
 		return 0, errReaderClosed
 	}
+	if !r.started {
+		r.started = true
+		r.startPrefetch()
+		if r.id != "" && r.err == nil {
+			r.err = r.resolveDictionary()
+		}
+		if r.options.OnBlockBoundary != nil && r.err == nil {
+			r.err = r.startBlocks()
+		}
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
 	if int(C.BrotliDecoderHasMoreOutput(r.state)) == 0 && len(r.in) == 0 {
-		m, readErr := r.src.Read(r.buf)
+		m, readErr := r.readSource()
 		if m == 0 {
 			if readErr != io.EOF {
 				return 0, readErr
 			}
 			if int(C.BrotliDecoderIsFinished(r.state)) == 0 {
-				return 0, io.ErrUnexpectedEOF
+				return 0, ErrTruncated
 			}
 			return 0, io.EOF
 		}
 		r.in = r.buf[:m]
+		if (r.options.Multistream || r.options.IgnoreTrailingZeros) && int(C.BrotliDecoderIsFinished(r.state)) != 0 {
+			if err := r.trailingInput(); err != nil {
+				return 0, err
+			}
+		}
+	} else if r.options.IgnoreTrailingZeros && len(r.in) != 0 && int(C.BrotliDecoderIsFinished(r.state)) != 0 {
+		if err := r.trailingInput(); err != nil {
+			return 0, err
+		}
 	}
 
 	if len(p) == 0 {
 		return 0, nil
 	}
+	// Read may return less than len(p).
+	p = p[:callSize(len(p))]
+	if limit := r.outputLimit(); limit > 0 && int64(len(p)) > limit-r.produced {
+		// A byte more than allowed reveals excess output.
+		p = p[:limit-r.produced+1]
+	}
 
 	for {
 		var written, consumed C.size_t
@@ -135,17 +504,40 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 			(*C.uint8_t)(&p[0]), C.size_t(len(p)),
 			data, C.size_t(len(r.in)),
 			&written, &consumed)
+		if r.blocks != nil && consumed != 0 {
+			r.blocks.feed(r.in[:int(consumed)])
+		}
 		r.in = r.in[int(consumed):]
 		n = int(written)
+		r.consumed += int64(consumed)
+		r.produced += int64(written)
+		if metricsEnabled.Load() {
+			metrics.decoderBytesIn.Add(int64(consumed))
+			metrics.decoderBytesOut.Add(int64(written))
+		}
+		if limit := r.outputLimit(); limit > 0 && r.produced > limit {
+			r.err = r.excessError()
+			return n - int(r.produced-limit), r.err
+		}
 
 		switch result {
 		case C.BROTLI_DECODER_RESULT_SUCCESS:
 			if len(r.in) > 0 {
-				return n, errExcessiveInput
+				if r.options.IgnoreTrailingZeros && n > 0 {
+					// Looking for the end of the padding may block.
+					return n, nil
+				}
+				if err := r.trailingInput(); err != nil {
+					return n, err
+				}
+				if n > 0 {
+					return n, nil
+				}
+				continue
 			}
 			return n, nil
 		case C.BROTLI_DECODER_RESULT_ERROR:
-			return n, decodeError(C.BrotliDecoderGetErrorCode(r.state))
+			return n, DecoderError{Code: int(C.BrotliDecoderGetErrorCode(r.state))}
 		case C.BROTLI_DECODER_RESULT_NEEDS_MORE_OUTPUT:
 			if n == 0 {
 				return 0, io.ErrShortBuffer
@@ -164,27 +556,25 @@ func (r *Reader) Read(p []byte) (n int, err error) {
 		}
 
 		// Top off the buffer.
-		encN, err := r.src.Read(r.buf)
+		encN, err := r.readSource()
 		if encN == 0 {
 			// Not enough data to complete decoding.
 			if err == io.EOF {
-				return 0, io.ErrUnexpectedEOF
+				return 0, ErrTruncated
 			}
 			return 0, err
 		}
 		r.in = r.buf[:encN]
 	}
-
-	return n, nil
-}
-
-// Decode decodes Brotli encoded data.
-func Decode(encodedData []byte) ([]byte, error) {
-	return DecodeWithRawDictionary(encodedData, nil)
 }
 
 // DecodeWithRawDictionary decodes Brotli encoded data with shared dictionary.
 func DecodeWithRawDictionary(encodedData []byte, dictionary []byte) ([]byte, error) {
+	if dictionary != nil {
+		if err := checkDictionarySize(len(dictionary), DtRaw); err != nil {
+			return nil, err
+		}
+	}
 	s := C.BrotliDecoderCreateInstance(nil, nil, nil)
 	var p *runtime.Pinner
 	if dictionary != nil {
@@ -201,6 +591,7 @@ func DecodeWithRawDictionary(encodedData []byte, dictionary []byte) ([]byte, err
 		in:     encodedData,
 		pinner: p,
 	}
+	count(&metrics.readersOpened, 1)
 	defer r.Close()
 	out, err := ioutil.ReadAll(r)
 	if err != nil {
@@ -0,0 +1,611 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/google/brotli/go/cbrotli/internal/decoder"
+)
+
+func (err DecoderError) Error() string {
+	name, ok := decoderErrorNames[err.Code]
+	if !ok {
+		name = "INVALID"
+	}
+	return "cbrotli: " + name
+}
+
+// nativeVersion returns 0: C-Brotli is not in use.
+func nativeVersion() uint32 { return 0 }
+
+// decoderErrorCodes maps the error codes of the pure-Go decoder that denote
+// malformed streams to the closest BrotliDecoderErrorCode.
+var decoderErrorCodes = map[int32]int{
+	-2:  -6,  // CORRUPTED_CODE_LENGTH_TABLE: FORMAT_CL_SPACE
+	-3:  -8,  // CORRUPTED_CONTEXT_MAP: FORMAT_CONTEXT_MAP_REPEAT
+	-4:  -6,  // CORRUPTED_HUFFMAN_CODE_HISTOGRAM: FORMAT_CL_SPACE
+	-5:  -14, // CORRUPTED_PADDING_BITS: FORMAT_PADDING_1
+	-6:  -2,  // CORRUPTED_RESERVED_BIT: FORMAT_RESERVED
+	-7:  -5,  // DUPLICATE_SIMPLE_HUFFMAN_SYMBOL: FORMAT_SIMPLE_HUFFMAN_SAME
+	-8:  -1,  // EXUBERANT_NIBBLE: FORMAT_EXUBERANT_NIBBLE
+	-9:  -16, // INVALID_BACKWARD_REFERENCE: FORMAT_DISTANCE
+	-10: -9,  // INVALID_METABLOCK_LENGTH: FORMAT_BLOCK_LENGTH_1
+	-11: -13, // INVALID_WINDOW_BITS: FORMAT_WINDOW_BITS
+	-12: -16, // NEGATIVE_DISTANCE: FORMAT_DISTANCE
+	-15: -4,  // SYMBOL_OUT_OF_RANGE: FORMAT_SIMPLE_HUFFMAN_ALPHABET
+	-18: -7,  // UNUSED_HUFFMAN_SPACE: FORMAT_HUFFMAN_SPACE
+}
+
+// decoderErrorUnreachable is BROTLI_DECODER_ERROR_UNREACHABLE, reported for
+// the internal failures of the pure-Go decoder.
+const decoderErrorUnreachable = -31
+
+// DecoderDictionary is a shared dictionary kept in a private copy, so that it
+// can be attached to many decoders; it is safe for concurrent use by many
+// Readers.
+type DecoderDictionary struct {
+	mu     sync.Mutex
+	kind   DictionaryType
+	data   []byte // attached to decoders: the raw dictionary of a serialized one
+	free   func() // releases mapped data; nil for copies
+	users  int    // open Readers
+	closed bool
+	id     [32]byte
+	// prefixes is the number of raw dictionaries, see MaxDictionaries.
+	prefixes int
+}
+
+// NewDecoderDictionary copies raw (LZ77 prefix) dictionary data to a new
+// DecoderDictionary.
+// Close MUST be called to free resources.
+func NewDecoderDictionary(data []byte) (*DecoderDictionary, error) {
+	if err := checkDictionarySize(len(data), DtRaw); err != nil {
+		return nil, err
+	}
+	return &DecoderDictionary{
+		kind:     DtRaw,
+		data:     bytes.Clone(data),
+		id:       DictionaryID(data),
+		prefixes: 1,
+	}, nil
+}
+
+// NewSerializedDecoderDictionary copies a serialized shared dictionary (e.g.
+// made by BuildSerializedDictionary) to a new DecoderDictionary. It fails if
+// data is malformed. Without cgo, only dictionaries without custom words or
+// transforms are supported, i.e. a raw dictionary in the serialized format;
+// others make it fail with an error wrapping ErrNotSupported.
+// Close MUST be called to free resources.
+func NewSerializedDecoderDictionary(data []byte) (*DecoderDictionary, error) {
+	if err := checkDictionarySize(len(data), DtSerialized); err != nil {
+		return nil, err
+	}
+	prefix, err := serializedPrefix(data)
+	if err != nil {
+		return nil, err
+	}
+	return &DecoderDictionary{
+		kind:     DtSerialized,
+		data:     bytes.Clone(prefix),
+		id:       DictionaryID(data),
+		prefixes: prefixCount(data, DtSerialized),
+	}, nil
+}
+
+var errCustomStaticDictionary = fmt.Errorf("%w: serialized dictionary with custom words or transforms", ErrNotSupported)
+
+// serializedPrefix returns the raw dictionary of a serialized one, which is
+// all of it that the pure-Go decoder supports: without custom words and
+// transforms, a serialized dictionary is its raw dictionary and the built-in
+// static dictionary.
+func serializedPrefix(data []byte) ([]byte, error) {
+	info, err := ParseSerializedDictionary(data)
+	if err != nil {
+		return nil, errInvalidDictionary
+	}
+	if info.CustomWords() || info.CustomTransforms() {
+		return nil, errCustomStaticDictionary
+	}
+	if len(info.Prefixes) == 0 {
+		return nil, nil
+	}
+	p := info.Prefixes[0]
+	return data[p.Offset : p.Offset+p.Size], nil
+}
+
+// OpenDictionaryFile maps a raw (LZ77 prefix) dictionary file into memory
+// read-only, and attaches the mapped memory to decoders directly. The file
+// must not be modified while it is mapped.
+//
+// Close unmaps the file; it fails while Readers using the dictionary are open.
+// Memory mapping is not supported on all platforms.
+func OpenDictionaryFile(path string) (*DecoderDictionary, error) {
+	data, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), DtRaw); err != nil {
+		unmapFile(data)
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	return &DecoderDictionary{
+		kind:     DtRaw,
+		data:     data,
+		free:     func() { unmapFile(data) },
+		id:       DictionaryID(data),
+		prefixes: 1,
+	}, nil
+}
+
+// ID returns the DictionaryID of the dictionary data, computed when the
+// dictionary was created.
+func (d *DecoderDictionary) ID() [32]byte { return d.id }
+
+// Close releases the dictionary data. It fails if some Readers using the
+// dictionary are not closed yet.
+func (d *DecoderDictionary) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return errDictionaryClosed
+	}
+	if d.users != 0 {
+		return errDictionaryInUse
+	}
+	d.closed = true
+	if d.free != nil {
+		d.free()
+	}
+	d.data = nil
+	return nil
+}
+
+// acquire registers a Reader using the dictionary; it fails if the dictionary
+// is closed, which may happen concurrently.
+func (d *DecoderDictionary) acquire() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return errDictionaryClosed
+	}
+	d.users++
+	return nil
+}
+
+func (d *DecoderDictionary) release() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.users--
+}
+
+// Reader implements io.ReadCloser by reading Brotli-encoded data from an
+// underlying Reader.
+//
+// Without cgo, the Reader decodes with a pure-Go decoder, which reads input
+// ahead in chunks of up to 4KiB and waits until a chunk is complete or the
+// source ends; it does not suit interactive streams. Serialized dictionaries
+// in ReaderOptions.Dictionaries are supported as by
+// NewSerializedDecoderDictionary: with custom words or transforms, Read fails
+// with an error wrapping ErrNotSupported.
+type Reader struct {
+	src     io.Reader
+	state   *decoder.Decoder
+	buf     []byte // scratch space for reading from src
+	in      []byte // input to decode before src; see readerInput
+	options ReaderOptions
+	err     error // invalid options, dictionary resolution or decoding failure; sticky
+	// optionsErr is the error of invalid options, which Reset keeps.
+	optionsErr error
+	id         string
+	started    bool // Read has been called
+	done       bool // the decoder has returned all of its stream
+	// srcErr is the first error (other than io.EOF) of src, wrapped; it is
+	// sticky, but data read along with it is decoded first. It is also set
+	// by a failure of options.TeeCompressed, whose data is not decoded.
+	srcErr error
+	// fed is the input given to the current decoder instance, and base the
+	// input consumed by the previous ones.
+	fed, base int64
+	// consumed and produced are the decoder counters; reported are their
+	// values at the last call of options.Progress.
+	consumed, produced int64
+	reported           [2]int64
+	failed             bool // an error has been counted in metrics
+	// blocks finds meta-blocks for options.OnBlockBoundary; it is started by
+	// the first Read.
+	blocks       *blockTracker
+	blocksFailed bool // the failure of blocks has been logged
+	// prefetch reads src ahead, see ReaderOptions.PrefetchBuffers; it is
+	// started by the first Read.
+	prefetch *prefetcher
+}
+
+// NewReaderWithOptions initializes new Reader instance with given options.
+// Close MUST be called to free resources.
+func NewReaderWithOptions(src io.Reader, options ReaderOptions) *Reader {
+	r := &Reader{
+		src:     src,
+		buf:     make([]byte, readBufSize),
+		options: options,
+	}
+	r.err = options.validate()
+	if r.err == nil && options.Dictionary != nil {
+		r.err = options.Dictionary.acquire()
+	}
+	if r.err != nil {
+		r.optionsErr = r.err
+		// Do not attach anything.
+		r.options = ReaderOptions{
+			Multistream:       options.Multistream,
+			ResolveDictionary: options.ResolveDictionary,
+			Progress:          options.Progress,
+			CompressedSize:    options.CompressedSize,
+		}
+	} else if options.Dictionary != nil {
+		r.options.RawDictionary = nil
+	}
+	r.state = r.newState()
+	count(&metrics.readersOpened, 1)
+	return r
+}
+
+// resolveDictionary attaches the dictionary named by SetDictionaryID to a
+// fresh decoder instance; decoding has not started yet.
+func (r *Reader) resolveDictionary() error {
+	d, err := r.options.ResolveDictionary(r.id)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		return nil
+	}
+	if err := d.acquire(); err != nil {
+		return err
+	}
+	if r.options.Dictionary != nil {
+		r.options.Dictionary.release()
+	}
+	r.options.Dictionary = d
+	r.options.RawDictionary = nil
+	r.state.Close()
+	r.state = r.newState()
+	return r.err
+}
+
+// newState creates a decoder instance with the dictionaries attached; of a
+// serialized one, only its raw dictionary is, see serializedPrefix.
+func (r *Reader) newState() *decoder.Decoder {
+	s := decoder.New(readerInput{r})
+	var err error
+	if d := r.options.Dictionary; d != nil {
+		s.AttachDictionary(d.data)
+	} else if dictionary := r.options.RawDictionary; dictionary != nil {
+		s.AttachDictionary(dictionary)
+	}
+	for _, d := range r.options.Dictionaries {
+		data := d.Data
+		if d.Type == DtSerialized && len(data) != 0 {
+			prefix, prefixErr := serializedPrefix(data)
+			if prefixErr == errInvalidDictionary {
+				prefixErr = errAttachDictionary
+			}
+			if err == nil {
+				err = prefixErr
+			}
+			data = prefix
+		}
+		s.AttachDictionary(data)
+	}
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	r.fed = 0
+	return s
+}
+
+// readerInput is the source of the decoder: r.in, i.e. the input left over by
+// the previous stream (or given whole by Decoder.DecodeAll), then src.
+type readerInput struct {
+	r *Reader
+}
+
+func (in readerInput) Read(p []byte) (int, error) {
+	r := in.r
+	if len(r.in) != 0 {
+		n := copy(p, r.in)
+		r.in = r.in[n:]
+		r.fed += int64(n)
+		return n, nil
+	}
+	n, err := r.readSource(p)
+	if n > 0 && r.blocks != nil {
+		r.blocks.feed(p[:n])
+	}
+	r.fed += int64(n)
+	if err != nil && err != io.EOF {
+		// The decoder drops the input it has read ahead when a read fails, so
+		// it is told the input ends: it decodes what it has, as C-Brotli does,
+		// and decoderError reports r.srcErr if the stream is cut short.
+		err = io.EOF
+	}
+	return n, err
+}
+
+// readSource reads from src into p. Errors of src other than io.EOF are
+// wrapped, so that they are distinguished from decoding errors, and sticky;
+// data read along with them is returned first. As the decoder takes a read of
+// no data for the end of the input, it is retried.
+func (r *Reader) readSource(p []byte) (int, error) {
+	if r.srcErr != nil {
+		return 0, r.srcErr
+	}
+	for i := 0; ; i++ {
+		n, err := r.src.Read(p)
+		if n > 0 && r.options.TeeCompressed != nil {
+			if teeErr := r.teeInput(p[:n]); teeErr != nil {
+				return 0, teeErr
+			}
+		}
+		if err != nil && err != io.EOF {
+			r.srcErr = fmt.Errorf("cbrotli: reading source: %w", err)
+			if n > 0 {
+				return n, nil
+			}
+			return 0, r.srcErr
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+		if i == 0 {
+			logEvent(slog.LevelDebug, "cbrotli: source returned no data and no error",
+				"offset", r.consumed)
+		}
+		if i == maxEmptyReads {
+			r.srcErr = fmt.Errorf("cbrotli: reading source: %w", io.ErrNoProgress)
+			return 0, r.srcErr
+		}
+	}
+}
+
+// nextStream replaces the decoder instance that has finished a stream with a
+// fresh one, in Multistream mode.
+func (r *Reader) nextStream() {
+	r.base = r.consumed
+	r.state.Close()
+	r.state = r.newState()
+	r.done = false
+}
+
+// Close implements io.Closer.
+func (r *Reader) Close() error {
+	if r.state == nil {
+		return errReaderClosed
+	}
+	// Close despite the state; i.e. there might be some unread decoded data.
+	r.state.Close()
+	r.state = nil
+	count(&metrics.readersClosed, 1)
+	r.stopPrefetch()
+	if r.blocks != nil {
+		r.blocks.close()
+		r.blocks = nil
+	}
+	if r.options.Dictionary != nil {
+		r.options.Dictionary.release()
+	}
+	return nil
+}
+
+// Reset discards the state of the Reader and makes it decode src with the same
+// options, so that a Reader (and its buffer) can be reused, e.g. via
+// sync.Pool. The id set by SetDictionaryID is forgotten, but a dictionary
+// resolved for it stays attached until another one is resolved. Any data not
+// yet read from the previous source is discarded.
+func (r *Reader) Reset(src io.Reader) error {
+	if r.state == nil {
+		return errReaderClosed
+	}
+	r.stopPrefetch()
+	if r.blocks != nil {
+		r.blocks.close()
+		r.blocks = nil
+	}
+	// newState records unsupported dictionaries.
+	r.err = r.optionsErr
+	r.state.Close()
+	r.state = r.newState()
+	r.src = src
+	r.in = nil
+	r.id = ""
+	r.started = false
+	r.done = false
+	r.srcErr = nil
+	r.base = 0
+	r.consumed, r.produced = 0, 0
+	r.reported = [2]int64{}
+	r.failed = false
+	r.blocksFailed = false
+	return nil
+}
+
+// finished reports whether the decoder has reached the end of the stream and
+// returned all of its content.
+func (r *Reader) finished() bool {
+	return r.state != nil && r.err == nil && r.done && len(r.in) == 0
+}
+
+// startBlocks starts finding meta-blocks for options.OnBlockBoundary, once
+// the dictionaries are known.
+func (r *Reader) startBlocks() error {
+	var prefix []byte
+	if d := r.options.Dictionary; d != nil {
+		if d.kind != DtRaw {
+			return errBlockBoundaryDictionary
+		}
+		prefix = append(prefix, d.data...)
+	} else {
+		prefix = append(prefix, r.options.RawDictionary...)
+	}
+	for _, d := range r.options.Dictionaries {
+		if d.Type != DtRaw {
+			return errBlockBoundaryDictionary
+		}
+		prefix = append(prefix, d.Data...)
+	}
+	r.blocks = newBlockTracker(prefix)
+	return nil
+}
+
+// decoderError converts a failure of the pure-Go decoder.
+func (r *Reader) decoderError(code int32) error {
+	truncated := code == decoder.TruncatedInput || code == decoder.ReadAfterEnd || code == decoder.ReadFailed
+	// C-Brotli would wait for the missing input instead of an overrun.
+	if truncated || r.state.Overrun() {
+		if r.srcErr != nil {
+			return r.srcErr
+		}
+		return ErrTruncated
+	}
+	if c, ok := decoderErrorCodes[code]; ok {
+		return DecoderError{Code: c}
+	}
+	return DecoderError{Code: decoderErrorUnreachable}
+}
+
+// nextInput looks at the input after the end of a stream: it returns io.EOF
+// (or the error of src) if there is none or if it is zero padding accepted by
+// ReaderOptions.IgnoreTrailingZeros, starts the next stream in Multistream
+// mode, and fails otherwise.
+func (r *Reader) nextInput() error {
+	fed := len(r.in) // bytes already passed to r.blocks
+	if len(r.in) == 0 {
+		m, err := r.readSource(r.buf)
+		if m == 0 {
+			return err
+		}
+		r.in = r.buf[:m]
+	}
+	if r.options.IgnoreTrailingZeros {
+		padding, err := r.skipTrailingZeros(func() ([]byte, error) {
+			m, err := r.readSource(r.buf)
+			return r.buf[:m], err
+		})
+		if err != nil {
+			return err
+		}
+		if padding {
+			return io.EOF
+		}
+	}
+	if r.blocks != nil && fed < len(r.in) {
+		r.blocks.feed(r.in[fed:])
+	}
+	if !r.options.Multistream {
+		logEvent(slog.LevelWarn, "cbrotli: trailing input after the end of the stream",
+			"offset", r.consumed, "buffered", len(r.in))
+		return errExcessiveInput
+	}
+	r.nextStream()
+	return r.err
+}
+
+func (r *Reader) read(p []byte) (n int, err error) {
+	if r.state == nil {
+		return 0, errReaderClosed
+	}
+	if !r.started {
+		r.started = true
+		r.startPrefetch()
+		if r.id != "" && r.err == nil {
+			r.err = r.resolveDictionary()
+		}
+		if r.options.OnBlockBoundary != nil && r.err == nil {
+			r.err = r.startBlocks()
+		}
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.done {
+		if err := r.nextInput(); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(p) == 0 {
+		return 0, nil
+	}
+	// Read may return less than len(p).
+	p = p[:callSize(len(p))]
+	if limit := r.outputLimit(); limit > 0 && int64(len(p)) > limit-r.produced {
+		// A byte more than allowed reveals excess output.
+		p = p[:limit-r.produced+1]
+	}
+
+	for {
+		written, code := r.state.Decode(p)
+		if code == decoder.Done || code == decoder.UnusedBytesAfterEnd {
+			r.done = true
+			// The decoder has read the unused bytes before those in r.in.
+			r.in = append(r.state.Unused(), r.in...)
+		}
+		consumed := r.base + r.fed - int64(r.state.Buffered())
+		n = written
+		if metricsEnabled.Load() {
+			metrics.decoderBytesIn.Add(consumed - r.consumed)
+			metrics.decoderBytesOut.Add(int64(written))
+		}
+		r.consumed = consumed
+		r.produced += int64(written)
+		if limit := r.outputLimit(); limit > 0 && r.produced > limit {
+			r.err = r.excessError()
+			return n - int(r.produced-limit), r.err
+		}
+
+		switch {
+		case r.done:
+			if len(r.in) > 0 && !r.options.Multistream && !r.options.IgnoreTrailingZeros {
+				logEvent(slog.LevelWarn, "cbrotli: trailing input after the end of the stream",
+					"offset", r.consumed, "buffered", len(r.in))
+				return n, errExcessiveInput
+			}
+			if n > 0 {
+				return n, nil
+			}
+			if err := r.nextInput(); err != nil {
+				return 0, err
+			}
+		case code < 0:
+			r.err = r.decoderError(code)
+			return n, r.err
+		default:
+			return n, nil
+		}
+	}
+}
+
+// DecodeWithRawDictionary decodes Brotli encoded data with shared dictionary.
+func DecodeWithRawDictionary(encodedData []byte, dictionary []byte) ([]byte, error) {
+	if dictionary != nil {
+		if err := checkDictionarySize(len(dictionary), DtRaw); err != nil {
+			return nil, err
+		}
+	}
+	r := NewReaderWithRawDictionary(bytes.NewReader(encodedData), dictionary)
+	defer r.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// RecoverPrefix decodes data until the end of the stream or the first error,
+// e.g. of a file cut short by a crash, and returns all the content decoded so
+// far. consumedInput is the number of bytes of data consumed by the decoder,
+// complete reports whether the stream ended, and err tells why decoding
+// stopped early: ErrTruncated, a DecoderError, or the error of data after the
+// end of the stream (then complete is set).
+//
+// The decoder drops the content it holds in its window when it detects a
+// corruption, so RecoverPrefix then decodes the longest prefix of data that
+// has no detected error again, as a truncated stream, to return all of it.
+// Brotli streams have no checksum, though: the content decoded before the
+// corruption is detected may itself be corrupt.
+func RecoverPrefix(data []byte) (out []byte, consumedInput int, complete bool, err error) {
+	var buf bytes.Buffer
+	_, consumed, complete, err := RecoverPrefixTo(&buf, bytes.NewReader(data))
+	out = buf.Bytes()
+	var decoderErr DecoderError
+	if !errors.As(err, &decoderErr) {
+		return out, int(consumed), complete, err
+	}
+	// A prefix of data is decoded without error if a longer one is; the input
+	// consumed by the decoder does not tell where the error is.
+	lo, hi := 0, len(data)
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if _, _, _, err := RecoverPrefixTo(io.Discard, bytes.NewReader(data[:mid])); err == ErrTruncated {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	buf.Reset()
+	RecoverPrefixTo(&buf, bytes.NewReader(data[:lo]))
+	if buf.Len() > len(out) {
+		out = buf.Bytes()
+	}
+	return out, int(consumed), false, err
+}
+
+// RecoverPrefixTo is like RecoverPrefix, but decodes the stream read from src
+// and writes the content to dst as it is decoded; written is the number of
+// bytes written to dst. Errors of src and dst also stop decoding. As the input
+// is not kept, the content in the window of the decoder is lost if it detects
+// a corruption.
+func RecoverPrefixTo(dst io.Writer, src io.Reader) (written, consumedInput int64, complete bool, err error) {
+	r := NewReader(src)
+	defer r.Close()
+	buf := make([]byte, readBufSize)
+	for {
+		n, readErr := r.Read(buf)
+		consumedInput = r.consumed
+		if n > 0 {
+			m, err := dst.Write(buf[:n])
+			written += int64(m)
+			if err == nil && m < n {
+				err = io.ErrShortWrite
+			}
+			if err != nil {
+				return written, consumedInput, false, err
+			}
+		}
+		switch readErr {
+		case nil:
+		case io.EOF:
+			return written, consumedInput, true, nil
+		case errExcessiveInput:
+			return written, consumedInput, true, readErr
+		default:
+			return written, consumedInput, false, readErr
+		}
+	}
+}
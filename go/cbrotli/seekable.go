@@ -0,0 +1,574 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Seekable format
+//
+// A seekable file is a single valid Brotli stream, so that any decoder reads
+// it front to back, that consists of:
+//
+//	frame_0 ... frame_{n-1} index final
+//
+// Each frame encodes (up to) a fixed amount of input with a fresh encoder
+// instance, which is told the uncompressed offset of the frame (see
+// WriterOptions.StreamOffset): it neither refers to data of earlier frames nor
+// relies on their literal context, and it ends at a byte boundary. Only
+// frame_0 starts with the stream header.
+//
+// index is a metadata meta-block, which decoders skip; its content is
+//
+//	entries footer
+//
+// where entries holds a pair of unsigned varints (encoding/binary) per frame:
+// the compressed size and the uncompressed size of the frame. footer is 16
+// bytes:
+//
+//	frame count      uint32, little endian
+//	len(entries)     uint32, little endian
+//	version          1 byte, seekableVersion
+//	reserved         3 zero bytes
+//	magic            seekableMagic
+//
+// final is the single byte 0x03: the empty last meta-block, which finishes the
+// stream. Metadata ends at a byte boundary, so the footer is found right
+// before it.
+//
+// To decode frame k on its own, a decoder is given the stream header followed
+// by m bytes that decode to anything and end at a byte boundary, and then the
+// frame, where m = min(uncompressed offset of the frame, window size); this
+// reproduces the distance limits the frame was encoded with.
+
+const (
+	seekableVersion    = 1
+	seekableMagic      = "BrSk"
+	seekableFooterSize = 16
+	seekableFinal      = 0x03
+	// defaultFrameSize is the SeekableWriter frame size if ChunkSize is not
+	// set.
+	defaultFrameSize = 1 << 20
+)
+
+// maxMetadataSize is the limit of content of a metadata meta-block.
+const maxMetadataSize = 1 << 24
+
+var errIndexTooLarge = errors.New("cbrotli: too many frames for seekable index; increase ChunkSize")
+
+// seekableFrame is an index entry.
+type seekableFrame struct {
+	compressed, uncompressed int64
+}
+
+// SeekableWriter implements io.WriteCloser by writing a seekable Brotli stream
+// (see SeekableReader) to an underlying Writer.
+//
+// Input is cut into frames of WriterOptions.ChunkSize bytes that are encoded
+// independently, and an index of the frames is appended on Close. Smaller
+// frames make random access cheaper: reading a byte costs decoding of up to
+// two frames' worth of data. Larger frames compress better, as matches do not
+// cross frame boundaries. The window is sized to cover a frame, unless LGWin
+// is set.
+//
+// WriterOptions.StreamOffset, WriterOptions.FlushInterval,
+// WriterOptions.SelectDictionary, WriterOptions.MaxOutputBytes and
+// WriterOptions.Progress are ignored.
+type SeekableWriter struct {
+	dst     io.Writer
+	options WriterOptions
+	frame   int
+	w       *Writer // current frame; nil between frames
+	offset  int64   // uncompressed size of finished frames
+	index   []seekableFrame
+	err     error // first error; sticky
+	closed  bool
+}
+
+// NewSeekableWriter initializes new SeekableWriter instance.
+// Close MUST be called to free resources and to write the index.
+func NewSeekableWriter(dst io.Writer, options WriterOptions) *SeekableWriter {
+	s := &SeekableWriter{dst: dst}
+	if s.err = options.validate(); s.err != nil {
+		return s
+	}
+	s.frame = options.ChunkSize
+	if s.frame == 0 {
+		s.frame = defaultFrameSize
+	}
+	if options.LGWin == 0 {
+		options.LGWin = windowBitsFor(s.frame)
+	}
+	options.SizeHint = s.frame
+	options.StreamOffset = 0
+	options.FlushInterval = 0
+	options.SelectDictionary = nil
+	options.MaxOutputBytes = 0
+	options.Progress = nil
+	s.options = options
+	return s
+}
+
+// Write implements io.Writer.
+func (s *SeekableWriter) Write(p []byte) (n int, err error) {
+	if s.closed {
+		return 0, ErrWriterClosed
+	}
+	if s.err != nil {
+		return 0, s.err
+	}
+	for len(p) > 0 {
+		if s.w == nil {
+			options := s.options
+			options.StreamOffset = s.offset
+			s.w = NewWriter(s.dst, options)
+		}
+		room := s.frame - int(s.w.Stats().BytesIn) - len(s.w.staged)
+		m, err := s.w.Write(p[:min(len(p), room)])
+		n += m
+		if err != nil {
+			s.err = err
+			return n, err
+		}
+		p = p[m:]
+		if m == room {
+			if err = s.endFrame(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// endFrame finishes the current frame at a byte boundary and records it.
+func (s *SeekableWriter) endFrame() error {
+	err := s.w.CloseAppendable()
+	stats := s.w.Stats()
+	s.w = nil
+	if err != nil {
+		s.err = err
+		return err
+	}
+	s.index = append(s.index, seekableFrame{stats.BytesOut, stats.BytesIn})
+	s.offset += stats.BytesIn
+	return nil
+}
+
+// Flush outputs encoded data for all input provided to Write; see
+// Writer.Flush. It does not end the current frame.
+func (s *SeekableWriter) Flush() error {
+	if s.closed {
+		return ErrWriterClosed
+	}
+	if s.err != nil || s.w == nil {
+		return s.err
+	}
+	if err := s.w.Flush(); err != nil {
+		s.err = err
+	}
+	return s.err
+}
+
+// Close finishes the last frame, writes the index and completes the stream.
+func (s *SeekableWriter) Close() error {
+	if s.closed {
+		return ErrWriterClosed
+	}
+	s.closed = true
+	if s.w != nil {
+		if s.err != nil {
+			s.w.Close()
+			s.w = nil
+		} else {
+			s.endFrame()
+		}
+	}
+	if s.err != nil {
+		return s.err
+	}
+	index := s.appendIndex(nil)
+	if len(index) > maxMetadataSize {
+		return errIndexTooLarge
+	}
+	options := s.options
+	options.StreamOffset = s.offset
+	w := NewWriter(s.dst, options)
+	err := w.writeMetadata(index)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// appendIndex appends the content of the index meta-block to b.
+func (s *SeekableWriter) appendIndex(b []byte) []byte {
+	for _, f := range s.index {
+		b = binary.AppendUvarint(b, uint64(f.compressed))
+		b = binary.AppendUvarint(b, uint64(f.uncompressed))
+	}
+	entries := len(b)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.index)))
+	b = binary.LittleEndian.AppendUint32(b, uint32(entries))
+	b = append(b, seekableVersion, 0, 0, 0)
+	return append(b, seekableMagic...)
+}
+
+// IndexError is returned by NewSeekableReader if the stream has no valid
+// seekable index: it was not produced by SeekableWriter, or it is truncated or
+// corrupted. Such streams can still be decoded sequentially with Reader.
+type IndexError struct {
+	Reason string
+}
+
+func (e *IndexError) Error() string {
+	return "cbrotli: no seekable index (" + e.Reason + "); decode the stream sequentially"
+}
+
+// seekableCacheSize is the number of decoded frames kept by a SeekableReader.
+const seekableCacheSize = 8
+
+// SeekableReader provides random access to the content of a stream produced by
+// SeekableWriter. It implements io.ReaderAt, which is safe for concurrent use,
+// and io.ReadSeeker, which is not.
+//
+// Only the frames that overlap a request are read and decoded; a few recently
+// decoded frames are cached.
+type SeekableReader struct {
+	src    io.ReaderAt
+	lgwin  int
+	frames []seekableFrame // absolute offsets of frames
+	ends   []int64         // uncompressed end offsets of frames
+	size   int64
+	pos    int64 // for Read and Seek
+
+	mu       sync.Mutex
+	cache    *list.List            // of *cachedFrame, most recent first
+	cached   map[int]*list.Element // by frame number
+	prefixes map[int64][]byte      // see prefix
+}
+
+type cachedFrame struct {
+	frame int
+	data  []byte
+}
+
+// NewSeekableReader parses the index of the stream of given size provided by
+// src. If the index is missing or invalid, the error is an *IndexError.
+func NewSeekableReader(src io.ReaderAt, size int64) (*SeekableReader, error) {
+	if size < seekableFooterSize+2 {
+		return nil, &IndexError{"stream too short"}
+	}
+	tail := make([]byte, seekableFooterSize+1)
+	if _, err := src.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return nil, err
+	}
+	footer := tail[:seekableFooterSize]
+	if tail[seekableFooterSize] != seekableFinal || string(footer[12:]) != seekableMagic {
+		return nil, &IndexError{"missing trailer"}
+	}
+	if footer[8] != seekableVersion {
+		return nil, &IndexError{fmt.Sprintf("unsupported version %d", footer[8])}
+	}
+	count := int64(binary.LittleEndian.Uint32(footer[0:]))
+	entriesSize := int64(binary.LittleEndian.Uint32(footer[4:]))
+	entriesStart := size - int64(len(tail)) - entriesSize
+	// Each entry takes at least 2 bytes.
+	if entriesStart < 0 || count*2 > entriesSize {
+		return nil, &IndexError{"invalid index size"}
+	}
+	entries := make([]byte, entriesSize)
+	if _, err := src.ReadAt(entries, entriesStart); err != nil {
+		return nil, err
+	}
+
+	r := &SeekableReader{
+		src:      src,
+		frames:   make([]seekableFrame, 0, count),
+		ends:     make([]int64, 0, count),
+		cache:    list.New(),
+		cached:   make(map[int]*list.Element),
+		prefixes: make(map[int64][]byte),
+	}
+	var compressed int64
+	for i := int64(0); i < count; i++ {
+		c, n := binary.Uvarint(entries)
+		if n <= 0 {
+			return nil, &IndexError{"corrupted index"}
+		}
+		entries = entries[n:]
+		u, n := binary.Uvarint(entries)
+		if n <= 0 || c == 0 || c > uint64(entriesStart) || u > 1<<62 {
+			return nil, &IndexError{"corrupted index"}
+		}
+		entries = entries[n:]
+		// Frames are stored by their start offsets.
+		r.frames = append(r.frames, seekableFrame{compressed, r.size})
+		compressed += int64(c)
+		r.size += int64(u)
+		r.ends = append(r.ends, r.size)
+		if compressed > entriesStart {
+			return nil, &IndexError{"index does not match stream"}
+		}
+	}
+	if len(entries) != 0 {
+		return nil, &IndexError{"corrupted index"}
+	}
+	r.frames = append(r.frames, seekableFrame{compressed, r.size})
+	if count != 0 {
+		header := make([]byte, 2)
+		if _, err := src.ReadAt(header, 0); err != nil {
+			return nil, err
+		}
+		if r.lgwin = windowBitsOfStream(header); r.lgwin == 0 {
+			return nil, &IndexError{"unsupported stream header"}
+		}
+	}
+	return r, nil
+}
+
+// windowBitsOfStream decodes the window size from the stream header (RFC 7932,
+// section 9.1); it returns 0 for large window streams.
+func windowBitsOfStream(header []byte) int {
+	bits := uint(header[0]) | uint(header[1])<<8
+	if bits&1 == 0 {
+		return 16
+	}
+	if n := (bits >> 1) & 7; n != 0 {
+		return 17 + int(n)
+	}
+	switch m := (bits >> 4) & 7; m {
+	case 0:
+		return 17
+	case 1:
+		return 0
+	default:
+		return 8 + int(m)
+	}
+}
+
+// Size returns the size of the uncompressed content.
+func (r *SeekableReader) Size() int64 { return r.size }
+
+// ReadAt implements io.ReaderAt.
+func (r *SeekableReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("cbrotli: negative offset")
+	}
+	for len(p) > 0 {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		k := sort.Search(len(r.ends), func(i int) bool { return r.ends[i] > off })
+		data, err := r.frame(k)
+		if err != nil {
+			return n, err
+		}
+		m := copy(p, data[off-r.frames[k].uncompressed:])
+		p = p[m:]
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+// Read implements io.Reader.
+func (r *SeekableReader) Read(p []byte) (n int, err error) {
+	if r.pos >= r.size {
+		return 0, io.EOF
+	}
+	n, err = r.ReadAt(p[:min(int64(len(p)), r.size-r.pos)], r.pos)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker.
+func (r *SeekableReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("cbrotli: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("cbrotli: negative position")
+	}
+	r.pos = offset
+	return offset, nil
+}
+
+// DecodeAllParallel decodes the whole content to dst, using up to concurrency
+// goroutines (GOMAXPROCS if not positive) that each decode one frame at a time
+// and write it at its uncompressed offset; frames are written in no particular
+// order. Memory is bounded by concurrency times the frame size. The frame
+// cache is neither used nor filled.
+//
+// DecodeAllParallel stops on the first error of decoding or of dst, and once
+// ctx is done, which does not interrupt frames already being decoded; it then
+// returns that error, or ctx.Err(). dst may have been written partially.
+func (r *SeekableReader) DecodeAllParallel(ctx context.Context, dst io.WriterAt, concurrency int) error {
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	count := len(r.frames) - 1
+	concurrency = min(concurrency, count)
+	work, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var once sync.Once
+	var failure error
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for work.Err() == nil {
+				k := int(next.Add(1) - 1)
+				if k >= count {
+					return
+				}
+				data, err := r.decodeFrame(k)
+				if err == nil {
+					_, err = dst.WriteAt(data, r.frames[k].uncompressed)
+				}
+				if err != nil {
+					once.Do(func() {
+						failure = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if failure != nil {
+		return failure
+	}
+	if int(next.Load()) < count {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// DecodeToFile truncates f to the size of the content and decodes the content
+// into it with DecodeAllParallel.
+func (r *SeekableReader) DecodeToFile(ctx context.Context, f *os.File, concurrency int) error {
+	if err := f.Truncate(r.size); err != nil {
+		return err
+	}
+	return r.DecodeAllParallel(ctx, f, concurrency)
+}
+
+// ServeSeekable replies to r with the uncompressed content of sr, like
+// http.ServeContent: the Content-Length comes from the index, and range
+// requests, single or multiple, decode only the frames they overlap, through
+// the frame cache of sr, which the ranges of a request share. Conditional
+// requests (If-Modified-Since with modtime, If-None-Match and If-Range with
+// the ETag set in the header of w) are answered as http.ServeContent
+// answers them, and so are unsatisfiable ranges, with 416. ServeSeekable
+// does not change the position of sr, and can serve concurrent requests from
+// one SeekableReader.
+//
+// Content-Type, if not set in the header of w, is sniffed from the start of
+// the content, which decodes the first frame.
+func ServeSeekable(w http.ResponseWriter, r *http.Request, sr *SeekableReader, modtime time.Time) {
+	http.ServeContent(w, r, "", modtime, io.NewSectionReader(sr, 0, sr.size))
+}
+
+// frame returns decoded frame k, from the cache if possible.
+func (r *SeekableReader) frame(k int) ([]byte, error) {
+	r.mu.Lock()
+	if e, ok := r.cached[k]; ok {
+		r.cache.MoveToFront(e)
+		r.mu.Unlock()
+		return e.Value.(*cachedFrame).data, nil
+	}
+	r.mu.Unlock()
+
+	data, err := r.decodeFrame(k)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.cached[k]; !ok {
+		r.cached[k] = r.cache.PushFront(&cachedFrame{k, data})
+		if r.cache.Len() > seekableCacheSize {
+			oldest := r.cache.Remove(r.cache.Back()).(*cachedFrame)
+			delete(r.cached, oldest.frame)
+		}
+	}
+	return data, nil
+}
+
+// decodeFrame decodes frame k on its own; see the description of the format.
+func (r *SeekableReader) decodeFrame(k int) ([]byte, error) {
+	start, end := r.frames[k], r.frames[k+1]
+	stream := make([]byte, end.compressed-start.compressed)
+	if _, err := r.src.ReadAt(stream, start.compressed); err != nil {
+		return nil, err
+	}
+	var skip int64
+	if k != 0 {
+		skip = min(start.uncompressed, int64(1)<<uint(r.lgwin)-16)
+		prefix, err := r.prefix(skip)
+		if err != nil {
+			return nil, err
+		}
+		stream = append(prefix[:len(prefix):len(prefix)], stream...)
+	}
+	d := NewReader(bytes.NewReader(stream))
+	defer d.Close()
+	if _, err := io.CopyN(io.Discard, d, skip); err != nil {
+		return nil, fmt.Errorf("cbrotli: frame %d: %w", k, err)
+	}
+	data := make([]byte, end.uncompressed-start.uncompressed)
+	if _, err := io.ReadFull(d, data); err != nil {
+		return nil, fmt.Errorf("cbrotli: frame %d: %w", k, err)
+	}
+	return data, nil
+}
+
+// prefix returns the stream header followed by size zero bytes encoded up to a
+// byte boundary.
+func (r *SeekableReader) prefix(size int64) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if p, ok := r.prefixes[size]; ok {
+		return p, nil
+	}
+	var buf bytes.Buffer
+	// Quality 2 is the fastest that does not raise the window size.
+	w := NewWriter(&buf, WriterOptions{Quality: 2, LGWin: r.lgwin})
+	_, err := w.Write(make([]byte, size))
+	if err == nil {
+		err = w.Flush()
+	}
+	w.destroy()
+	if err != nil {
+		return nil, err
+	}
+	r.prefixes[size] = buf.Bytes()
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrTooLarge is returned when a length does not fit the size types of
+// C-Brotli or Go on this platform (e.g. size_t on 32-bit platforms), instead
+// of truncating it. Readers and Writers never return it: they pass long
+// buffers to C-Brotli piecewise.
+var ErrTooLarge = errors.New("cbrotli: too large for this platform")
+
+// Limits of size_t and int; variables so that tests can emulate 32-bit
+// platforms.
+var (
+	maxSizeT = uint64(^uintptr(0)) // size_t is as wide as uintptr
+	maxInt   = uint64(math.MaxInt)
+)
+
+// maxCallSize bounds the buffers given to a single call of the C encoder or
+// decoder, so that the counts they return fit in int on every platform;
+// Read and Write process longer buffers piecewise.
+var maxCallSize = 1 << 30
+
+// checkLength checks that the length n can be passed to C-Brotli as size_t.
+func checkLength(n int64) error {
+	if n < 0 || uint64(n) > maxSizeT {
+		return fmt.Errorf("%w: length %d does not fit size_t", ErrTooLarge, n)
+	}
+	return nil
+}
+
+// checkedInt converts n, a size computed by C-Brotli, to int.
+func checkedInt(n uint64) (int, error) {
+	if n > maxInt {
+		return 0, fmt.Errorf("%w: size %d does not fit int", ErrTooLarge, n)
+	}
+	return int(n), nil
+}
+
+// callSize returns the length of the part of a buffer of length n that is
+// given to a single call of C-Brotli.
+func callSize(n int) int {
+	return min(n, maxCallSize)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// emulate32Bit sets the size limits of a 32-bit platform until the end of
+// the test.
+func emulate32Bit(t *testing.T) {
+	savedSizeT, savedInt := maxSizeT, maxInt
+	maxSizeT, maxInt = 1<<32-1, 1<<31-1
+	t.Cleanup(func() { maxSizeT, maxInt = savedSizeT, savedInt })
+}
+
+func TestSizeConversions(t *testing.T) {
+	emulate32Bit(t)
+	for _, c := range []struct {
+		n  int64
+		ok bool
+	}{
+		{0, true}, {1<<31 - 1, true}, {1 << 31, true}, {1<<32 - 1, true},
+		{1 << 32, false}, {1<<32 + 1, false}, {-1, false},
+	} {
+		if err := checkLength(c.n); (err == nil) != c.ok || (err != nil && !errors.Is(err, ErrTooLarge)) {
+			t.Errorf("checkLength(%d): %v", c.n, err)
+		}
+	}
+	for _, c := range []struct {
+		n  uint64
+		ok bool
+	}{
+		{0, true}, {1<<31 - 1, true}, {1 << 31, false}, {1<<32 - 1, false}, {1 << 32, false},
+	} {
+		got, err := checkedInt(c.n)
+		if (err == nil) != c.ok || (err != nil && !errors.Is(err, ErrTooLarge)) || (c.ok && uint64(got) != c.n) {
+			t.Errorf("checkedInt(%d) = %d, %v", c.n, got, err)
+		}
+	}
+	if err := checkDictionarySize(1<<32, DtSerialized); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("checkDictionarySize(1<<32): %v", err)
+	}
+}
+
+func TestEncodeBoundTooLarge(t *testing.T) {
+	emulate32Bit(t)
+	content := textLikeData(10000)
+	if _, ok := encodeOneShot(content, WriterOptions{Quality: 5}, nil); !ok {
+		t.Fatal("encodeOneShot failed")
+	}
+	// A bound above the limit of int makes Encode stream instead.
+	maxInt = 10000
+	if _, ok := encodeOneShot(content, WriterOptions{Quality: 5}, nil); ok {
+		t.Error("encodeOneShot succeeded with a bound above the limit of int")
+	}
+	encoded, err := Encode(content, WriterOptions{Quality: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded, err := Decode(encoded); err != nil || !bytes.Equal(decoded, content) {
+		t.Errorf("Decode: %v", err)
+	}
+}
+
+func TestPiecewiseCalls(t *testing.T) {
+	saved := maxCallSize
+	maxCallSize = 1000
+	t.Cleanup(func() { maxCallSize = saved })
+	content := textLikeData(100000)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, WriterOptions{Quality: 5})
+	if n, err := w.Write(content); n != len(content) || err != nil {
+		t.Fatalf("Write: %d, %v", n, err)
+	}
+	if err := w.writeMetadata(bytes.Repeat([]byte{'m'}, 5000)); err != nil {
+		t.Fatalf("writeMetadata: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReader(bytes.NewReader(buf.Bytes()))
+	defer r.Close()
+	p := make([]byte, len(content)+1)
+	n, err := r.Read(p)
+	if n <= 0 || n > maxCallSize || err != nil {
+		t.Fatalf("Read: %d, %v; want at most %d bytes", n, err, maxCallSize)
+	}
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded := append(p[:n], rest...); !bytes.Equal(decoded, content) {
+		t.Error("decoded content differs")
+	}
+}
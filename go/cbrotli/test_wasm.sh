@@ -0,0 +1,21 @@
+#!/bin/sh
+# Copyright 2025 Google Inc. All Rights Reserved.
+#
+# Distributed under MIT license.
+# See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+# Runs the tests of cbrotli, which builds with the pure-Go decoder there, under
+# WebAssembly: GOOS=js with Node.js, and GOOS=wasip1 with the runtime named by
+# GOWASIRUNTIME (wasmtime if unset; wazero, wasmer and wasmedge also work),
+# which must be in PATH. Extra arguments are passed to go test.
+# Run it from go/cbrotli.
+set -e
+
+wasm="$(go env GOROOT)/lib/wasm"
+if [ ! -d "$wasm" ]; then
+  # Before Go 1.24.
+  wasm="$(go env GOROOT)/misc/wasm"
+fi
+export CGO_ENABLED=0 GOARCH=wasm
+GOOS=js go test -exec="$wasm/go_js_wasm_exec" "$@" ./...
+GOOS=wasip1 go test -exec="$wasm/go_wasip1_wasm_exec" "$@" ./...
@@ -0,0 +1,142 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+// GzipError is a failure of the gzip side of a transcoding: an invalid header,
+// corrupt deflate data, a truncated member, or a trailer whose checksum or
+// size does not match the data. Errors of the underlying source and
+// destination, and EncoderError, are reported as they are, so that a damaged
+// object can be told from a failing pipeline.
+type GzipError struct {
+	Err error
+}
+
+func (e GzipError) Error() string {
+	return "cbrotli: gzip: " + e.Err.Error()
+}
+
+func (e GzipError) Unwrap() error { return e.Err }
+
+// TranscodeOptions configures TranscodeGzipToBrotliWithOptions.
+type TranscodeOptions struct {
+	// Writer configures the Brotli encoder.
+	Writer WriterOptions
+	// KeepName copies the original file name of the gzip header, if any, to
+	// a metadata meta-block at the start of the Brotli stream. Decoders skip
+	// metadata; its content is gzipNameMetadata followed by the name.
+	KeepName bool
+}
+
+// gzipNameMetadata prefixes the file name in the metadata written with
+// TranscodeOptions.KeepName.
+const gzipNameMetadata = "gzip-name:"
+
+// TranscodeGzipToBrotli decompresses the gzip stream of src, all of its
+// members, and writes the content compressed with Brotli to dst; written is
+// the number of bytes written to dst. Data is streamed through buffers of
+// fixed size, so objects of any size transcode in constant memory. Failures
+// of the gzip data are returned as GzipError. On error, the Brotli stream is
+// left unterminated, as by CompressCopy.
+func TranscodeGzipToBrotli(dst io.Writer, src io.Reader, options WriterOptions) (written int64, err error) {
+	return TranscodeGzipToBrotliWithOptions(dst, src, TranscodeOptions{Writer: options})
+}
+
+// TranscodeGzipToBrotliWithOptions is like TranscodeGzipToBrotli with
+// TranscodeOptions.
+func TranscodeGzipToBrotliWithOptions(dst io.Writer, src io.Reader, options TranscodeOptions) (written int64, err error) {
+	in := &sourceReader{r: src}
+	zr, err := gzip.NewReader(in)
+	if err == io.EOF {
+		// An empty source is not a gzip stream.
+		return 0, GzipError{io.ErrUnexpectedEOF}
+	}
+	if err != nil {
+		return 0, in.wrap(err)
+	}
+	defer zr.Close()
+
+	out := &copyOutput{w: dst}
+	w := NewWriter(out, options.Writer)
+	defer func() {
+		if err != nil {
+			out.discard = true
+		}
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		written = out.n
+	}()
+	if name := zr.Name; options.KeepName && name != "" && len(gzipNameMetadata)+len(name) <= maxMetadataSize {
+		w.mu.Lock()
+		err = w.writeMetadata([]byte(gzipNameMetadata + name))
+		w.mu.Unlock()
+		if err != nil {
+			return 0, err
+		}
+	}
+	_, err = w.ReadFrom(gzipContent{zr, in})
+	return 0, err
+}
+
+// TranscodeBrotliToGzip decodes the Brotli stream of src and writes the
+// content compressed with gzip at level (a level of compress/gzip) to dst;
+// written is the number of bytes written to dst. Like TranscodeGzipToBrotli,
+// it streams in constant memory. Invalid Brotli data fails with the errors of
+// Reader; an invalid level fails before anything is written.
+func TranscodeBrotliToGzip(dst io.Writer, src io.Reader, level int) (written int64, err error) {
+	out := &copyOutput{w: dst}
+	zw, err := gzip.NewWriterLevel(out, level)
+	if err != nil {
+		return 0, err
+	}
+	r := NewReader(src)
+	defer r.Close()
+	if _, err = io.Copy(zw, r); err != nil {
+		return out.n, err
+	}
+	err = zw.Close()
+	return out.n, err
+}
+
+// sourceReader records the errors of the source of a gzip.Reader, so that
+// they can be told from gzip errors.
+type sourceReader struct {
+	r   io.Reader
+	err error
+}
+
+func (s *sourceReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err != nil && err != io.EOF {
+		s.err = err
+	}
+	return n, err
+}
+
+// wrap returns err, an error of the gzip.Reader, as a GzipError, unless the
+// source has failed with it.
+func (s *sourceReader) wrap(err error) error {
+	if err == nil || err == io.EOF || err == s.err {
+		return err
+	}
+	return GzipError{err}
+}
+
+// gzipContent reads the content of a gzip stream and wraps its errors.
+type gzipContent struct {
+	zr *gzip.Reader
+	in *sourceReader
+}
+
+func (g gzipContent) Read(p []byte) (int, error) {
+	n, err := g.zr.Read(p)
+	return n, g.in.wrap(err)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"io"
+	"net/http"
+)
+
+// CompressingTransportOptions configures NewCompressingTransport.
+type CompressingTransportOptions struct {
+	// Writer configures the compression of request bodies.
+	Writer WriterOptions
+	// ContentTypes lists the media types of the request bodies to compress,
+	// as HTTPOptions.ContentTypes does for responses; nil means the same
+	// default. Bodies without Content-Type are sent unchanged.
+	ContentTypes []string
+	// MinSize is the size of the smallest body that is compressed; 0 means
+	// 1KiB. Bodies of unknown size are compressed.
+	MinSize int64
+}
+
+// NewCompressingTransport returns an http.RoundTripper that sends the request
+// bodies compressed with Content-Encoding "br" through base (nil means
+// http.DefaultTransport), for servers that accept compressed requests.
+//
+// The body is compressed while base sends it, so it is never held in memory
+// whole; the compressed request has no Content-Length. If the request has
+// GetBody, so has the compressed one: it compresses a new copy of the
+// original body, so that base can retry the request (e.g. HTTP/2 after a
+// GOAWAY). Redirects made by http.Client call GetBody on the original request
+// and go through the transport again.
+//
+// Requests without body, with a Content-Encoding already, or whose body is
+// too short or of a Content-Type not listed in options.ContentTypes are
+// passed through unchanged. Responses are not touched; see NewRoundTripper.
+//
+// NewCompressingTransport panics if options.Writer is invalid.
+func NewCompressingTransport(base http.RoundTripper, options CompressingTransportOptions) http.RoundTripper {
+	if err := options.Writer.validate(); err != nil {
+		panic(err)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if options.ContentTypes == nil {
+		options.ContentTypes = defaultHTTPContentTypes
+	}
+	if options.MinSize <= 0 {
+		options.MinSize = defaultHTTPMinSize
+	}
+	return &compressingTransport{base: base, options: options}
+}
+
+type compressingTransport struct {
+	base    http.RoundTripper
+	options CompressingTransportOptions
+}
+
+func (t *compressingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.compressible(req) {
+		return t.base.RoundTrip(req)
+	}
+	out := req.Clone(req.Context())
+	out.Body = t.compress(req.Body)
+	if getBody := req.GetBody; getBody != nil {
+		out.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			return t.compress(body), nil
+		}
+	}
+	out.ContentLength = -1
+	out.Header.Del("Content-Length")
+	out.Header.Set("Content-Encoding", "br")
+	return t.base.RoundTrip(out)
+}
+
+// compressible reports whether the body of req is to be compressed.
+func (t *compressingTransport) compressible(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return false
+	}
+	if req.Header.Get("Content-Encoding") != "" {
+		return false
+	}
+	// A client request with a body has ContentLength 0 if it is unknown.
+	if req.ContentLength > 0 && req.ContentLength < t.options.MinSize {
+		return false
+	}
+	contentType := req.Header.Get("Content-Type")
+	return contentType != "" && matchContentType(contentType, t.options.ContentTypes)
+}
+
+// compress returns a body that reads body compressed. Compression runs in a
+// goroutine that ends, closing body, once the stream is read to its end or
+// the returned body is closed.
+func (t *compressingTransport) compress(body io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer body.Close()
+		w := NewWriter(pw, t.options.Writer)
+		_, err := w.ReadFrom(body)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		// A failed body makes the request fail rather than end early.
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
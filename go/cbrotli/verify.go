@@ -0,0 +1,148 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// MismatchError is returned by the Verify functions when the decoded content
+// differs from the original.
+type MismatchError struct {
+	// Offset is the offset of the first differing byte or, if one content is a
+	// prefix of the other, the length of the shorter one. It is -1 if only a
+	// digest was compared, which does not locate the difference.
+	Offset int64
+	Reason string
+}
+
+func (e *MismatchError) Error() string {
+	if e.Offset < 0 {
+		return "cbrotli: round trip mismatch: " + e.Reason
+	}
+	return fmt.Sprintf("cbrotli: round trip mismatch at offset %d: %s", e.Offset, e.Reason)
+}
+
+// VerifyOptions configures VerifyRoundTripWithOptions.
+type VerifyOptions struct {
+	// Reader configures the decoding of the compressed stream, e.g. with the
+	// dictionaries it was compressed with.
+	Reader ReaderOptions
+	// SHA256, if not nil, is the expected SHA-256 digest of the decoded
+	// content. The original may then be nil.
+	SHA256 *[sha256.Size]byte
+	// BufferSize is the size of the buffers used to compare the contents; 0
+	// selects 32 KiB.
+	BufferSize int
+}
+
+// defaultVerifyBufferSize is the default VerifyOptions.BufferSize.
+const defaultVerifyBufferSize = 32 << 10
+
+// VerifyRoundTrip decodes compressed and compares the result with original,
+// chunk by chunk, and returns nil if they are equal. Neither side is kept in
+// memory: VerifyRoundTrip uses two buffers and a Reader, whatever the size of
+// the content.
+//
+// A difference is reported with a *MismatchError; read and decoding errors
+// are returned as is, errors of original wrapped.
+func VerifyRoundTrip(original, compressed io.Reader) error {
+	return VerifyRoundTripWithOptions(original, compressed, VerifyOptions{})
+}
+
+// VerifyRoundTripBytes is like VerifyRoundTrip for contents in memory.
+func VerifyRoundTripBytes(original, compressed []byte) error {
+	return VerifyRoundTrip(bytes.NewReader(original), bytes.NewReader(compressed))
+}
+
+// VerifyRoundTripWithOptions is like VerifyRoundTrip with options. If
+// options.SHA256 is set, the digest of the decoded content is checked too,
+// and original may be nil to check only the digest.
+func VerifyRoundTripWithOptions(original, compressed io.Reader, options VerifyOptions) error {
+	if original == nil && options.SHA256 == nil {
+		return errors.New("cbrotli: no original content or digest to verify against")
+	}
+	size := options.BufferSize
+	if size <= 0 {
+		size = defaultVerifyBufferSize
+	}
+	r := NewReaderWithOptions(compressed, options.Reader)
+	defer r.Close()
+	var digest hash.Hash
+	if options.SHA256 != nil {
+		digest = sha256.New()
+	}
+	decoded := make([]byte, size)
+	var want []byte
+	if original != nil {
+		want = make([]byte, size)
+	}
+	var offset int64
+	for {
+		n, err := io.ReadFull(r, decoded)
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if digest != nil {
+			digest.Write(decoded[:n])
+		}
+		if original != nil {
+			if err := compareChunk(original, decoded[:n], want[:n], offset); err != nil {
+				return err
+			}
+		}
+		offset += int64(n)
+		if err == io.EOF {
+			break
+		}
+	}
+	if original != nil {
+		// The original must end where the decoded content does.
+		m, err := io.ReadFull(original, want[:1])
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("cbrotli: reading original: %w", err)
+		}
+		if m != 0 {
+			return &MismatchError{Offset: offset, Reason: "decoded content is shorter than the original"}
+		}
+	}
+	if digest != nil {
+		var sum [sha256.Size]byte
+		digest.Sum(sum[:0])
+		if sum != *options.SHA256 {
+			return &MismatchError{Offset: -1, Reason: fmt.Sprintf(
+				"SHA-256 of the decoded content is %x, want %x", sum, *options.SHA256)}
+		}
+	}
+	return nil
+}
+
+// compareChunk reads len(decoded) bytes of original to buf and compares them
+// with decoded, which starts at offset of the content.
+func compareChunk(original io.Reader, decoded, buf []byte, offset int64) error {
+	m, err := io.ReadFull(original, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("cbrotli: reading original: %w", err)
+	}
+	for i := 0; i < m; i++ {
+		if buf[i] != decoded[i] {
+			return &MismatchError{Offset: offset + int64(i), Reason: fmt.Sprintf(
+				"decoded byte is %#02x, original %#02x", decoded[i], buf[i])}
+		}
+	}
+	if m < len(decoded) {
+		return &MismatchError{Offset: offset + int64(m), Reason: "decoded content is longer than the original"}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+package cbrotli
+
+import "fmt"
+
+// BundledVersion is the version of the C sources bundled with the package.
+const BundledVersion = "1.2.0"
+
+// Version returns the version of C-Brotli in use, e.g. "1.2.0": that of the
+// bundled sources (BundledVersion) by default, or that of the system library
+// with the libbrotli_system build tag. Without cgo, C-Brotli is not in use and
+// Version returns "".
+func Version() string {
+	v := nativeVersion()
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", v>>24, v>>12&0xFFF, v&0xFFF)
+}
@@ -3,6 +3,8 @@
 // Distributed under MIT license.
 // See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
 
+//go:build cgo
+
 package cbrotli
 
 /*
@@ -18,6 +20,7 @@ struct CompressStreamResult {
   size_t output_data_size;
   int success;
   int has_more;
+  int is_finished;
 };
 
 static struct CompressStreamResult CompressStream(
@@ -36,123 +39,624 @@ static struct CompressStreamResult CompressStream(
     result.output_data = BrotliEncoderTakeOutput(s, &result.output_data_size);
   }
   result.has_more = BrotliEncoderHasMoreOutput(s) ? 1 : 0;
+  result.is_finished = BrotliEncoderIsFinished(s) ? 1 : 0;
   return result;
 }
+
+// Returns the size of the compressed data, or 0 on failure; unlike
+// BrotliEncoderCompress, it does not take the address of a Go variable, which
+// would escape to the heap.
+static size_t CompressBuffer(int quality, int lgwin, BrotliEncoderMode mode,
+    size_t input_size, const uint8_t* input,
+    size_t output_size, uint8_t* output) {
+  size_t encoded_size = output_size;
+  if (!BrotliEncoderCompress(quality, lgwin, mode, input_size, input,
+      &encoded_size, output)) {
+    return 0;
+  }
+  return encoded_size;
+}
 */
 import "C"
 
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"log/slog"
+	"math"
 	"runtime"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
 	"unsafe"
 )
 
 // PreparedDictionary is a handle to native object.
 type PreparedDictionary struct {
-	opaque *C.BrotliEncoderPreparedDictionary
-	pinner *runtime.Pinner
-}
-
-// DictionaryType is type for shared dictionary
-type DictionaryType int
+	opaque  *C.BrotliEncoderPreparedDictionary
+	quality int    // the quality opaque is prepared for
+	data    []byte // pinned or mapped
+	kind    DictionaryType
+	pinner  *runtime.Pinner
+	unmap   func() // releases mapped data; see OpenPreparedDictionaryFile
+	id      [32]byte
 
-const (
-	// DtRaw denotes LZ77 prefix dictionary
-	DtRaw DictionaryType = 0
-	// DtSerialized denotes serialized format
-	DtSerialized DictionaryType = 1
-)
+	mu     sync.Mutex
+	more   map[int]*C.BrotliEncoderPreparedDictionary // added by Reprepare
+	users  int                                        // open Writers
+	closed bool
+}
 
 // NewPreparedDictionary prepares dictionary data for encoder.
 // Same instance can be used for multiple encoding sessions.
 // Close MUST be called to free resources.
 func NewPreparedDictionary(data []byte, dictionaryType DictionaryType, quality int) *PreparedDictionary {
-	var ptr *C.uint8_t
-	if len(data) != 0 {
-		ptr = (*C.uint8_t)(&data[0])
+	if checkDictionarySize(len(data), dictionaryType) != nil {
+		// Writers fail to attach it.
+		return &PreparedDictionary{quality: quality, kind: dictionaryType}
 	}
+	// data is not empty, so that &data[0] is valid.
 	p := new(runtime.Pinner)
 	p.Pin(&data[0])
-	d := C.BrotliEncoderPrepareDictionary(C.BrotliSharedDictionaryType(dictionaryType), C.size_t(len(data)), ptr, C.int(quality), nil, nil, nil)
+	d := C.BrotliEncoderPrepareDictionary(C.BrotliSharedDictionaryType(dictionaryType), C.size_t(len(data)), (*C.uint8_t)(&data[0]), C.int(quality), nil, nil, nil)
 	return &PreparedDictionary{
-		opaque: d,
-		pinner: p,
+		opaque:  d,
+		quality: quality,
+		data:    data,
+		kind:    dictionaryType,
+		pinner:  p,
+		id:      DictionaryID(data),
 	}
 }
 
-// Close frees C resources.
-// IMPORTANT: calling Close until all encoders that use that dictionary are closed as well will
-// cause crash.
+// PrepareDictionary is like NewPreparedDictionary, but reports invalid options
+// and data that C-Brotli can not prepare. The data is not copied; it must not
+// be modified until the dictionary is closed.
+// Close MUST be called to free resources.
+func PrepareDictionary(data []byte, dictionaryType DictionaryType, options PrepareDictionaryOptions) (*PreparedDictionary, error) {
+	if err := validateQuality(options.Quality); err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), dictionaryType); err != nil {
+		return nil, err
+	}
+	p := NewPreparedDictionary(data, dictionaryType, options.Quality)
+	if p.opaque == nil {
+		p.Close()
+		return nil, errors.New("cbrotli: dictionary can not be prepared")
+	}
+	return p, nil
+}
+
+// OpenPreparedDictionaryFile maps a dictionary file into memory read-only and
+// prepares it for encoder; the prepared dictionary refers to the mapped memory
+// rather than a copy on the heap. The file must not be modified while it is
+// mapped. See also OpenDictionaryFile.
+// Close MUST be called to free resources and unmap the file.
+func OpenPreparedDictionaryFile(path string, dictionaryType DictionaryType, quality int) (*PreparedDictionary, error) {
+	data, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), dictionaryType); err != nil {
+		unmapFile(data)
+		return nil, fmt.Errorf("cbrotli: %s: %w", path, err)
+	}
+	d := C.BrotliEncoderPrepareDictionary(C.BrotliSharedDictionaryType(dictionaryType),
+		C.size_t(len(data)), (*C.uint8_t)(unsafe.Pointer(&data[0])), C.int(quality), nil, nil, nil)
+	if d == nil {
+		unmapFile(data)
+		return nil, fmt.Errorf("cbrotli: dictionary %s can not be prepared", path)
+	}
+	return &PreparedDictionary{
+		opaque:  d,
+		quality: quality,
+		data:    data,
+		kind:    dictionaryType,
+		unmap:   func() { unmapFile(data) },
+		id:      DictionaryID(data),
+	}, nil
+}
+
+// Reprepare adds a representation of the dictionary prepared for quality,
+// without copying the dictionary data. Writers configured with that quality
+// (including after SetQuality) attach it instead of the original one; others
+// keep using the original. WriterStats.DictionaryQuality reports which one is
+// attached. It is safe to call Reprepare while Writers use the dictionary.
+func (p *PreparedDictionary) Reprepare(quality int) error {
+	if err := validateQuality(quality); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return errPreparedDictionaryClosed
+	}
+	if err := checkDictionarySize(len(p.data), p.kind); err != nil {
+		return err
+	}
+	if quality == p.quality || p.more[quality] != nil {
+		return nil
+	}
+	d := C.BrotliEncoderPrepareDictionary(C.BrotliSharedDictionaryType(p.kind),
+		C.size_t(len(p.data)), (*C.uint8_t)(unsafe.Pointer(&p.data[0])), C.int(quality), nil, nil, nil)
+	if d == nil {
+		return fmt.Errorf("cbrotli: dictionary can not be prepared for quality %d", quality)
+	}
+	if p.more == nil {
+		p.more = make(map[int]*C.BrotliEncoderPreparedDictionary)
+	}
+	p.more[quality] = d
+	return nil
+}
+
+// representation returns the native dictionary to attach to an encoder of
+// the given quality, and the quality it is prepared for.
+func (p *PreparedDictionary) representation(quality int) (*C.BrotliEncoderPreparedDictionary, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if d := p.more[quality]; d != nil {
+		return d, quality
+	}
+	return p.opaque, p.quality
+}
+
+// prefixCount returns the number of raw dictionaries in p; p may be nil.
+func (p *PreparedDictionary) prefixCount() int {
+	if p == nil {
+		return 0
+	}
+	return prefixCount(p.data, p.kind)
+}
+
+// ID returns the DictionaryID of the dictionary data, computed when the
+// dictionary was prepared.
+func (p *PreparedDictionary) ID() [32]byte { return p.id }
+
+// Close frees C resources. It fails if some Writers using the dictionary are
+// not closed yet; closing a Writer (or resetting it with ResetOptions) releases
+// the dictionary.
 func (p *PreparedDictionary) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil
+	}
+	if p.users != 0 {
+		return errPreparedDictionaryInUse
+	}
+	p.closed = true
 	// C-Brotli tolerates `nil` pointer here.
 	C.BrotliEncoderDestroyPreparedDictionary(p.opaque)
 	p.opaque = nil
-	p.pinner.Unpin()
+	for _, d := range p.more {
+		C.BrotliEncoderDestroyPreparedDictionary(d)
+	}
+	p.more = nil
+	if p.pinner != nil {
+		p.pinner.Unpin()
+	}
+	if p.unmap != nil {
+		p.unmap()
+	}
 	return nil
 }
 
-// WriterOptions configures Writer.
-type WriterOptions struct {
-	// Quality controls the compression-speed vs compression-density trade-offs.
-	// The higher the quality, the slower the compression. Range is 0 to 11.
-	Quality int
-	// LGWin is the base 2 logarithm of the sliding window size.
-	// Range is 10 to 24. 0 indicates automatic configuration based on Quality.
-	LGWin int
-	// Prepared shared dictionary
-	Dictionary *PreparedDictionary
+// acquire registers a Writer using the dictionary; it reports false if the
+// dictionary is closed.
+func (p *PreparedDictionary) acquire() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false
+	}
+	p.users++
+	return true
+}
+
+func (p *PreparedDictionary) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.users--
 }
 
 // Writer implements io.WriteCloser by writing Brotli-encoded data to an
 // underlying Writer.
+//
+// Writer methods must not be called concurrently, unless noted otherwise;
+// internal locking only serializes them with automatic flushes.
 type Writer struct {
+	mu           sync.Mutex // guards against background flushes
 	healthy      bool
+	err          error // invalid options, first error reported by dst or EncoderError; sticky
 	dst          io.Writer
 	state        *C.BrotliEncoderState
-	buf, encoded []byte
+	options      WriterOptions
+	lgwin        int
+	fast         bool // encoder is switched to MinQuality by DetectIncompressible
+	stats        WriterStats
+	unflushed    atomic.Bool // some input has been consumed since the last flush
+	timer        timer       // pending automatic flush
+	generation   int         // incremented by ResetOptions; stops stale timers
+	staged       []byte      // short writes not yet passed to the encoder
+	finished     bool        // the destroyed instance had completed the stream
+	buf, encoded []byte      // encoded is the output of closeSmall
+	pipe         *pipeline   // see WriterOptions.PipelineDepth; nil if not used
+
+	// dictionary is options.Dictionary, acquired by the Writer.
+	dictionary *PreparedDictionary
+	// dictionaries are prepared by NewWriterWithDictionaries and owned.
+	dictionaries []*PreparedDictionary
+	// selecting is set until options.SelectDictionary is called, or
+	// options.AutoMode chooses the mode, with sample, the input held until
+	// then.
+	selecting bool
+	sample    []byte
+	// reported are the counters at the last call of options.Progress.
+	reported [2]int64
+	// holding is set while the input, held in held instead of being passed
+	// to the encoder, may still be compressed by closeSmall.
+	holding bool
+	held    []byte
 }
 
-var (
-	errEncode          = errors.New("cbrotli: encode error")
-	errWriterClosed    = errors.New("cbrotli: Writer is closed")
-	errWriterUnhealthy = errors.New("cbrotli: Writer is unhealthy")
-)
+// smallStreamSize is the size of the largest stream that Close compresses
+// with a single call to the C encoder.
+const smallStreamSize = 4 << 10
 
 // NewWriter initializes new Writer instance.
 // Close MUST be called to free resources.
 func NewWriter(dst io.Writer, options WriterOptions) *Writer {
-	state := C.BrotliEncoderCreateInstance(nil, nil, nil)
-	healthy := state != nil
+	w := &Writer{}
+	// Failure is recorded in w.healthy and reported by the first Write.
+	w.init(C.BrotliEncoderCreateInstance(nil, nil, nil), dst, options)
+	return w
+}
+
+// NewWriterWithDictionaries initializes new Writer instance that uses several
+// shared dictionaries of any type; C-Brotli accepts up to MaxDictionaries LZ77
+// prefix (raw) dictionaries, including those contained in serialized ones, and
+// at most one custom static dictionary. Each match is picked from whichever
+// dictionary has it. The dictionaries are prepared by the Writer and attached
+// after options.Dictionary, in order; a Reader must be given the same
+// dictionaries in the same order (see ReaderOptions.Dictionaries). Their data
+// must not be modified until the Writer is closed.
+//
+// Preparing dictionaries is expensive; to reuse them across many streams,
+// prepare them once with NewPreparedDictionary instead.
+// Close MUST be called to free resources.
+func NewWriterWithDictionaries(dst io.Writer, options WriterOptions, dictionaries []Dictionary) *Writer {
+	w := &Writer{}
+	// Failure is reported by the first Write.
+	w.initWithDictionaries(dst, options, dictionaries)
+	return w
+}
+
+// ResetWithDictionaries discards the Writer's state and makes it equivalent to
+// the result of NewWriterWithDictionaries(dst, options, dictionaries); see
+// ResetOptions. The dictionaries attached previously are released, so a
+// pooled Writer can serve streams that use different dictionaries, or none.
+//
+// If an error is returned, the Writer is unusable, but Close MUST still be
+// called to free resources.
+func (w *Writer) ResetWithDictionaries(dst io.Writer, options WriterOptions, dictionaries []Dictionary) error {
+	return w.reset(func() error {
+		return w.initWithDictionaries(dst, options, dictionaries)
+	})
+}
+
+// initWithDictionaries prepares dictionaries and initializes a new encoder
+// instance; failures are recorded like those of init.
+func (w *Writer) initWithDictionaries(dst io.Writer, options WriterOptions, dictionaries []Dictionary) error {
+	if options.validate() != nil {
+		// Reported by init.
+		dictionaries = nil
+	}
+	err := checkDictionaryCount(options.Dictionary.prefixCount(), dictionaries)
+	for i := 0; err == nil && i < len(dictionaries); i++ {
+		d := dictionaries[i]
+		pd, prepareErr := PrepareDictionary(d.Data, d.Type, PrepareDictionaryOptions{Quality: options.Quality})
+		if prepareErr != nil {
+			w.releaseDictionaries()
+			err = fmt.Errorf("cbrotli: dictionary %d: %w", i, prepareErr)
+			break
+		}
+		w.dictionaries = append(w.dictionaries, pd)
+	}
+	initErr := w.init(C.BrotliEncoderCreateInstance(nil, nil, nil), dst, options)
+	if err != nil {
+		// Reported by the first Write, like invalid options.
+		w.healthy = false
+		w.err = err
+		w.pipe = nil
+		return err
+	}
+	return initErr
+}
+
+// releaseDictionaries releases options.Dictionary and frees the dictionaries
+// owned by the Writer; the encoder instance must be destroyed beforehand.
+func (w *Writer) releaseDictionaries() {
+	if w.dictionary != nil {
+		w.dictionary.release()
+		w.dictionary = nil
+	}
+	for _, d := range w.dictionaries {
+		d.Close()
+	}
+	w.dictionaries = nil
+}
+
+// init configures a fresh encoder instance with options.
+func (w *Writer) init(state *C.BrotliEncoderState, dst io.Writer, options WriterOptions) error {
+	w.dst = dst
+	w.err = nil
+	w.state = state
+	w.options = options
+	w.fast = false
+	w.stats = WriterStats{DictionaryQuality: -1}
+	w.unflushed.Store(false)
+	w.staged = w.staged[:0]
+	w.finished = false
+	w.selecting = options.SelectDictionary != nil || options.AutoMode
+	w.sample = nil
+	w.reported = [2]int64{}
+	// The dictionaries of NewWriterWithDictionaries are prepared by now.
+	w.holding = options.oneShot() && options.StreamOffset == 0 && len(w.dictionaries) == 0
+	w.held = w.held[:0]
+	if options.Dictionary != nil && options.Dictionary.acquire() {
+		w.dictionary = options.Dictionary
+	}
+	w.healthy = w.state != nil
+	if !w.healthy {
+		return errEncoderInit
+	}
+	count(&metrics.writerStreamsStarted, 1)
+	if w.err = options.validate(); w.err != nil {
+		return w.err
+	}
+	w.lgwin = options.windowBits()
+	if err := w.configure(options.Quality, options.StreamOffset); err != nil {
+		return err
+	}
+	if options.PipelineDepth > 0 {
+		w.pipe = newPipeline(options.PipelineDepth)
+	}
+	return nil
+}
+
+// configure sets encoder parameters; the window size is w.lgwin.
+func (w *Writer) configure(quality int, streamOffset int64) error {
+	options := &w.options
+	if C.BrotliEncoderSetParameter(
+		w.state, C.BROTLI_PARAM_QUALITY, (C.uint32_t)(quality)) == 0 {
+		w.healthy = false
+	}
 	if C.BrotliEncoderSetParameter(
-		state, C.BROTLI_PARAM_QUALITY, (C.uint32_t)(options.Quality)) == 0 {
-		healthy = false
+		w.state, C.BROTLI_PARAM_LGWIN, (C.uint32_t)(w.lgwin)) == 0 {
+		w.healthy = false
 	}
-	if options.LGWin > 0 {
+	if options.SizeHint > 0 {
+		// C-Brotli does not distinguish sizes above 1GiB.
+		sizeHint := min(options.SizeHint, 1<<30)
 		if C.BrotliEncoderSetParameter(
-			state, C.BROTLI_PARAM_LGWIN, (C.uint32_t)(options.LGWin)) == 0 {
-			healthy = false
+			w.state, C.BROTLI_PARAM_SIZE_HINT, (C.uint32_t)(sizeHint)) == 0 {
+			w.healthy = false
 		}
 	}
+	if streamOffset > 0 {
+		// Values of at least the window size have the same effect; C-Brotli
+		// rejects values above 1GiB.
+		offset := min(streamOffset, 1<<30)
+		if C.BrotliEncoderSetParameter(
+			w.state, C.BROTLI_PARAM_STREAM_OFFSET, (C.uint32_t)(offset)) == 0 {
+			w.healthy = false
+		}
+	}
+	if mode := w.mode(); mode != C.BROTLI_MODE_GENERIC && C.BrotliEncoderSetParameter(
+		w.state, C.BROTLI_PARAM_MODE, C.uint32_t(mode)) == 0 {
+		w.healthy = false
+	}
 	if options.Dictionary != nil {
-		if C.BrotliEncoderAttachPreparedDictionary(state, options.Dictionary.opaque) == 0 {
-			healthy = false
+		d, dictionaryQuality := options.Dictionary.representation(quality)
+		// d is nil if the dictionary is closed or invalid; C-Brotli does not
+		// check.
+		if d == nil || C.BrotliEncoderAttachPreparedDictionary(w.state, d) == 0 {
+			w.healthy = false
 		}
+		w.stats.DictionaryQuality = dictionaryQuality
 	}
-	return &Writer{
-		healthy: healthy,
-		dst:     dst,
-		state:   state,
+	for _, d := range w.dictionaries {
+		if C.BrotliEncoderAttachPreparedDictionary(w.state, d.opaque) == 0 {
+			w.healthy = false
+		}
 	}
+	if !w.healthy {
+		return errEncoderInit
+	}
+	return nil
 }
 
-func (w *Writer) writeChunk(p []byte, op C.BrotliEncoderOperation) (n int, err error) {
+// restart continues the stream with a new encoder instance configured with
+// quality. C-Brotli does not allow changing parameters after the encoder has
+// started, so the current instance is flushed and the new one is told the
+// offset of the stream, so that it omits the header.
+func (w *Writer) restart(quality int) error {
+	if w.stats.BytesIn != 0 {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.destroy()
+	w.state = C.BrotliEncoderCreateInstance(nil, nil, nil)
+	if w.state == nil {
+		w.healthy = false
+		// Do not report the Writer as closed.
+		w.err = errEncoderInit
+		return errEncoderInit
+	}
+	return w.configure(quality, w.options.StreamOffset+w.stats.BytesIn)
+}
+
+// SetQuality changes the compression quality of the rest of the stream.
+//
+// C-Brotli does not allow changing parameters once the encoder has received
+// input, so after the first Write the Writer flushes the stream and continues
+// it with a new encoder instance. The change thus costs what a Flush costs,
+// and the new encoder does not refer to data written before the change (the
+// window starts empty), which reduces the ratio of streams that switch often.
+// The window size and the dictionary are retained.
+func (w *Writer) SetQuality(quality int) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := validateQuality(quality); err != nil {
+		return err
+	}
+	if err := w.syncPipeline(); err != nil {
+		return err
+	}
+	if w.state == nil {
+		return ErrWriterClosed
+	}
+	if w.err != nil {
+		return w.err
+	}
 	if !w.healthy {
-		return 0, errWriterUnhealthy
+		return errWriterUnhealthy
+	}
+	if quality == w.options.Quality {
+		return nil
+	}
+	// Buffered data belongs to the part of the stream before the change.
+	if err := w.drain(); err != nil {
+		return err
+	}
+	w.options.Quality = quality
+	if w.fast {
+		// DetectIncompressible has already switched to MinQuality; the new
+		// quality takes effect with the next compressible chunk.
+		if quality == MinQuality {
+			w.fast = false
+		}
+		return nil
+	}
+	return w.restart(quality)
+}
+
+// destroy frees the encoder instance, remembering whether it has completed the
+// stream. It is a no-op if there is no instance.
+func (w *Writer) destroy() {
+	if w.state == nil {
+		return
+	}
+	w.finished = w.isFinished()
+	C.BrotliEncoderDestroyInstance(w.state)
+	w.state = nil
+	count(&metrics.writerStreamsEnded, 1)
+}
+
+// Finished reports whether the stream is complete, i.e. whether Close has
+// delivered the final bytes of the stream to the destination. It may be called
+// after errors, including after a failed Close; it is false for streams closed
+// with CloseAppendable, and for streams whose destination has failed.
+func (w *Writer) Finished() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.syncPipeline()
+	if w.state == nil {
+		return w.finished
+	}
+	return w.isFinished()
+}
+
+func (w *Writer) isFinished() bool {
+	// Output taken from the encoder is lost if dst has failed.
+	return w.err == nil && C.BrotliEncoderIsFinished(w.state) != 0
+}
+
+// HasMoreOutput reports whether the encoder holds output it has not handed to
+// the Writer yet; this can be the case after an encoding error. It is false
+// once the encoder instance is freed by Close, CloseAppendable or ResetOptions.
+func (w *Writer) HasMoreOutput() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.syncPipeline()
+	if w.state == nil {
+		return false
+	}
+	return C.BrotliEncoderHasMoreOutput(w.state) != 0
+}
+
+// WindowBits returns the base 2 logarithm of the window size used by the
+// Writer; useful when it was chosen automatically.
+func (w *Writer) WindowBits() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lgwin
+}
+
+// ResetOptions discards the Writer's state and makes it equivalent to the
+// result of NewWriter(dst, options), so that a Writer can be reused (e.g. via
+// sync.Pool) with different settings. It can be called on an open or a closed
+// Writer; any data not yet flushed by an open Writer is discarded.
+//
+// C-Brotli does not allow changing parameters of an encoder that has already
+// consumed input, so a new native encoder instance is created. Consequently,
+// the dictionaries attached previously are released (including those prepared
+// by NewWriterWithDictionaries) and only options.Dictionary (if any) is
+// attached to the new instance; to attach more, use ResetWithDictionaries.
+//
+// If an error is returned, the Writer is unusable, but Close MUST still be
+// called to free resources.
+func (w *Writer) ResetOptions(dst io.Writer, options WriterOptions) error {
+	return w.reset(func() error {
+		return w.init(C.BrotliEncoderCreateInstance(nil, nil, nil), dst, options)
+	})
+}
+
+// reset discards the state of the Writer and initializes it with init. The
+// failure of the discarded stream, if any, is logged once the lock is released.
+func (w *Writer) reset(init func() error) error {
+	failure, stats, err := w.resetLocked(init)
+	if failure != nil {
+		logEvent(slog.LevelDebug, "cbrotli: Writer reset after an error",
+			"error", failure, "bytes_in", stats.BytesIn, "bytes_out", stats.BytesOut)
 	}
+	return err
+}
+
+func (w *Writer) resetLocked(init func() error) (failure error, stats WriterStats, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stopPipeline()
+	failure, stats = w.err, w.stats
+	w.stopTimer()
+	w.generation++
+	w.destroy()
+	w.releaseDictionaries()
+	return failure, stats, init()
+}
+
+// check returns the error that stops the Writer from encoding, if any.
+func (w *Writer) check() error {
 	if w.state == nil {
-		return 0, errWriterClosed
+		return ErrWriterClosed
+	}
+	// Once dst has failed, compressing more data is pointless.
+	if w.err != nil {
+		return w.err
+	}
+	if !w.healthy {
+		return errWriterUnhealthy
+	}
+	return nil
+}
+
+func (w *Writer) writeChunk(p []byte, op C.BrotliEncoderOperation) (n int, err error) {
+	if err := w.check(); err != nil {
+		return 0, err
 	}
 
 	for {
@@ -160,12 +664,21 @@ func (w *Writer) writeChunk(p []byte, op C.BrotliEncoderOperation) (n int, err e
 		if len(p) != 0 {
 			data = (*C.uint8_t)(&p[0])
 		}
-		result := C.CompressStream(w.state, op, data, C.size_t(len(p)))
+		size := len(p)
+		if op == C.BROTLI_OPERATION_PROCESS {
+			// Metadata must be given whole; it is short.
+			size = callSize(size)
+		}
+		result := C.CompressStream(w.state, op, data, C.size_t(size))
 		if result.success == 0 {
-			return n, errEncode
+			return n, w.encoderError(op, "the encoder failed")
 		}
 		p = p[int(result.bytes_consumed):]
 		n += int(result.bytes_consumed)
+		w.stats.BytesIn += int64(result.bytes_consumed)
+		if op != C.BROTLI_OPERATION_EMIT_METADATA {
+			count(&metrics.encoderBytesIn, int64(result.bytes_consumed))
+		}
 
 		length := int(result.output_data_size)
 		if length != 0 {
@@ -174,15 +687,119 @@ func (w *Writer) writeChunk(p []byte, op C.BrotliEncoderOperation) (n int, err e
 			// TODO(eustas): use natural wrapper, when it becomes available, see
 			//               https://golang.org/issue/13656.
 			output := (*[1 << 30]byte)(unsafe.Pointer(result.output_data))[:length:length]
-			_, err = w.dst.Write(output)
-			if err != nil {
+			if budget := w.options.MaxOutputBytes; budget > 0 && w.stats.BytesOut+int64(length) > budget {
+				return n, w.exceedBudget()
+			}
+			if err = w.writeOutput(output); err != nil {
+				w.err = err
+				count(&metrics.destinationErrors, 1)
 				return n, err
 			}
 		}
+		// Metadata is not input; writeMetadata corrects the counter later.
+		if w.options.Progress != nil && op != C.BROTLI_OPERATION_EMIT_METADATA {
+			w.reportProgress(false)
+		}
 		if len(p) == 0 && result.has_more == 0 {
-			return n, nil
+			// FINISH must be repeated until the stream is complete; this
+			// guarantees that even an empty stream gets its final bytes.
+			if op != C.BROTLI_OPERATION_FINISH || result.is_finished != 0 {
+				return n, nil
+			}
+			if length == 0 && result.bytes_consumed == 0 {
+				// No progress; do not spin on a broken encoder.
+				return n, w.encoderError(op, "the encoder made no progress")
+			}
+		}
+	}
+}
+
+var operationNames = map[C.BrotliEncoderOperation]string{
+	C.BROTLI_OPERATION_PROCESS:       "process",
+	C.BROTLI_OPERATION_FLUSH:         "flush",
+	C.BROTLI_OPERATION_FINISH:        "finish",
+	C.BROTLI_OPERATION_EMIT_METADATA: "emit metadata",
+}
+
+// encoderError makes the Writer unusable with an EncoderError.
+func (w *Writer) encoderError(op C.BrotliEncoderOperation, reason string) error {
+	quality := w.options.Quality
+	if w.fast {
+		quality = MinQuality
+	}
+	w.healthy = false
+	count(&metrics.encoderErrors, 1)
+	w.err = EncoderError{
+		Op:       operationNames[op],
+		Reason:   reason,
+		BytesIn:  w.stats.BytesIn,
+		BytesOut: w.stats.BytesOut,
+		Quality:  quality,
+		LGWin:    w.lgwin,
+	}
+	return w.err
+}
+
+// exceedBudget fails the Writer with ErrOutputBudgetExceeded and frees its
+// resources, as Close does.
+func (w *Writer) exceedBudget() error {
+	w.err = ErrOutputBudgetExceeded
+	w.destroy()
+	w.releaseDictionaries()
+	return w.err
+}
+
+// reportProgress calls options.Progress if the counters have advanced enough,
+// or at all if final is set.
+func (w *Writer) reportProgress(final bool) {
+	current := [2]int64{w.stats.BytesIn, w.stats.BytesOut}
+	if current == w.reported {
+		return
+	}
+	if !final && current[0]-w.reported[0] < progressInterval && current[1]-w.reported[1] < progressInterval {
+		return
+	}
+	w.reported = current
+	w.options.Progress(current[0], current[1])
+}
+
+// writeOutput sends encoded bytes to the destination. Destinations that accept
+// only a part of the data are retried with the remainder; a write that makes
+// no progress without reporting an error is turned into io.ErrShortWrite.
+func (w *Writer) writeOutput(output []byte) error {
+	for len(output) > 0 {
+		m, err := w.dst.Write(output)
+		if err != nil {
+			return err
+		}
+		if m <= 0 || m > len(output) {
+			return io.ErrShortWrite
 		}
+		w.stats.BytesOut += int64(m)
+		count(&metrics.encoderBytesOut, int64(m))
+		output = output[m:]
 	}
+	return nil
+}
+
+// writeMetadata emits a metadata meta-block, which decoders skip, with content
+// p; the stream is flushed first.
+func (w *Writer) writeMetadata(p []byte) error {
+	if err := w.syncPipeline(); err != nil {
+		return err
+	}
+	if err := w.drain(); err != nil {
+		return err
+	}
+	n, err := w.writeChunk(p, C.BROTLI_OPERATION_EMIT_METADATA)
+	// Metadata is not part of the uncompressed stream.
+	w.stats.BytesIn -= int64(n)
+	if err != nil {
+		return err
+	}
+	// C-Brotli leaves the metadata workflow on a call without input.
+	_, err = w.writeChunk(nil, C.BROTLI_OPERATION_EMIT_METADATA)
+	return err
 }
 
 // Flush outputs encoded data for all input provided to Write. The resulting
@@ -190,33 +807,658 @@ func (w *Writer) writeChunk(p []byte, op C.BrotliEncoderOperation) (n int, err e
 // not yet complete until after Close.
 // Flush has a negative impact on compression.
 func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.syncPipeline(); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+func (w *Writer) flush() error {
+	if err := w.drain(); err != nil {
+		return err
+	}
 	_, err := w.writeChunk(nil, C.BROTLI_OPERATION_FLUSH)
+	if err == nil {
+		w.unflushed.Store(false)
+	}
 	return err
 }
 
+// armTimer schedules an automatic flush, unless one is already pending.
+func (w *Writer) armTimer() {
+	if w.options.FlushInterval <= 0 || w.timer != nil || !w.unflushed.Load() {
+		return
+	}
+	generation := w.generation
+	w.timer = afterFunc(w.options.FlushInterval, func() {
+		w.autoFlush(generation)
+	})
+}
+
+func (w *Writer) stopTimer() {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+}
+
+// autoFlush is invoked by the timer armed by armTimer.
+func (w *Writer) autoFlush(generation int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if generation != w.generation {
+		return
+	}
+	w.timer = nil
+	if w.syncPipeline() != nil {
+		// Reported by the next call.
+		return
+	}
+	if w.state == nil || !w.unflushed.Load() {
+		return
+	}
+	if err := w.flush(); err != nil && w.err == nil {
+		// Make the error sticky, so that it is reported by the next call.
+		w.err = err
+	}
+}
+
 // Close flushes remaining data to the decorated writer and frees C resources.
+// Native resources are released even if Close returns an error; any further
+// calls return ErrWriterClosed.
+//
+// If writing to the decorated writer has failed before, Close (like Write and
+// Flush) returns that error without invoking the encoder.
+//
+// A stream of at most 4KiB that has not been flushed is compressed in one go,
+// like Encode does it: with the exact size hint and, unless LGWin is set, the
+// smallest window that covers it, which is faster and smaller than streaming.
+// The Writer holds such input until Close; Stats count it as consumed. This
+// does not apply to Writers with dictionaries, Progress, MaxOutputBytes or
+// StreamOffset.
 func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stopTimer()
 	// If stream is already closed, it is reported by `writeChunk`.
-	_, err := w.writeChunk(nil, C.BROTLI_OPERATION_FINISH)
-	// C-Brotli tolerates `nil` pointer here.
-	C.BrotliEncoderDestroyInstance(w.state)
-	w.state = nil
+	err := w.stopPipeline()
+	if err == nil {
+		err = w.unstage()
+	}
+	small := false
+	if err == nil {
+		small, err = w.closeSmall()
+	}
+	if err == nil && !small {
+		err = w.release()
+	}
+	if err == nil && !small {
+		_, err = w.writeChunk(nil, C.BROTLI_OPERATION_FINISH)
+	}
+	if err == nil && w.options.Progress != nil {
+		w.reportProgress(true)
+	}
+	w.destroy()
+	if small {
+		w.finished = w.err == nil
+	}
+	w.releaseDictionaries()
+	return err
+}
+
+// closeSmall compresses the held input with a single call to the C encoder
+// and writes it to dst. It reports false, with nothing written, if there is
+// no held input or the encoder fails; Close then streams it.
+func (w *Writer) closeSmall() (bool, error) {
+	if !w.holding || len(w.held) == 0 {
+		return false, nil
+	}
+	if err := w.check(); err != nil {
+		return false, err
+	}
+	options := w.options
+	options.SizeHint = len(w.held)
+	bound := compressBound(len(w.held))
+	if cap(w.encoded) < bound {
+		w.encoded = make([]byte, bound)
+	}
+	n, ok := compressBuffer(w.encoded[:bound], w.held, options.Quality, options.windowBits(), w.mode())
+	if !ok {
+		return false, nil
+	}
+	w.holding = false
+	w.lgwin = options.windowBits()
+	count(&metrics.encoderBytesIn, int64(len(w.held)))
+	if err := w.writeOutput(w.encoded[:n]); err != nil {
+		w.err = err
+		count(&metrics.destinationErrors, 1)
+		return true, err
+	}
+	return true, nil
+}
+
+// CloseAppendable flushes remaining data to the decorated writer and frees C
+// resources, like Close, but does not mark the stream as finished. The output
+// ends at a byte boundary, so that a new Writer with StreamOffset set to the
+// total number of bytes written so far (including previous StreamOffset) can
+// append more data; eventually the last Writer should be finished with Close.
+//
+// Data written before CloseAppendable is decodable, but the stream is
+// incomplete: a Reader returns all that data and then ErrTruncated.
+func (w *Writer) CloseAppendable() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stopTimer()
+	err := w.stopPipeline()
+	if err == nil {
+		err = w.flush()
+	}
+	if err == nil && w.options.Progress != nil {
+		w.reportProgress(true)
+	}
+	w.destroy()
+	w.releaseDictionaries()
 	return err
 }
 
 // Write implements io.Writer. Flush or Close must be called to ensure that the
 // encoded bytes are actually flushed to the underlying Writer.
 func (w *Writer) Write(p []byte) (n int, err error) {
-	return w.writeChunk(p, C.BROTLI_OPERATION_PROCESS)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.pipe != nil {
+		n, err = w.enqueue(p)
+	} else {
+		n, err = w.consume(p)
+	}
+	if n > 0 {
+		w.unflushed.Store(true)
+		w.armTimer()
+	}
+	return n, err
+}
+
+// consume passes p to the encoder, or holds it in the sample for
+// SelectDictionary and AutoMode or in the write buffer.
+func (w *Writer) consume(p []byte) (n int, err error) {
+	if w.selecting && w.state != nil && w.err == nil && w.healthy {
+		return w.collectSample(p)
+	}
+	return w.stage(p)
+}
+
+// stage accumulates short writes in w.staged; see WriteBufferSize.
+func (w *Writer) stage(p []byte) (n int, err error) {
+	size := w.options.WriteBufferSize
+	if size <= 0 || w.state == nil || w.err != nil || !w.healthy {
+		// Errors are reported by writeChunk.
+		return w.write(p)
+	}
+	if len(w.staged)+len(p) > size {
+		if err = w.unstage(); err != nil {
+			return 0, err
+		}
+		if len(p) >= size {
+			return w.write(p)
+		}
+	}
+	if w.staged == nil {
+		w.staged = make([]byte, 0, size)
+	}
+	w.staged = append(w.staged, p...)
+	return len(p), nil
+}
+
+// collectSample holds input for SelectDictionary and AutoMode until the sample
+// is complete.
+func (w *Writer) collectSample(p []byte) (n int, err error) {
+	size := w.options.SelectionSampleSize
+	if size == 0 {
+		size = defaultSelectionSampleSize
+	}
+	n = min(len(p), size-len(w.sample))
+	w.sample = append(w.sample, p[:n]...)
+	if len(w.sample) < size {
+		return n, nil
+	}
+	if err = w.selectDictionary(); err != nil {
+		return n, err
+	}
+	m, err := w.stage(p[n:])
+	return n + m, err
+}
+
+// selectDictionary calls options.SelectDictionary, attaches the dictionary it
+// returns, chooses the mode for options.AutoMode and passes the sample on.
+func (w *Writer) selectDictionary() error {
+	w.selecting = false
+	sample := w.sample
+	w.sample = nil
+	if w.options.AutoMode && w.state != nil && w.err == nil && w.healthy {
+		mode := chooseMode(sample)
+		w.stats.ModeChosen = true
+		w.stats.TextMode = mode == C.BROTLI_MODE_TEXT
+		w.stats.FontMode = mode == C.BROTLI_MODE_FONT
+		// Nothing has been compressed, so the encoder accepts the mode.
+		if mode != C.BROTLI_MODE_GENERIC && C.BrotliEncoderSetParameter(
+			w.state, C.BROTLI_PARAM_MODE, C.uint32_t(mode)) == 0 {
+			w.healthy = false
+		}
+	}
+	if w.options.SelectDictionary != nil && w.state != nil && w.err == nil && w.healthy {
+		if d := w.options.SelectDictionary(sample); d != nil {
+			w.options.Dictionary = d
+			if d.acquire() {
+				w.dictionary = d
+			}
+			// Nothing has been compressed, so the stream does not change.
+			if err := w.restart(w.options.Quality); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := w.stage(sample)
+	return err
+}
+
+// drain passes buffered writes and the held input to the encoder.
+func (w *Writer) drain() error {
+	if err := w.unstage(); err != nil {
+		return err
+	}
+	return w.release()
+}
+
+// unstage passes buffered writes on, to the encoder or to the held input.
+func (w *Writer) unstage() error {
+	if w.selecting {
+		return w.selectDictionary()
+	}
+	if len(w.staged) == 0 {
+		return nil
+	}
+	p := w.staged
+	// Reentrant calls (via restart) find the buffer empty.
+	w.staged = w.staged[:0]
+	_, err := w.write(p)
+	return err
+}
+
+// release passes the held input to the encoder and ends holding, when the
+// stream is flushed or grows beyond smallStreamSize.
+func (w *Writer) release() error {
+	if !w.holding {
+		return nil
+	}
+	w.holding = false
+	if len(w.held) == 0 {
+		return nil
+	}
+	// writeChunk counts the input again.
+	w.stats.BytesIn -= int64(len(w.held))
+	_, err := w.write(w.held)
+	w.held = w.held[:0]
+	return err
+}
+
+func (w *Writer) write(p []byte) (n int, err error) {
+	if w.holding && w.state != nil && w.err == nil && w.healthy {
+		if len(w.held)+len(p) <= smallStreamSize {
+			w.held = append(w.held, p...)
+			// Counted as consumed, as by the streaming encoder, which
+			// produces no output for so little input before Close either.
+			w.stats.BytesIn += int64(len(p))
+			return len(p), nil
+		}
+		if err = w.release(); err != nil {
+			return 0, err
+		}
+	}
+	if !w.options.DetectIncompressible || w.options.Quality == MinQuality {
+		return w.writeChunk(p, C.BROTLI_OPERATION_PROCESS)
+	}
+	for len(p) > 0 {
+		chunk := p[:min(len(p), entropySampleChunk)]
+		// Short chunks do not give a reliable estimate; keep current mode.
+		if len(chunk) >= minEntropySample && w.state != nil && w.err == nil && w.healthy {
+			if fast := looksIncompressible(chunk); fast != w.fast {
+				quality := w.options.Quality
+				if fast {
+					quality = MinQuality
+				}
+				if err = w.restart(quality); err != nil {
+					return n, err
+				}
+				w.fast = fast
+			}
+		}
+		m, err := w.writeChunk(chunk, C.BROTLI_OPERATION_PROCESS)
+		n += m
+		if w.fast {
+			w.stats.IncompressibleBytes += int64(m)
+		}
+		if err != nil {
+			return n, err
+		}
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+const (
+	// entropySampleChunk is the granularity of DetectIncompressible decisions.
+	entropySampleChunk = 64 * 1024
+	// minEntropySample is the shortest chunk DetectIncompressible classifies.
+	minEntropySample = 4 * 1024
+	// maxEntropySamples limits the number of bytes inspected per chunk.
+	maxEntropySamples = 4 * 1024
+	// incompressibleEntropy is the order-0 entropy (in bits per byte) above
+	// which data is considered incompressible. Sampling 4096 bytes of random
+	// data yields ~7.95 bits; English text is ~4.5 bits.
+	incompressibleEntropy = 7.8
+)
+
+// looksIncompressible estimates order-0 entropy of (a sample of) data.
+func looksIncompressible(data []byte) bool {
+	var histogram [256]int
+	stride := max(1, len(data)/maxEntropySamples)
+	total := 0
+	for i := 0; i < len(data); i += stride {
+		histogram[data[i]]++
+		total++
+	}
+	entropy := 0.0
+	for _, count := range histogram {
+		if count != 0 {
+			p := float64(count) / float64(total)
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy > incompressibleEntropy
+}
+
+// mode returns the encoder mode chosen by options.AutoMode.
+func (w *Writer) mode() C.BrotliEncoderMode {
+	switch {
+	case w.stats.FontMode:
+		return C.BROTLI_MODE_FONT
+	case w.stats.TextMode:
+		return C.BROTLI_MODE_TEXT
+	}
+	return C.BROTLI_MODE_GENERIC
+}
+
+// chooseMode returns the encoder mode for a stream starting with sample.
+func chooseMode(sample []byte) C.BrotliEncoderMode {
+	switch {
+	case looksLikeFont(sample):
+		return C.BROTLI_MODE_FONT
+	case looksLikeText(sample):
+		return C.BROTLI_MODE_TEXT
+	}
+	return C.BROTLI_MODE_GENERIC
+}
+
+// sfntTags are the tags that start TrueType and OpenType fonts.
+var sfntTags = [][]byte{{0, 1, 0, 0}, []byte("OTTO"), []byte("true")}
+
+// looksLikeFont reports whether sample starts with the header of a font
+// collection, or of an sfnt font with a plausible number of tables.
+func looksLikeFont(sample []byte) bool {
+	if len(sample) < 12 {
+		return false
+	}
+	if bytes.HasPrefix(sample, []byte("ttcf")) {
+		return true
+	}
+	for _, tag := range sfntTags {
+		if bytes.HasPrefix(sample, tag) {
+			tables := int(sample[4])<<8 | int(sample[5])
+			return tables > 0 && tables < 256
+		}
+	}
+	return false
+}
+
+// looksLikeText reports whether sample is valid UTF-8, but for a rune cut at
+// its end, with at most 1% of control characters other than whitespace.
+func looksLikeText(sample []byte) bool {
+	if len(sample) == 0 {
+		return false
+	}
+	// The sample may end in the middle of a rune.
+	for i := 1; i <= min(len(sample), utf8.UTFMax-1); i++ {
+		if utf8.RuneStart(sample[len(sample)-i]) {
+			if !utf8.FullRune(sample[len(sample)-i:]) {
+				sample = sample[:len(sample)-i]
+			}
+			break
+		}
+	}
+	if !utf8.Valid(sample) {
+		return false
+	}
+	controls := 0
+	for _, b := range sample {
+		if (b < 0x20 && b != '\t' && b != '\n' && b != '\r' && b != '\f') || b == 0x7f {
+			controls++
+		}
+	}
+	return controls*100 <= len(sample)
+}
+
+// Stats returns the activity counters of the Writer. Counters are reset by
+// ResetOptions.
+func (w *Writer) Stats() WriterStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.syncPipeline()
+	return w.stats
+}
+
+// WriteString implements io.StringWriter.
+func (w *Writer) WriteString(s string) (n int, err error) {
+	// The encoder only reads its input, and staged writes are copied, so s
+	// is passed without the copy of a conversion.
+	return w.Write(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// ReadFrom implements io.ReaderFrom. It reads src until io.EOF and feeds the
+// data to the encoder; io.EOF is not reported as an error.
+func (w *Writer) ReadFrom(src io.Reader) (n int64, err error) {
+	w.mu.Lock()
+	// The state belongs to the goroutine of the pipeline if there is one.
+	closed := w.pipe == nil && w.state == nil
+	w.mu.Unlock()
+	if closed {
+		return 0, ErrWriterClosed
+	}
+	if w.buf == nil {
+		w.buf = make([]byte, readBufSize)
+	}
+	for {
+		m, readErr := src.Read(w.buf)
+		if m > 0 {
+			written, err := w.Write(w.buf[:m])
+			n += int64(written)
+			if err != nil {
+				return n, err
+			}
+		}
+		if readErr == io.EOF {
+			return n, nil
+		}
+		if readErr != nil {
+			return n, readErr
+		}
+	}
 }
 
 // Encode returns content encoded with Brotli.
 func Encode(content []byte, options WriterOptions) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := NewWriter(&buf, options)
-	_, err := writer.Write(content)
-	if closeErr := writer.Close(); err == nil {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	if options.SizeHint == 0 {
+		options.SizeHint = len(content)
+	}
+	// Empty input takes the streaming path, so that the result is the same as
+	// the output of a Writer that is closed without writing.
+	if options.oneShot() && len(content) != 0 {
+		if encoded, ok := encodeOneShot(content, options, nil); ok {
+			return encoded, nil
+		}
+	}
+	b, _ := bufferWriterPool.Get().(*BufferWriter)
+	if b == nil {
+		count(&metrics.poolMisses, 1)
+		b = NewBufferWriter(options)
+	} else {
+		count(&metrics.poolHits, 1)
+		b.ResetOptions(options)
+	}
+	defer bufferWriterPool.Put(b)
+	_, err := b.Write(content)
+	if closeErr := b.Close(); err == nil {
+		err = closeErr
+	}
+	// The buffer goes back to the pool.
+	return bytes.Clone(b.out.buf), err
+}
+
+// bufferWriterPool keeps output buffers of the streaming path of Encode.
+var bufferWriterPool sync.Pool // *BufferWriter
+
+// compressBound returns the largest size of the Brotli stream of n bytes, or
+// 0 if it does not fit int.
+func compressBound(n int) int {
+	if checkLength(int64(n)) != nil {
+		return 0
+	}
+	bound, err := checkedInt(uint64(C.BrotliEncoderMaxCompressedSize(C.size_t(n))))
+	if err != nil {
+		return 0
+	}
+	return bound
+}
+
+// encodeOneShot compresses content with a single call to the C encoder; the
+// result is stored in scratch if it is large enough.
+// It returns false if the output buffer size can not be computed, or if the
+// encoder fails; Encode then falls back to streaming to report the error.
+func encodeOneShot(content []byte, options WriterOptions, scratch []byte) ([]byte, bool) {
+	if checkLength(int64(len(content))) != nil {
+		return nil, false
+	}
+	// The bound of inputs near the limit of int may exceed it.
+	bound, err := checkedInt(uint64(C.BrotliEncoderMaxCompressedSize(C.size_t(len(content)))))
+	if err != nil || bound == 0 {
+		return nil, false
+	}
+	encoded := scratch[:cap(scratch)]
+	if len(encoded) < bound {
+		encoded = make([]byte, bound)
+	}
+	n, ok := compressInto(encoded, content, options)
+	return encoded[:n], ok
+}
+
+// compressInto compresses content, which is not empty, into dst with a single
+// call to the C encoder. It returns false if the encoder fails, which it does
+// if dst is too small.
+func compressInto(dst, content []byte, options WriterOptions) (int, bool) {
+	var mode C.BrotliEncoderMode = C.BROTLI_MODE_GENERIC
+	if options.AutoMode {
+		size := options.SelectionSampleSize
+		if size == 0 {
+			size = defaultSelectionSampleSize
+		}
+		mode = chooseMode(content[:min(len(content), size)])
+	}
+	n, ok := compressBuffer(dst, content, options.Quality, options.windowBits(), mode)
+	if ok && metricsEnabled.Load() {
+		metrics.encoderBytesIn.Add(int64(len(content)))
+		metrics.encoderBytesOut.Add(int64(n))
+	}
+	return n, ok
+}
+
+// compressBuffer is compressInto with explicit encoder parameters, and
+// without counting metrics.
+func compressBuffer(dst, content []byte, quality, lgwin int, mode C.BrotliEncoderMode) (int, bool) {
+	if len(dst) == 0 || checkLength(int64(len(content))) != nil {
+		return 0, false
+	}
+	encodedSize := C.CompressBuffer(C.int(quality), C.int(lgwin),
+		mode, C.size_t(len(content)), (*C.uint8_t)(&content[0]),
+		C.size_t(len(dst)), (*C.uint8_t)(&dst[0]))
+	if encodedSize == 0 {
+		return 0, false
+	}
+	return int(encodedSize), true
+}
+
+// EncodeTo compresses src into dst, which does not grow, and returns the size
+// of the stream. If the stream does not fit, EncodeTo fails with
+// io.ErrShortBuffer, and the content of dst is unspecified; a dst of
+// CompressBound(len(src)) bytes is always large enough without a dictionary.
+// The stream is the same as that of Encode, and is written to dst directly:
+// there is no intermediate output buffer.
+func EncodeTo(dst, src []byte, options WriterOptions) (int, error) {
+	if err := options.validate(); err != nil {
+		return 0, err
+	}
+	if options.SizeHint == 0 {
+		options.SizeHint = len(src)
+	}
+	// Empty input takes the streaming path, as in Encode.
+	if options.oneShot() && len(src) != 0 {
+		if n, ok := compressInto(dst, src, options); ok {
+			return n, nil
+		}
+		if len(dst) < compressBound(len(src)) {
+			return 0, io.ErrShortBuffer
+		}
+		// The streaming path reports the error.
+	}
+	out := &sliceWriter{buf: dst}
+	w := NewWriter(out, options)
+	_, err := w.Write(src)
+	if closeErr := w.Close(); err == nil {
 		err = closeErr
 	}
-	return buf.Bytes(), err
+	if err != nil {
+		return 0, err
+	}
+	return out.n, nil
+}
+
+// sliceWriter writes to buf, and fails with io.ErrShortBuffer once it is full.
+type sliceWriter struct {
+	buf []byte
+	n   int
+}
+
+func (s *sliceWriter) Write(p []byte) (int, error) {
+	if len(p) > len(s.buf)-s.n {
+		return 0, io.ErrShortBuffer
+	}
+	s.n += copy(s.buf[s.n:], p)
+	return len(p), nil
+}
+
+// initialBufferSize guesses the size of the compressed stream: the exact bound
+// for short inputs, and half of the input for longer ones, since the output
+// grows as needed.
+func initialBufferSize(sizeHint int) int {
+	switch {
+	case sizeHint <= 0:
+		return 4 << 10
+	case sizeHint <= 64<<10:
+		return int(C.BrotliEncoderMaxCompressedSize(C.size_t(sizeHint)))
+	default:
+		return sizeHint / 2
+	}
 }
@@ -0,0 +1,324 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build !cgo
+
+package cbrotli
+
+import (
+	"io"
+	"sync"
+)
+
+// PreparedDictionary is a dictionary for encoders. Without cgo, there is no
+// encoder: PrepareDictionary fails with ErrNotSupported, and Writers given a
+// dictionary made by NewPreparedDictionary fail like all Writers.
+type PreparedDictionary struct {
+	quality int
+	data    []byte
+	kind    DictionaryType
+	id      [32]byte
+
+	mu     sync.Mutex
+	users  int // open Writers
+	closed bool
+}
+
+// NewPreparedDictionary records dictionary data for encoders; see
+// PreparedDictionary.
+// Close MUST be called to free resources.
+func NewPreparedDictionary(data []byte, dictionaryType DictionaryType, quality int) *PreparedDictionary {
+	p := &PreparedDictionary{quality: quality, kind: dictionaryType}
+	if checkDictionarySize(len(data), dictionaryType) == nil {
+		p.data = data
+		p.id = DictionaryID(data)
+	}
+	return p
+}
+
+// PrepareDictionary reports invalid options and data, and ErrNotSupported
+// otherwise.
+func PrepareDictionary(data []byte, dictionaryType DictionaryType, options PrepareDictionaryOptions) (*PreparedDictionary, error) {
+	if err := validateQuality(options.Quality); err != nil {
+		return nil, err
+	}
+	if err := checkDictionarySize(len(data), dictionaryType); err != nil {
+		return nil, err
+	}
+	return nil, ErrNotSupported
+}
+
+// OpenPreparedDictionaryFile returns ErrNotSupported.
+func OpenPreparedDictionaryFile(path string, dictionaryType DictionaryType, quality int) (*PreparedDictionary, error) {
+	return nil, ErrNotSupported
+}
+
+// Reprepare reports an invalid quality or a closed dictionary, and
+// ErrNotSupported otherwise.
+func (p *PreparedDictionary) Reprepare(quality int) error {
+	if err := validateQuality(quality); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return errPreparedDictionaryClosed
+	}
+	return ErrNotSupported
+}
+
+// prefixCount returns the number of raw dictionaries in p; p may be nil.
+func (p *PreparedDictionary) prefixCount() int {
+	if p == nil {
+		return 0
+	}
+	return prefixCount(p.data, p.kind)
+}
+
+// ID returns the DictionaryID of the dictionary data, computed when the
+// dictionary was prepared.
+func (p *PreparedDictionary) ID() [32]byte { return p.id }
+
+// Close releases the dictionary. It fails if some Writers using the
+// dictionary are not closed yet.
+func (p *PreparedDictionary) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.users != 0 {
+		return errPreparedDictionaryInUse
+	}
+	p.closed = true
+	p.data = nil
+	return nil
+}
+
+// acquire registers a Writer using the dictionary; it reports false if the
+// dictionary is closed.
+func (p *PreparedDictionary) acquire() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false
+	}
+	p.users++
+	return true
+}
+
+func (p *PreparedDictionary) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.users--
+}
+
+// Writer has the API of the Writer of cgo builds, but there is no encoder
+// without cgo: Write, Flush and Close fail with ErrNotSupported, or with the
+// error of invalid options. Once closed, the Writer returns ErrWriterClosed.
+type Writer struct {
+	mu         sync.Mutex
+	err        error // invalid options or ErrNotSupported
+	closed     bool
+	options    WriterOptions
+	lgwin      int
+	stats      WriterStats
+	dictionary *PreparedDictionary // options.Dictionary, acquired
+	staged     []byte              // always empty; see SeekableWriter
+}
+
+// NewWriter initializes new Writer instance.
+// Close MUST be called to free resources.
+func NewWriter(dst io.Writer, options WriterOptions) *Writer {
+	w := &Writer{}
+	w.init(options)
+	return w
+}
+
+// NewWriterWithDictionaries initializes new Writer instance, like NewWriter;
+// the dictionaries are not used.
+// Close MUST be called to free resources.
+func NewWriterWithDictionaries(dst io.Writer, options WriterOptions, dictionaries []Dictionary) *Writer {
+	return NewWriter(dst, options)
+}
+
+// init records options and the error that the Writer reports.
+func (w *Writer) init(options WriterOptions) error {
+	w.closed = false
+	w.options = options
+	w.lgwin = 0
+	w.stats = WriterStats{DictionaryQuality: -1}
+	if options.Dictionary != nil && options.Dictionary.acquire() {
+		w.dictionary = options.Dictionary
+	}
+	if w.err = options.validate(); w.err != nil {
+		return w.err
+	}
+	w.lgwin = options.windowBits()
+	w.err = ErrNotSupported
+	return w.err
+}
+
+// destroy releases the dictionary and marks the Writer closed.
+func (w *Writer) destroy() {
+	w.closed = true
+	if w.dictionary != nil {
+		w.dictionary.release()
+		w.dictionary = nil
+	}
+}
+
+// ResetWithDictionaries is like ResetOptions; the dictionaries are not used.
+func (w *Writer) ResetWithDictionaries(dst io.Writer, options WriterOptions, dictionaries []Dictionary) error {
+	return w.ResetOptions(dst, options)
+}
+
+// ResetOptions discards the Writer's state and makes it equivalent to the
+// result of NewWriter(dst, options); it returns the error that the Writer
+// reports.
+func (w *Writer) ResetOptions(dst io.Writer, options WriterOptions) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.destroy()
+	return w.init(options)
+}
+
+// SetQuality reports an invalid quality, and the error of the Writer
+// otherwise.
+func (w *Writer) SetQuality(quality int) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := validateQuality(quality); err != nil {
+		return err
+	}
+	if w.closed {
+		return ErrWriterClosed
+	}
+	return w.err
+}
+
+// Finished reports whether the stream is complete; it never is.
+func (w *Writer) Finished() bool { return false }
+
+// HasMoreOutput reports whether the encoder holds output; there is none.
+func (w *Writer) HasMoreOutput() bool { return false }
+
+// WindowBits returns the base 2 logarithm of the window size configured by
+// the options.
+func (w *Writer) WindowBits() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lgwin
+}
+
+// fail returns the error of a call on the Writer.
+func (w *Writer) fail() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return ErrWriterClosed
+	}
+	return w.err
+}
+
+// writeMetadata fails like Write; the caller holds w.mu.
+func (w *Writer) writeMetadata(p []byte) error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	return w.err
+}
+
+// Write implements io.Writer.
+func (w *Writer) Write(p []byte) (n int, err error) {
+	return 0, w.fail()
+}
+
+// WriteString implements io.StringWriter.
+func (w *Writer) WriteString(s string) (n int, err error) {
+	return 0, w.fail()
+}
+
+// ReadFrom implements io.ReaderFrom; it reads nothing from src.
+func (w *Writer) ReadFrom(src io.Reader) (n int64, err error) {
+	return 0, w.fail()
+}
+
+// Flush implements the Flush of cgo builds.
+func (w *Writer) Flush() error {
+	return w.fail()
+}
+
+// Close releases the dictionary and returns the error of the Writer; any
+// further calls return ErrWriterClosed.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return ErrWriterClosed
+	}
+	w.destroy()
+	return w.err
+}
+
+// CloseAppendable is like Close.
+func (w *Writer) CloseAppendable() error {
+	return w.Close()
+}
+
+// Stats returns the activity counters of the Writer, which stay zero.
+func (w *Writer) Stats() WriterStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.stats
+}
+
+// Encode reports invalid options, and ErrNotSupported otherwise.
+func Encode(content []byte, options WriterOptions) ([]byte, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return nil, ErrNotSupported
+}
+
+// EncodeTo reports invalid options, and ErrNotSupported otherwise.
+func EncodeTo(dst, src []byte, options WriterOptions) (int, error) {
+	if err := options.validate(); err != nil {
+		return 0, err
+	}
+	return 0, ErrNotSupported
+}
+
+// compressBound returns the largest size of the Brotli stream of n bytes, or
+// 0 if it does not fit int, as BrotliEncoderMaxCompressedSize does.
+func compressBound(n int) int {
+	if n < 0 {
+		return 0
+	}
+	if n == 0 {
+		return 2
+	}
+	// Stream header, uncompressed meta-blocks of 16KiB and the last empty one.
+	bound := n + 2 + 4*(n>>14) + 3 + 1
+	if bound < n {
+		return 0
+	}
+	return bound
+}
+
+// encodeOneShot fails: there is no encoder.
+func encodeOneShot(content []byte, options WriterOptions, scratch []byte) ([]byte, bool) {
+	return nil, false
+}
+
+// initialBufferSize guesses the size of the compressed stream; see the cgo
+// build.
+func initialBufferSize(sizeHint int) int {
+	switch {
+	case sizeHint <= 0:
+		return 4 << 10
+	case sizeHint <= 64<<10:
+		return compressBound(sizeHint)
+	default:
+		return sizeHint / 2
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 Google Inc. All Rights Reserved.
+//
+// Distributed under MIT license.
+// See file LICENSE for detail or copy at https://opensource.org/licenses/MIT
+
+//go:build cgo
+
+package cbrotli
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock replaces afterFunc; armed timers fire only when advanced. Timers
+// may be stopped from other goroutines.
+type fakeClock struct {
+	mu     sync.Mutex
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	c       *fakeClock
+	f       func()
+	stopped bool
+	fired   bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	active := !t.stopped && !t.fired
+	t.stopped = true
+	return active
+}
+
+func (c *fakeClock) afterFunc(d time.Duration, f func()) timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{c: c, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// advance fires the timers armed so far, as if the interval elapsed.
+func (c *fakeClock) advance() {
+	c.mu.Lock()
+	var due []*fakeTimer
+	for _, t := range c.timers {
+		if !t.stopped && !t.fired {
+			t.fired = true
+			due = append(due, t)
+		}
+	}
+	c.timers = nil
+	c.mu.Unlock()
+	for _, t := range due {
+		t.f()
+	}
+}
+
+func (c *fakeClock) pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, t := range c.timers {
+		if !t.stopped && !t.fired {
+			n++
+		}
+	}
+	return n
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	c := &fakeClock{}
+	saved := afterFunc
+	afterFunc = c.afterFunc
+	t.Cleanup(func() { afterFunc = saved })
+	return c
+}
+
+// countingWriter counts Write calls.
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+	err    error
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.writes++
+	return w.Buffer.Write(p)
+}
+
+func TestWriterFlushInterval(t *testing.T) {
+	clock := useFakeClock(t)
+	out := &countingWriter{}
+	w := NewWriter(out, WriterOptions{Quality: 5, FlushInterval: time.Second})
+
+	// Idle writer: no timer, no output.
+	clock.advance()
+	if out.writes != 0 {
+		t.Fatalf("idle writer wrote %d times", out.writes)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := w.Write([]byte("hello ")); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if n := clock.pending(); n != 1 {
+		t.Fatalf("%d pending timers after writes; want 1", n)
+	}
+	clock.advance()
+	if out.writes != 1 {
+		t.Fatalf("got %d writes after interval; want 1", out.writes)
+	}
+	// The stream is not finished yet, but flushed data must be decodable.
+	r := NewReader(bytes.NewReader(out.Bytes()))
+	got := make([]byte, 18)
+	if _, err := r.Read(got); err != nil || string(got) != "hello hello hello " {
+		t.Errorf("flushed data: got %q, %v", got, err)
+	}
+	r.Close()
+
+	// No writes since the flush: nothing happens.
+	clock.advance()
+	clock.advance()
+	if out.writes != 1 {
+		t.Errorf("got %d writes while idle; want 1", out.writes)
+	}
+
+	// Explicit flush cancels the need for an automatic one.
+	w.Write([]byte("world"))
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	writes := out.writes
+	clock.advance()
+	if out.writes != writes {
+		t.Errorf("automatic flush after explicit Flush wrote %d times",
+			out.writes-writes)
+	}
+
+	w.Write([]byte("!"))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if n := clock.pending(); n != 0 {
+		t.Errorf("%d pending timers after Close", n)
+	}
+	decoded, err := Decode(out.Bytes())
+	if err != nil || string(decoded) != "hello hello hello world!" {
+		t.Errorf("Decode: got %q, %v", decoded, err)
+	}
+}
+
+func TestWriterFlushIntervalError(t *testing.T) {
+	clock := useFakeClock(t)
+	out := &countingWriter{}
+	w := NewWriter(out, WriterOptions{Quality: 5, FlushInterval: time.Second})
+	if _, err := w.Write([]byte("hello")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	want := errors.New("broken pipe")
+	out.err = want
+	clock.advance()
+	if _, err := w.Write([]byte("world")); err != want {
+		t.Errorf("Write after failed automatic flush: got %v, want %v", err, want)
+	}
+	if err := w.Close(); err != want {
+		t.Errorf("Close after failed automatic flush: got %v, want %v", err, want)
+	}
+}
+
+func TestWriterNegativeFlushInterval(t *testing.T) {
+	if _, err := Encode(nil, WriterOptions{FlushInterval: -1}); err == nil {
+		t.Error("Encode accepted negative FlushInterval")
+	}
+}
+
+func TestEncoderError(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, WriterOptions{Quality: 4, LGWin: 18})
+	if _, err := w.Write([]byte("some data")); err != nil {
+		t.Fatal(err)
+	}
+	// C-Brotli rejects metadata over 16MiB.
+	err := w.writeMetadata(make([]byte, 1<<24+1))
+	var e EncoderError
+	if !errors.As(err, &e) {
+		t.Fatalf("writeMetadata: %v", err)
+	}
+	want := EncoderError{Op: "emit metadata", Reason: "the encoder failed", BytesIn: 9,
+		BytesOut: int64(buf.Len()), Quality: 4, LGWin: 18}
+	if e != want {
+		t.Errorf("got %+v, want %+v", e, want)
+	}
+	if _, err := w.Write([]byte("more")); err != e {
+		t.Errorf("Write: %v, want %v", err, e)
+	}
+	if err := w.Close(); err != e {
+		t.Errorf("Close: %v, want %v", err, e)
+	}
+}